@@ -170,7 +170,13 @@ func (a *access) hasPermission(roles []string) bool {
 }
 
 func (a *access) hasRequiredRole(role string) bool {
-	switch a.requiredRole {
+	return HasRequiredRole(a.requiredRole, role)
+}
+
+// HasRequiredRole reports whether the given role grants at least the
+// permissions of the required role.
+func HasRequiredRole(requiredRole string, role string) bool {
+	switch requiredRole {
 	case OwnerRole:
 		return role == OwnerRole
 	case MemberRole:
@@ -22,6 +22,7 @@ var DefaultRoles = map[string]string{
 	atc.BuildEvents:                   ViewerRole,
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
+	atc.ApproveBuild:                  OperatorRole,
 	atc.GetBuildPreparation:           ViewerRole,
 	atc.GetJob:                        ViewerRole,
 	atc.CreateJobBuild:                OperatorRole,
@@ -30,6 +31,7 @@ var DefaultRoles = map[string]string{
 	atc.ListJobs:                      ViewerRole,
 	atc.ListJobBuilds:                 ViewerRole,
 	atc.ListJobInputs:                 ViewerRole,
+	atc.RunJob:                        MemberRole,
 	atc.GetJobBuild:                   ViewerRole,
 	atc.PauseJob:                      OperatorRole,
 	atc.UnpauseJob:                    OperatorRole,
@@ -52,6 +54,8 @@ var DefaultRoles = map[string]string{
 	atc.EnableResourceVersion:         OperatorRole,
 	atc.DisableResourceVersion:        OperatorRole,
 	atc.PinResourceVersion:            OperatorRole,
+	atc.AnnotateResourceVersion:       OperatorRole,
+	atc.RemoveVersionAnnotation:       OperatorRole,
 	atc.ListBuildsWithVersionAsInput:  ViewerRole,
 	atc.ListBuildsWithVersionAsOutput: ViewerRole,
 	atc.GetResourceCausality:          ViewerRole,
@@ -76,6 +80,7 @@ var DefaultRoles = map[string]string{
 	atc.HeartbeatWorker:               MemberRole,
 	atc.ListWorkers:                   ViewerRole,
 	atc.DeleteWorker:                  MemberRole,
+	atc.ReportWorkerDiagnostics:       MemberRole,
 	atc.SetLogLevel:                   MemberRole,
 	atc.GetLogLevel:                   ViewerRole,
 	atc.DownloadCLI:                   ViewerRole,
@@ -95,8 +100,14 @@ var DefaultRoles = map[string]string{
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.ListTeamWebhooks:              ViewerRole,
+	atc.SetTeamWebhook:                OwnerRole,
+	atc.DestroyTeamWebhook:            OwnerRole,
+	atc.ReceiveTeamWebhook:            OperatorRole,
+	atc.ListTeamWebhookDeliveries:     ViewerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
+	atc.PatchArtifact:                 MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
+	"github.com/concourse/concourse/atc/configlint/configlintfakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
@@ -62,6 +63,7 @@ var (
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
 	fakeSecretManager       *credsfakes.FakeSecrets
+	fakePipelineLinter      *configlintfakes.FakeLinter
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
 	credsManagers           creds.Managers
@@ -138,6 +140,7 @@ var _ = BeforeEach(func() {
 	fakeDestroyer = new(gcfakes.FakeDestroyer)
 
 	fakeSecretManager = new(credsfakes.FakeSecrets)
+	fakePipelineLinter = new(configlintfakes.FakeLinter)
 	fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	credsManagers = make(creds.Managers)
 
@@ -217,6 +220,7 @@ var _ = BeforeEach(func() {
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
+		fakePipelineLinter,
 		fakeClock,
 	)
 
@@ -351,4 +351,154 @@ var _ = Describe("ArtifactRepository API", func() {
 			})
 		})
 	})
+
+	Describe("POST /api/v1/teams/:team_name/artifacts/:artifact_id/patch", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Post(
+				server.URL+"/api/v1/teams/some-team/artifacts/18/patch",
+				"application/octet-stream",
+				bytes.NewBuffer([]byte("some-delta")),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("uses the artifactID to fetch the db volume record", func() {
+				Expect(dbTeam.FindVolumeForWorkerArtifactCallCount()).To(Equal(1))
+				Expect(dbTeam.FindVolumeForWorkerArtifactArgsForCall(0)).To(Equal(18))
+			})
+
+			Context("when the db artifact volume is not found", func() {
+				BeforeEach(func() {
+					dbTeam.FindVolumeForWorkerArtifactReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the db artifact volume is found", func() {
+				BeforeEach(func() {
+					fakeDBVolume := new(dbfakes.FakeCreatedVolume)
+					fakeDBVolume.HandleReturns("some-handle")
+
+					dbTeam.FindVolumeForWorkerArtifactReturns(fakeDBVolume, true, nil)
+				})
+
+				Context("when the worker volume is not found", func() {
+					BeforeEach(func() {
+						fakeWorkerPool.FindVolumeReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when the worker volume is found", func() {
+					var fakeParentVolume *workerfakes.FakeVolume
+					var fakeVolume *workerfakes.FakeVolume
+
+					BeforeEach(func() {
+						fakeParentVolume = new(workerfakes.FakeVolume)
+						fakeWorkerPool.FindVolumeReturns(fakeParentVolume, true, nil)
+
+						fakeWorkerArtifact := new(dbfakes.FakeWorkerArtifact)
+						fakeWorkerArtifact.IDReturns(19)
+						fakeWorkerArtifact.CreatedAtReturns(time.Unix(42, 0))
+
+						fakeVolume = new(workerfakes.FakeVolume)
+						fakeVolume.InitializeArtifactReturns(fakeWorkerArtifact, nil)
+						fakeVolume.StreamInStub = func(ctx context.Context, path string, encoding baggageclaim.Encoding, body io.Reader) error {
+							Expect(path).To(Equal("/"))
+							Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+							Expect(ioutil.ReadAll(body)).To(Equal([]byte("some-delta")))
+							return nil
+						}
+
+						fakeWorkerPool.CreateChildVolumeReturns(fakeVolume, nil)
+					})
+
+					It("creates a child of the artifact's volume", func() {
+						Expect(fakeWorkerPool.CreateChildVolumeCallCount()).To(Equal(1))
+
+						_, teamID, parent := fakeWorkerPool.CreateChildVolumeArgsForCall(0)
+						Expect(teamID).To(Equal(734))
+						Expect(parent).To(Equal(fakeParentVolume))
+					})
+
+					It("streams the delta into the new volume", func() {
+						Expect(fakeVolume.StreamInCallCount()).To(Equal(1))
+					})
+
+					It("returns 201 Created with the new artifact", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"id": 19,
+							"name": "",
+							"build_id": 0,
+							"created_at": 42
+						}`))
+					})
+
+					Context("when creating the child volume fails", func() {
+						BeforeEach(func() {
+							fakeWorkerPool.CreateChildVolumeReturns(nil, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when streaming in the delta fails", func() {
+						BeforeEach(func() {
+							fakeVolume.StreamInStub = nil
+							fakeVolume.StreamInReturns(errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+			})
+		})
+	})
 })
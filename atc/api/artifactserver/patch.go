@@ -0,0 +1,84 @@
+package artifactserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// PatchArtifact creates a new artifact from a copy-on-write volume of an
+// existing artifact, streaming the request body on top of it. This lets fly
+// send only the files that changed instead of the whole input.
+func (s *Server) PatchArtifact(team db.Team) http.Handler {
+	hLog := s.logger.Session("patch-artifact")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		artifactID, err := strconv.Atoi(r.FormValue(":artifact_id"))
+		if err != nil {
+			hLog.Error("failed-to-get-artifact-id", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		artifactVolume, found, err := team.FindVolumeForWorkerArtifact(artifactID)
+		if err != nil {
+			hLog.Error("failed-to-get-artifact-volume", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			hLog.Info("artifact-volume-not-found", lager.Data{"artifact_id": artifactID})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		parentVolume, found, err := s.workerPool.FindVolume(hLog, team.ID(), artifactVolume.Handle())
+		if err != nil {
+			hLog.Error("failed-to-get-worker-volume", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			hLog.Info("worker-volume-not-found", lager.Data{"artifact_id": artifactID})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		volume, err := s.workerPool.CreateChildVolume(hLog, team.ID(), parentVolume)
+		if err != nil {
+			hLog.Error("failed-to-create-volume", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// NOTE: the same race as in CreateArtifact applies here; the volume
+		// may be collected before the artifact is initialized.
+
+		artifact, err := volume.InitializeArtifact("", 0)
+		if err != nil {
+			hLog.Error("failed-to-initialize-artifact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = volume.StreamIn(r.Context(), "/", baggageclaim.GzipEncoding, r.Body)
+		if err != nil {
+			hLog.Error("failed-to-stream-volume-contents", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+
+		json.NewEncoder(w).Encode(present.WorkerArtifact(artifact))
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -636,6 +637,33 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when the build is paused on an approval step", func() {
+							BeforeEach(func() {
+								build.StatusReturns(db.BuildStatusStarted)
+								build.AwaitingApprovalReturns("some-plan")
+							})
+
+							It("reports it as awaiting approval", func() {
+								var result atc.Build
+								err := json.NewDecoder(response.Body).Decode(&result)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(result.Status).To(Equal(atc.StatusAwaitingApproval))
+							})
+						})
+
+						Context("when the build was triggered by a schedule", func() {
+							BeforeEach(func() {
+								build.CauseReturns(atc.BuildCauseScheduled)
+							})
+
+							It("includes the cause", func() {
+								var result atc.Build
+								err := json.NewDecoder(response.Body).Decode(&result)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(result.Cause).To(Equal("scheduled"))
+							})
+						})
 					})
 				})
 			})
@@ -1113,6 +1141,198 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/builds/:build_id/approval", func() {
+		var (
+			approval atc.BuildApproval
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			approval = atc.BuildApproval{Approved: true, Comment: "ship it"}
+		})
+
+		JustBeforeEach(func() {
+			reqPayload, err := json.Marshal(approval)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/approval", bytes.NewBuffer(reqPayload))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+						fakeAccess.TeamRolesReturns(map[string][]string{
+							"some-team": {"member"},
+						})
+						fakeAccess.ClaimsReturns(accessor.Claims{
+							UserName:          "Some User",
+							PreferredUsername: "some-user",
+						})
+					})
+
+					Context("when the build is not awaiting approval", func() {
+						BeforeEach(func() {
+							build.AwaitingApprovalReturns("")
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						})
+
+						It("does not save the approval", func() {
+							Expect(build.SaveApprovalCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when the build is awaiting approval", func() {
+						var role string
+
+						BeforeEach(func() {
+							role = "member"
+						})
+
+						BeforeEach(func() {
+							build.AwaitingApprovalReturns("some-plan")
+							build.PrivatePlanStub = func() atc.Plan {
+								return atc.Plan{
+									ID: "some-do",
+									Do: &atc.DoPlan{
+										{
+											ID: "some-plan",
+											Approval: &atc.ApprovalPlan{
+												Name:      "deploy",
+												Approvers: 1,
+												Role:      role,
+											},
+										},
+									},
+								}
+							}
+							build.SaveApprovalReturns(true, nil)
+						})
+
+						It("returns 204", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+						})
+
+						It("saves the approval with the user and their role", func() {
+							Expect(build.SaveApprovalCallCount()).To(Equal(1))
+							Expect(build.SaveApprovalArgsForCall(0)).To(Equal(db.BuildApproval{
+								Username: "some-user",
+								Role:     "member",
+								Approved: true,
+								Comment:  "ship it",
+							}))
+						})
+
+						Context("when rejecting", func() {
+							BeforeEach(func() {
+								approval = atc.BuildApproval{Approved: false}
+							})
+
+							It("saves the rejection", func() {
+								Expect(build.SaveApprovalCallCount()).To(Equal(1))
+								Expect(build.SaveApprovalArgsForCall(0).Approved).To(BeFalse())
+							})
+						})
+
+						Context("when the step requires a higher role", func() {
+							BeforeEach(func() {
+								role = "owner"
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+
+							It("does not save the approval", func() {
+								Expect(build.SaveApprovalCallCount()).To(BeZero())
+							})
+
+							Context("when the user is an admin", func() {
+								BeforeEach(func() {
+									fakeAccess.IsAdminReturns(true)
+								})
+
+								It("saves the approval as an owner", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+									Expect(build.SaveApprovalArgsForCall(0).Role).To(Equal("owner"))
+								})
+							})
+						})
+
+						Context("when the build stopped awaiting approval in the meantime", func() {
+							BeforeEach(func() {
+								build.SaveApprovalReturns(false, nil)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							})
+						})
+
+						Context("when saving the approval fails", func() {
+							BeforeEach(func() {
+								build.SaveApprovalReturns(false, errors.New("nope"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
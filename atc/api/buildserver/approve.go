@@ -0,0 +1,118 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ApproveBuild(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		aLog := s.logger.Session("approve", build.LagerData())
+
+		var reqBody atc.BuildApproval
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil {
+			aLog.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		plan, found := awaitedApprovalPlan(build)
+		if !found {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		role, permitted := approverRole(acc, build.TeamName(), plan.Role)
+		if !permitted {
+			aLog.Info("insufficient-role", lager.Data{"required": plan.Role})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		claims := acc.Claims()
+
+		username := claims.PreferredUsername
+		if username == "" {
+			username = claims.UserName
+		}
+
+		saved, err := build.SaveApproval(db.BuildApproval{
+			Username: username,
+			Role:     role,
+			Approved: reqBody.Approved,
+			Comment:  reqBody.Comment,
+		})
+		if err != nil {
+			aLog.Error("failed-to-save-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !saved {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		aLog.Info("saved-approval", lager.Data{
+			"user":     username,
+			"approved": reqBody.Approved,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// awaitedApprovalPlan returns the approval step the build is currently paused
+// on, if any.
+func awaitedApprovalPlan(build db.Build) (atc.ApprovalPlan, bool) {
+	planID := build.AwaitingApproval()
+	if planID == "" {
+		return atc.ApprovalPlan{}, false
+	}
+
+	var (
+		approvalPlan atc.ApprovalPlan
+		found        bool
+	)
+
+	privatePlan := build.PrivatePlan()
+	privatePlan.Each(func(plan *atc.Plan) {
+		if plan.ID == planID && plan.Approval != nil {
+			approvalPlan = *plan.Approval
+			found = true
+		}
+	})
+
+	return approvalPlan, found
+}
+
+// approverRole returns the highest role the user has on the team, and whether
+// it is enough to approve a step requiring the given role.
+func approverRole(acc accessor.Access, teamName string, requiredRole string) (string, bool) {
+	if acc.IsAdmin() {
+		return accessor.OwnerRole, true
+	}
+
+	for _, role := range []string{
+		accessor.OwnerRole,
+		accessor.MemberRole,
+		accessor.OperatorRole,
+		accessor.ViewerRole,
+	} {
+		for _, teamRole := range acc.TeamRoles()[teamName] {
+			if teamRole == role {
+				return role, accessor.HasRequiredRole(requiredRole, role)
+			}
+		}
+	}
+
+	return "", false
+}
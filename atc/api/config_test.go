@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -262,6 +263,70 @@ var _ = Describe("Config API", func() {
 						})
 					})
 
+					Context("when a config version is requested", func() {
+						BeforeEach(func() {
+							fakePipeline.ConfigReturns(pipelineConfig, nil)
+
+							query := request.URL.Query()
+							query.Add("version", "42")
+							request.URL.RawQuery = query.Encode()
+						})
+
+						Context("when the revision is found", func() {
+							BeforeEach(func() {
+								fakePipeline.ConfigRevisionReturns(pipelineConfig, true, nil)
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("looks up the revision", func() {
+								Expect(fakePipeline.ConfigRevisionCallCount()).To(Equal(1))
+								Expect(fakePipeline.ConfigRevisionArgsForCall(0)).To(Equal(db.ConfigVersion(42)))
+								Expect(fakePipeline.ConfigCallCount()).To(Equal(0))
+							})
+
+							It("returns the requested version as X-Concourse-Config-Version", func() {
+								Expect(response).Should(IncludeHeaderEntries(map[string]string{
+									atc.ConfigVersionHeader: "42",
+								}))
+							})
+
+							It("returns the config of the revision", func() {
+								var actualConfigResponse atc.ConfigResponse
+								err := json.NewDecoder(response.Body).Decode(&actualConfigResponse)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(actualConfigResponse).To(Equal(atc.ConfigResponse{
+									Config: pipelineConfig,
+								}))
+							})
+						})
+
+						Context("when the revision is not found", func() {
+							BeforeEach(func() {
+								fakePipeline.ConfigRevisionReturns(atc.Config{}, false, nil)
+							})
+
+							It("returns 404", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+							})
+						})
+
+						Context("when the version is malformed", func() {
+							BeforeEach(func() {
+								query := request.URL.Query()
+								query.Set("version", "latest")
+								request.URL.RawQuery = query.Encode()
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+						})
+					})
+
 					Context("when the pipeline is archived", func() {
 						BeforeEach(func() {
 							fakePipeline.ArchivedReturns(true)
@@ -581,6 +646,80 @@ var _ = Describe("Config API", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
 							})
 						})
+
+						It("lints the config for the team", func() {
+							Expect(fakePipelineLinter.LintCallCount()).To(Equal(1))
+
+							team, config := fakePipelineLinter.LintArgsForCall(0)
+							Expect(team).To(Equal("a-team"))
+							Expect(config).To(Equal(pipelineConfig))
+						})
+
+						Context("when the config fails a lint rule with error severity", func() {
+							BeforeEach(func() {
+								fakePipelineLinter.LintReturns([]configlint.Violation{
+									{
+										Rule:     "job-on-failure",
+										Severity: configlint.SeverityError,
+										Location: "jobs.some-job",
+										Message:  "job has no on_failure hook",
+									},
+									{
+										Rule:     "task-timeout",
+										Severity: configlint.SeverityWarning,
+										Location: "jobs.some-job.plan.do[0].task(some-task)",
+										Message:  "task has no timeout",
+									},
+								})
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("returns the lint errors", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+								{
+									"errors": [
+										"jobs.some-job: job has no on_failure hook (job-on-failure)"
+									]
+								}`))
+							})
+
+							It("does not save it", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the config fails a lint rule with warning severity", func() {
+							BeforeEach(func() {
+								fakePipelineLinter.LintReturns([]configlint.Violation{
+									{
+										Rule:     "task-timeout",
+										Severity: configlint.SeverityWarning,
+										Location: "jobs.some-job.plan.do[0].task(some-task)",
+										Message:  "task has no timeout",
+									},
+								})
+							})
+
+							It("saves it", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+							})
+
+							It("returns the lint warnings", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+								{
+									"warnings": [
+										{
+											"type": "lint",
+											"message": "jobs.some-job.plan.do[0].task(some-task): task has no timeout (task-timeout)"
+										}
+									]
+								}`))
+							})
+						})
 					})
 
 					Context("YAML", func() {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/tedsuo/rata"
 )
 
@@ -24,6 +25,16 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var revision db.ConfigVersion
+	if versionStr := r.URL.Query().Get(atc.GetConfigVersion); versionStr != "" {
+		_, err := fmt.Sscanf(versionStr, "%d", &revision)
+		if err != nil {
+			logger.Info("malformed-config-version", lager.Data{"error": err.Error()})
+			s.handleBadRequest(w, fmt.Sprintf("config version is malformed: %s", err))
+			return
+		}
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		logger.Error("failed-to-find-team", err)
@@ -56,14 +67,34 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	config, err := pipeline.Config()
-	if err != nil {
-		logger.Error("failed-to-get-pipeline-config", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	version := pipeline.ConfigVersion()
+
+	var config atc.Config
+	if revision != 0 {
+		version = revision
+
+		config, found, err = pipeline.ConfigRevision(revision)
+		if err != nil {
+			logger.Error("failed-to-get-pipeline-config-revision", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("config-revision-not-found", lager.Data{"version": revision})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	} else {
+		config, err = pipeline.Config()
+		if err != nil {
+			logger.Error("failed-to-get-pipeline-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 	}
 
-	w.Header().Set(atc.ConfigVersionHeader, fmt.Sprintf("%d", pipeline.ConfigVersion()))
+	w.Header().Set(atc.ConfigVersionHeader, fmt.Sprintf("%d", version))
 	w.Header().Set("Content-Type", "application/json")
 
 	err = json.NewEncoder(w).Encode(atc.ConfigResponse{
@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -90,6 +91,29 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		warnings = append(warnings, *warning)
 	}
 
+	if s.linter != nil {
+		violations := s.linter.Lint(teamName, config)
+
+		lintErrors := configlint.Errors(violations)
+		if len(lintErrors) > 0 {
+			var messages []string
+			for _, violation := range lintErrors {
+				messages = append(messages, violation.String())
+			}
+
+			session.Info("ignoring-config-failing-lint", lager.Data{"errors": messages})
+			s.handleBadRequest(w, messages...)
+			return
+		}
+
+		for _, violation := range configlint.Warnings(violations) {
+			warnings = append(warnings, atc.ConfigWarning{
+				Type:    "lint",
+				Message: violation.String(),
+			})
+		}
+	}
+
 	pipelineRef := atc.PipelineRef{Name: pipelineName}
 	pipelineRef.InstanceVars, err = atc.InstanceVarsFromQueryParams(r.URL.Query())
 	if atc.EnablePipelineInstances {
@@ -2,6 +2,7 @@ package configserver
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -10,16 +11,21 @@ type Server struct {
 	logger        lager.Logger
 	teamFactory   db.TeamFactory
 	secretManager creds.Secrets
+	linter        configlint.Linter
 }
 
+// NewServer constructs a Server. The linter may be nil, in which case configs
+// aren't linted when they're saved.
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	secretManager creds.Secrets,
+	linter configlint.Linter,
 ) *Server {
 	return &Server{
 		logger:        logger,
 		teamFactory:   teamFactory,
 		secretManager: secretManager,
+		linter:        linter,
 	}
 }
@@ -24,7 +24,9 @@ import (
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
+	"github.com/concourse/concourse/atc/api/webhookserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
@@ -73,6 +75,7 @@ func NewHandler(
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
+	pipelineLinter configlint.Linter,
 	clock clock.Clock,
 ) (http.Handler, error) {
 
@@ -91,7 +94,7 @@ func NewHandler(
 
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
-	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
+	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager, pipelineLinter)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
 	workerServer := workerserver.NewServer(logger, workerTeamFactory, dbWorkerFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
@@ -103,6 +106,7 @@ func NewHandler(
 	artifactServer := artifactserver.NewServer(logger, workerPool)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	webhookServer := webhookserver.NewServer(logger, dbCheckFactory)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -115,6 +119,7 @@ func NewHandler(
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.ApproveBuild:        buildHandlerFactory.HandlerFor(buildServer.ApproveBuild),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
@@ -128,6 +133,7 @@ func NewHandler(
 		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
 		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
 		atc.RerunJobBuild:  pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
+		atc.RunJob:         pipelineHandlerFactory.HandlerFor(jobServer.RunJob),
 		atc.PauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
 		atc.UnpauseJob:     pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
 		atc.ScheduleJob:    pipelineHandlerFactory.HandlerFor(jobServer.ScheduleJob),
@@ -170,6 +176,8 @@ func NewHandler(
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
 		atc.DisableResourceVersion:        pipelineHandlerFactory.HandlerFor(versionServer.DisableResourceVersion),
 		atc.PinResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.PinResourceVersion),
+		atc.AnnotateResourceVersion:       pipelineHandlerFactory.HandlerFor(versionServer.AnnotateResourceVersion),
+		atc.RemoveVersionAnnotation:       pipelineHandlerFactory.HandlerFor(versionServer.RemoveVersionAnnotation),
 		atc.ListBuildsWithVersionAsInput:  pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsInput),
 		atc.ListBuildsWithVersionAsOutput: pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsOutput),
 		atc.GetResourceCausality:          pipelineHandlerFactory.HandlerFor(versionServer.GetCausality),
@@ -182,6 +190,10 @@ func NewHandler(
 		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
 		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
 
+		atc.RequestWorkerDiagnostics: http.HandlerFunc(workerServer.RequestWorkerDiagnostics),
+		atc.GetWorkerDiagnostics:     http.HandlerFunc(workerServer.GetWorkerDiagnostics),
+		atc.ReportWorkerDiagnostics:  http.HandlerFunc(workerServer.ReportWorkerDiagnostics),
+
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
 
@@ -209,8 +221,15 @@ func NewHandler(
 		atc.DestroyTeam:    teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
 
+		atc.ListTeamWebhooks:          teamHandlerFactory.HandlerFor(webhookServer.ListTeamWebhooks),
+		atc.SetTeamWebhook:            teamHandlerFactory.HandlerFor(webhookServer.SetTeamWebhook),
+		atc.DestroyTeamWebhook:        teamHandlerFactory.HandlerFor(webhookServer.DestroyTeamWebhook),
+		atc.ReceiveTeamWebhook:        teamHandlerFactory.HandlerFor(webhookServer.ReceiveTeamWebhook),
+		atc.ListTeamWebhookDeliveries: teamHandlerFactory.HandlerFor(webhookServer.ListTeamWebhookDeliveries),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
+		atc.PatchArtifact:  teamHandlerFactory.HandlerFor(artifactServer.PatchArtifact),
 
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
@@ -498,6 +498,33 @@ var _ = Describe("Jobs API", func() {
 
 							})
 
+							Context("when the job depends on other jobs", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{
+										Name:      "some-job",
+										DependsOn: []string{"unit", "lint"},
+									}, nil)
+								})
+
+								It("includes the jobs it depends on", func() {
+									var job atc.Job
+									err := json.NewDecoder(response.Body).Decode(&job)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(job.DependsOn).To(Equal([]string{"unit", "lint"}))
+								})
+							})
+
+							Context("when getting the job's config fails", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{}, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+
 							Context("when there are no running or finished builds", func() {
 								BeforeEach(func() {
 									fakeJob.FinishedAndNextBuildReturns(nil, nil, nil)
@@ -1016,6 +1043,7 @@ var _ = Describe("Jobs API", func() {
 								Resource: "input-2",
 							},
 						},
+						DependsOn: []string{"job-1"},
 						Groups: []string{
 							"group-2",
 						},
@@ -1175,6 +1203,7 @@ var _ = Describe("Jobs API", func() {
 									"end_time": 200
 								},
 								"inputs": [{"name": "input-2", "resource": "input-2"}],
+								"depends_on": ["job-1"],
 								"groups": ["group-2"]
 							},
 							{
@@ -2616,6 +2645,200 @@ var _ = Describe("Jobs API", func() {
 			})
 		})
 	})
+
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/run", func() {
+		var requestBody string
+		var response *http.Response
+
+		BeforeEach(func() {
+			requestBody = `{"artifacts":{"some-input":42}}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Post(
+				server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/run",
+				"application/json",
+				strings.NewReader(requestBody),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				var fakeBuild *dbfakes.FakeBuild
+
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{
+								Config: &atc.GetStep{
+									Name:     "some-input",
+									Resource: "some-resource",
+								},
+							},
+							{
+								Config: &atc.GetStep{
+									Name:     "some-other-input",
+									Resource: "some-resource",
+								},
+							},
+							{
+								Config: &atc.TaskStep{
+									Name:       "some-task",
+									ConfigPath: "some-input/((task_file))",
+								},
+							},
+							{
+								Config: &atc.PutStep{
+									Name:     "some-output",
+									Resource: "some-resource",
+								},
+							},
+						},
+					}, nil)
+
+					fakeResource := new(dbfakes.FakeResource)
+					fakeResource.NameReturns("some-resource")
+					fakeResource.TypeReturns("some-type")
+					fakeResource.SourceReturns(atc.Source{"some": "source"})
+					fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+					fakeJob.GetFullNextBuildInputsReturns([]db.BuildInput{
+						{
+							Name:    "some-other-input",
+							Version: atc.Version{"some": "version"},
+						},
+					}, true, nil)
+
+					fakeBuild = new(dbfakes.FakeBuild)
+					fakeBuild.IDReturns(42)
+					fakeBuild.NameReturns("1")
+					fakeBuild.TeamNameReturns("some-team")
+					fakeBuild.StatusReturns("started")
+					fakePipeline.CreateStartedBuildReturns(fakeBuild, nil)
+				})
+
+				It("returns 201 with the build", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					var build atc.Build
+					err := json.NewDecoder(response.Body).Decode(&build)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(build.ID).To(Equal(42))
+				})
+
+				It("creates a one-off build with the job's plan", func() {
+					Expect(fakePipeline.CreateStartedBuildCallCount()).To(Equal(1))
+					plan := fakePipeline.CreateStartedBuildArgsForCall(0)
+
+					steps := *plan.Do
+					Expect(steps).To(HaveLen(4))
+
+					Expect(steps[0].ArtifactInput).To(Equal(&atc.ArtifactInputPlan{
+						ArtifactID: 42,
+						Name:       "some-input",
+					}))
+
+					Expect(steps[1].Get).ToNot(BeNil())
+					Expect(steps[1].Get.Name).To(Equal("some-other-input"))
+					Expect(steps[1].Get.Version).To(Equal(&atc.Version{"some": "version"}))
+
+					Expect(steps[2].Task).ToNot(BeNil())
+					Expect(steps[2].Task.ConfigPath).To(Equal("some-input/((task_file))"))
+				})
+
+				It("leaves out put steps", func() {
+					plan := fakePipeline.CreateStartedBuildArgsForCall(0)
+
+					steps := *plan.Do
+					Expect(steps[3].Do).To(Equal(&atc.DoPlan{}))
+				})
+
+				It("does not create a job build", func() {
+					Expect(fakeJob.CreateBuildCallCount()).To(BeZero())
+				})
+
+				Context("when vars are given", func() {
+					BeforeEach(func() {
+						requestBody = `{"artifacts":{"some-input":42},"vars":{"task_file":"task.yml"}}`
+					})
+
+					It("interpolates them into the job config", func() {
+						plan := fakePipeline.CreateStartedBuildArgsForCall(0)
+
+						steps := *plan.Do
+						Expect(steps[2].Task.ConfigPath).To(Equal("some-input/task.yml"))
+					})
+				})
+
+				Context("when an artifact is given for an unknown input", func() {
+					BeforeEach(func() {
+						requestBody = `{"artifacts":{"bogus-input":42}}`
+					})
+
+					It("returns 400 with an error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("job has no input named 'bogus-input'")))
+					})
+				})
+
+				Context("when an input has no version and no artifact", func() {
+					BeforeEach(func() {
+						requestBody = `{}`
+					})
+
+					It("returns 400 with an error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("version for input some-input not provided"))
+					})
+
+					It("does not create a build", func() {
+						Expect(fakePipeline.CreateStartedBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when creating the build fails", func() {
+					BeforeEach(func() {
+						fakePipeline.CreateStartedBuildReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
 })
 
 func fakeDBResourceType(t atc.VersionedResourceType) *dbfakes.FakeResourceType {
@@ -39,6 +39,13 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		config, err := job.Config()
+		if err != nil {
+			logger.Error("could-not-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		finished, next, err := job.FinishedAndNextBuild()
 		if err != nil {
 			logger.Error("could-not-get-job-finished-and-next-build", err)
@@ -51,7 +58,7 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
-		err = json.NewEncoder(w).Encode(present.Job(
+		presentedJob := present.Job(
 			teamName,
 			job,
 			inputs,
@@ -59,7 +66,10 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			finished,
 			next,
 			nil,
-		))
+		)
+		presentedJob.DependsOn = config.DependsOn
+
+		err = json.NewEncoder(w).Encode(presentedJob)
 		if err != nil {
 			logger.Error("failed-to-encode-job", err)
 			w.WriteHeader(http.StatusInternalServerError)
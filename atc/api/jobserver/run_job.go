@@ -0,0 +1,165 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+	"sigs.k8s.io/yaml"
+)
+
+// RunJob runs a job's plan as a one-off build of the pipeline. The build
+// doesn't show up in the job's history, and its put and set_pipeline steps
+// are left out so that it can't trigger other jobs.
+func (s *Server) RunJob(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("run-job")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var req atc.RunJobRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		jobConfig, err := job.Config()
+		if err != nil {
+			logger.Error("failed-to-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if len(req.Vars) != 0 {
+			jobConfig, err = interpolateJobConfig(jobConfig, vars.StaticVariables(req.Vars))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "failed to interpolate vars: %s", err)
+				return
+			}
+		}
+
+		for name := range req.Artifacts {
+			if !hasInput(jobConfig, name) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "job has no input named '%s'", name)
+				return
+			}
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		schedulerResources := db.SchedulerResources{}
+		for _, resource := range resources {
+			schedulerResources = append(schedulerResources, db.SchedulerResource{
+				Name:   resource.Name(),
+				Type:   resource.Type(),
+				Source: resource.Source(),
+			})
+		}
+
+		resourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			logger.Error("failed-to-get-resource-types", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// the inputs may not have been determined yet, in which case every
+		// get step has to be given an artifact
+		buildInputs, _, err := job.GetFullNextBuildInputs()
+		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		planner := builds.NewPlanner(atc.NewPlanFactory(time.Now().Unix()))
+
+		plan, err := planner.CreateOneOff(
+			jobConfig.StepConfig(),
+			schedulerResources,
+			resourceTypes.Deserialize(),
+			buildInputs,
+			req.Artifacts,
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "failed to plan job: %s", err)
+			return
+		}
+
+		build, err := pipeline.CreateStartedBuild(plan)
+		if err != nil {
+			logger.Error("failed-to-create-one-off-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(present.Build(build))
+		if err != nil {
+			logger.Error("failed-to-encode-build", err)
+		}
+	})
+}
+
+func interpolateJobConfig(config atc.JobConfig, variables vars.Variables) (atc.JobConfig, error) {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return atc.JobConfig{}, err
+	}
+
+	// leave vars that weren't given for the build to resolve at runtime
+	evaluated, err := vars.NewTemplate(payload).Evaluate(variables, vars.EvaluateOpts{})
+	if err != nil {
+		return atc.JobConfig{}, err
+	}
+
+	var interpolated atc.JobConfig
+	err = yaml.Unmarshal(evaluated, &interpolated)
+	if err != nil {
+		return atc.JobConfig{}, err
+	}
+
+	return interpolated, nil
+}
+
+func hasInput(config atc.JobConfig, name string) bool {
+	for _, input := range config.Inputs() {
+		if input.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
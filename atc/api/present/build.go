@@ -29,6 +29,11 @@ func Build(build db.Build) atc.Build {
 		TeamName:             build.TeamName(),
 		Status:               atc.BuildStatus(build.Status()),
 		APIURL:               apiURL,
+		Cause:                build.Cause(),
+	}
+
+	if build.Status() == db.BuildStatusStarted && build.AwaitingApproval() != "" {
+		atcBuild.Status = atc.StatusAwaitingApproval
 	}
 
 	if build.RerunOf() != 0 {
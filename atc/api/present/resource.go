@@ -1,11 +1,13 @@
 package present
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
-func Resource(resource db.Resource) atc.Resource {
+func Resource(resource db.Resource, nextCheck time.Time) atc.Resource {
 	atcResource := atc.Resource{
 		Name:                 resource.Name(),
 		PipelineID:           resource.PipelineID(),
@@ -15,6 +17,8 @@ func Resource(resource db.Resource) atc.Resource {
 		Type:                 resource.Type(),
 		Icon:                 resource.Icon(),
 
+		CheckFailures: resource.CheckFailures(),
+
 		PinComment: resource.PinComment(),
 
 		Build: resource.BuildSummary(),
@@ -24,12 +28,21 @@ func Resource(resource db.Resource) atc.Resource {
 		atcResource.LastChecked = resource.LastCheckEndTime().Unix()
 	}
 
+	if !nextCheck.IsZero() {
+		atcResource.NextCheck = nextCheck.Unix()
+	}
+
 	if resource.ConfigPinnedVersion() != nil {
 		atcResource.PinnedVersion = resource.ConfigPinnedVersion()
 		atcResource.PinnedInConfig = true
 	} else if resource.APIPinnedVersion() != nil {
 		atcResource.PinnedVersion = resource.APIPinnedVersion()
 		atcResource.PinnedInConfig = false
+		atcResource.PinnedBy = resource.PinnedBy()
+
+		if !resource.PinExpiresAt().IsZero() {
+			atcResource.PinExpiresAt = resource.PinExpiresAt().Unix()
+		}
 	}
 
 	return atcResource
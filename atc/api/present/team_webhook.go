@@ -0,0 +1,14 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// TeamWebhook presents the webhook without its secret.
+func TeamWebhook(webhook db.TeamWebhook) atc.TeamWebhook {
+	config := webhook.Config()
+	config.Name = webhook.Name()
+	config.Secret = ""
+	return config
+}
@@ -32,6 +32,8 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ActiveContainers: workerInfo.ActiveContainers(),
 		ActiveVolumes:    workerInfo.ActiveVolumes(),
 		ActiveTasks:      activeTasks,
+		ResourcePressure: workerInfo.ResourcePressure(),
+		StalledReason:    workerInfo.StalledReason(),
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
@@ -40,6 +42,8 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		State:            string(workerInfo.State()),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+
+		DiagnosticsRequested: workerInfo.DiagnosticsRequested(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
@@ -1131,6 +1131,44 @@ var _ = Describe("Resources API", func() {
 					})
 				})
 
+				Context("when the resource has failing checks", func() {
+					BeforeEach(func() {
+						resource1 := new(dbfakes.FakeResource)
+						resource1.TeamNameReturns("a-team")
+						resource1.PipelineIDReturns(1)
+						resource1.PipelineNameReturns("a-pipeline")
+						resource1.NameReturns("resource-1")
+						resource1.TypeReturns("type-1")
+						resource1.LastCheckEndTimeReturns(time.Unix(1513364881, 0))
+						resource1.CheckFailuresReturns(3)
+
+						fakePipeline.ResourceReturns(resource1, true, nil)
+						dbCheckFactory.NextCheckTimeReturns(time.Unix(1513365361, 0))
+					})
+
+					It("asks the check factory when the resource is next checked", func() {
+						Expect(dbCheckFactory.NextCheckTimeCallCount()).To(Equal(1))
+						Expect(dbCheckFactory.NextCheckTimeArgsForCall(0).Name()).To(Equal("resource-1"))
+					})
+
+					It("returns the failures and the next check time", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_id": 1,
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"last_checked": 1513364881,
+								"next_check": 1513365361,
+								"check_failures": 3
+							}`))
+					})
+				})
+
 				Context("when the resource version is pinned via the API", func() {
 					BeforeEach(func() {
 						resource1 := new(dbfakes.FakeResource)
@@ -1204,6 +1242,40 @@ var _ = Describe("Resources API", func() {
 							}`))
 					})
 				})
+
+				Context("when the resource's pin expires", func() {
+					BeforeEach(func() {
+						resource1 := new(dbfakes.FakeResource)
+						resource1.TeamNameReturns("a-team")
+						resource1.PipelineIDReturns(1)
+						resource1.PipelineNameReturns("a-pipeline")
+						resource1.NameReturns("resource-1")
+						resource1.TypeReturns("type-1")
+						resource1.LastCheckEndTimeReturns(time.Unix(1513364881, 0))
+						resource1.APIPinnedVersionReturns(atc.Version{"version": "v1"})
+						resource1.PinnedByReturns("some-user")
+						resource1.PinExpiresAtReturns(time.Unix(1513368481, 0))
+						fakePipeline.ResourceReturns(resource1, true, nil)
+					})
+
+					It("returns who pinned it and when it expires in the response json", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_id": 1,
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"last_checked": 1513364881,
+								"pinned_version": {"version": "v1"},
+								"pinned_by": "some-user",
+								"pin_expires_at": 1513368481
+							}`))
+					})
+				})
 			})
 		})
 
@@ -30,7 +30,7 @@ func (s *Server) GetResource(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		resource := present.Resource(dbResource)
+		resource := present.Resource(dbResource, s.checkFactory.NextCheckTime(dbResource))
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
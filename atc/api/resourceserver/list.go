@@ -24,7 +24,7 @@ func (s *Server) ListResources(pipeline db.Pipeline) http.Handler {
 		for _, resource := range resources {
 			presentedResources = append(
 				presentedResources,
-				present.Resource(resource),
+				present.Resource(resource, s.checkFactory.NextCheckTime(resource)),
 			)
 		}
 
@@ -35,7 +35,7 @@ func (s *Server) ListAllResources(w http.ResponseWriter, r *http.Request) {
 	for _, resource := range dbResources {
 		resources = append(
 			resources,
-			present.Resource(resource),
+			present.Resource(resource, s.checkFactory.NextCheckTime(resource)),
 		)
 	}
 
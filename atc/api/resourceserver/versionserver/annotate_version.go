@@ -0,0 +1,66 @@
+package versionserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) AnnotateResourceVersion(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("annotate-resource-version")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+		resource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		resourceConfigVersionID, err := strconv.Atoi(r.FormValue(":resource_config_version_id"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		label := r.FormValue(":label")
+
+		err = atc.ValidateVersionLabel(label)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", err)
+			return
+		}
+
+		claims := accessor.GetAccessor(r).Claims()
+
+		author := claims.PreferredUsername
+		if author == "" {
+			author = claims.UserName
+		}
+
+		found, err = resource.AnnotateVersion(resourceConfigVersionID, label, author)
+		if err != nil {
+			logger.Error("failed-to-annotate-resource-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-version-id-not-found", lager.Data{"resource_config_version_id": resourceConfigVersionID})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -1,10 +1,16 @@
 package versionserver
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -30,7 +36,34 @@ func (s *Server) PinResourceVersion(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		found, err = resource.PinVersion(resourceConfigVersionID)
+		// the body is optional, as pins didn't always expire
+		var reqBody atc.PinVersionRequestBody
+		err = json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var expiresAt time.Time
+		if reqBody.ExpiresAt != 0 {
+			expiresAt = time.Unix(reqBody.ExpiresAt, 0)
+
+			if !expiresAt.After(time.Now()) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "pin expiry must be in the future")
+				return
+			}
+		}
+
+		claims := accessor.GetAccessor(r).Claims()
+
+		pinnedBy := claims.PreferredUsername
+		if pinnedBy == "" {
+			pinnedBy = claims.UserName
+		}
+
+		found, err = resource.PinVersion(resourceConfigVersionID, pinnedBy, expiresAt)
 		if err != nil {
 			logger.Error("failed-to-pin-resource-version", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,49 @@
+package versionserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) RemoveVersionAnnotation(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("remove-version-annotation")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+		resource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		resourceConfigVersionID, err := strconv.Atoi(r.FormValue(":resource_config_version_id"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		label := r.FormValue(":label")
+
+		found, err = resource.RemoveVersionAnnotation(resourceConfigVersionID, label)
+		if err != nil {
+			logger.Error("failed-to-remove-version-annotation", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("version-annotation-not-found", lager.Data{"resource_config_version_id": resourceConfigVersionID, "label": label})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,531 @@
+package api_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team Webhooks API", func() {
+	var (
+		fakeTeam    *dbfakes.FakeTeam
+		fakeWebhook *dbfakes.FakeTeamWebhook
+		response    *http.Response
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("some-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+		fakeWebhook = new(dbfakes.FakeTeamWebhook)
+		fakeWebhook.NameReturns("some-webhook")
+		fakeWebhook.ConfigReturns(atc.TeamWebhook{
+			Name:          "some-webhook",
+			Type:          atc.WebhookTypeGitHub,
+			Secret:        "some-secret",
+			ResourceTypes: []string{"git"},
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/webhooks", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/webhooks")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.WebhooksCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the webhooks succeeds", func() {
+				BeforeEach(func() {
+					fakeTeam.WebhooksReturns([]db.TeamWebhook{fakeWebhook}, nil)
+				})
+
+				It("returns the webhooks without their secrets", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-webhook",
+							"type": "github",
+							"resource_types": ["git"]
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the webhooks fails", func() {
+				BeforeEach(func() {
+					fakeTeam.WebhooksReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/webhooks/:webhook_name", func() {
+		var config atc.TeamWebhook
+
+		BeforeEach(func() {
+			config = atc.TeamWebhook{
+				Type:   atc.WebhookTypeGitHub,
+				Secret: "some-secret",
+			}
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/webhooks/some-webhook", jsonEncode(config))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SetWebhookCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeTeam.SetWebhookReturns(fakeWebhook, true, nil)
+			})
+
+			It("saves the webhook with the name from the url", func() {
+				Expect(fakeTeam.SetWebhookCallCount()).To(Equal(1))
+				Expect(fakeTeam.SetWebhookArgsForCall(0)).To(Equal(atc.TeamWebhook{
+					Name:   "some-webhook",
+					Type:   atc.WebhookTypeGitHub,
+					Secret: "some-secret",
+				}))
+			})
+
+			It("returns 201 with the webhook", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{
+					"name": "some-webhook",
+					"type": "github",
+					"resource_types": ["git"]
+				}`))
+			})
+
+			Context("when the webhook already existed", func() {
+				BeforeEach(func() {
+					fakeTeam.SetWebhookReturns(fakeWebhook, false, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+
+			Context("when the webhook is invalid", func() {
+				BeforeEach(func() {
+					config.Type = "some-type"
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("unknown webhook type 'some-type'"))
+
+					Expect(fakeTeam.SetWebhookCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving the webhook fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SetWebhookReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/webhooks/:webhook_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/webhooks/some-webhook", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the webhook exists", func() {
+				BeforeEach(func() {
+					fakeTeam.DeleteWebhookReturns(true, nil)
+				})
+
+				It("deletes it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(fakeTeam.DeleteWebhookCallCount()).To(Equal(1))
+					Expect(fakeTeam.DeleteWebhookArgsForCall(0)).To(Equal("some-webhook"))
+				})
+			})
+
+			Context("when the webhook does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.DeleteWebhookReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.DeleteWebhookCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/webhooks/:webhook_name/deliveries", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/webhooks/some-webhook/deliveries" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				fakeTeam.WebhookReturns(fakeWebhook, true, nil)
+				fakeWebhook.DeliveriesReturns([]atc.WebhookDelivery{
+					{
+						ID:         1,
+						Webhook:    "some-webhook",
+						Event:      "push",
+						ReceivedAt: 42,
+						StatusCode: 200,
+					},
+				}, nil)
+			})
+
+			It("returns the deliveries", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(fakeTeam.WebhookArgsForCall(0)).To(Equal("some-webhook"))
+				Expect(fakeWebhook.DeliveriesArgsForCall(0)).To(Equal(db.MaxWebhookDeliveries))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 1,
+						"webhook": "some-webhook",
+						"event": "push",
+						"received_at": 42,
+						"status_code": 200
+					}
+				]`))
+			})
+
+			Context("with a limit", func() {
+				BeforeEach(func() {
+					query = "?limit=5"
+				})
+
+				It("passes it through", func() {
+					Expect(fakeWebhook.DeliveriesArgsForCall(0)).To(Equal(5))
+				})
+			})
+
+			Context("with a malformed limit", func() {
+				BeforeEach(func() {
+					query = "?limit=lots"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeWebhook.DeliveriesCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				fakeTeam.WebhookReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/webhooks/:webhook_name", func() {
+		var (
+			payload     []byte
+			contentType string
+			signature   string
+
+			matchingResource  *dbfakes.FakeResource
+			otherRepoResource *dbfakes.FakeResource
+			otherTypeResource *dbfakes.FakeResource
+			fakePipeline      *dbfakes.FakePipeline
+			fakeResourceTypes db.ResourceTypes
+			fakeBuild         *dbfakes.FakeBuild
+		)
+
+		sign := func(secret string, body []byte) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write(body)
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		BeforeEach(func() {
+			payload = []byte(`{"repository": {"full_name": "some-org/some-repo"}}`)
+			contentType = "application/json"
+			signature = sign("some-secret", payload)
+
+			fakeTeam.WebhookReturns(fakeWebhook, true, nil)
+
+			fakePipeline = new(dbfakes.FakePipeline)
+			fakeResourceTypes = db.ResourceTypes{new(dbfakes.FakeResourceType)}
+			fakePipeline.ResourceTypesReturns(fakeResourceTypes, nil)
+
+			matchingResource = new(dbfakes.FakeResource)
+			matchingResource.NameReturns("some-resource")
+			matchingResource.PipelineIDReturns(1)
+			matchingResource.PipelineNameReturns("some-pipeline")
+			matchingResource.TypeReturns("git")
+			matchingResource.SourceReturns(atc.Source{"uri": "https://github.com/some-org/some-repo.git"})
+			matchingResource.PipelineReturns(fakePipeline, true, nil)
+
+			otherRepoResource = new(dbfakes.FakeResource)
+			otherRepoResource.NameReturns("other-repo")
+			otherRepoResource.TypeReturns("git")
+			otherRepoResource.SourceReturns(atc.Source{"uri": "https://github.com/some-org/other-repo.git"})
+
+			otherTypeResource = new(dbfakes.FakeResource)
+			otherTypeResource.NameReturns("other-type")
+			otherTypeResource.TypeReturns("github-release")
+			otherTypeResource.SourceReturns(atc.Source{"uri": "https://github.com/some-org/some-repo.git"})
+
+			fakeTeam.ResourcesReturns([]db.Resource{matchingResource, otherRepoResource, otherTypeResource}, nil)
+
+			fakeBuild = new(dbfakes.FakeBuild)
+			fakeBuild.IDReturns(42)
+			dbCheckFactory.TryCreateCheckReturns(fakeBuild, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/webhooks/some-webhook", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Content-Type", contentType)
+			req.Header.Set("X-GitHub-Event", "push")
+			if signature != "" {
+				req.Header.Set("X-Hub-Signature-256", signature)
+			}
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not require authentication", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("checks the matching resources", func() {
+			Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+
+			_, checkable, resourceTypes, fromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+			Expect(checkable).To(Equal(matchingResource))
+			Expect(resourceTypes).To(Equal(fakeResourceTypes))
+			Expect(fromVersion).To(BeNil())
+			Expect(manuallyTriggered).To(BeTrue())
+		})
+
+		It("returns and records the delivery", func() {
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`{
+				"id": 0,
+				"webhook": "some-webhook",
+				"event": "push",
+				"received_at": 0,
+				"status_code": 200,
+				"values": {"repository.full_name": "some-org/some-repo"},
+				"checked_resources": [
+					{"pipeline_name": "some-pipeline", "resource": "some-resource", "build_id": 42}
+				]
+			}`))
+
+			Expect(fakeWebhook.SaveDeliveryCallCount()).To(Equal(1))
+			delivery := fakeWebhook.SaveDeliveryArgsForCall(0)
+			Expect(delivery.StatusCode).To(Equal(http.StatusOK))
+			Expect(delivery.CheckedResources).To(HaveLen(1))
+		})
+
+		Context("when creating the check fails", func() {
+			BeforeEach(func() {
+				dbCheckFactory.TryCreateCheckReturns(nil, false, errors.New("nope"))
+			})
+
+			It("records the error against the resource", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				delivery := fakeWebhook.SaveDeliveryArgsForCall(0)
+				Expect(delivery.CheckedResources).To(Equal([]atc.WebhookDeliveryResource{
+					{PipelineName: "some-pipeline", Resource: "some-resource", Error: "nope"},
+				}))
+			})
+		})
+
+		Context("when the signature is wrong", func() {
+			BeforeEach(func() {
+				signature = sign("other-secret", payload)
+			})
+
+			It("returns 401 without recording the delivery", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+
+				var delivery atc.WebhookDelivery
+				err := json.NewDecoder(response.Body).Decode(&delivery)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(delivery.Error).To(Equal("invalid signature"))
+
+				Expect(fakeWebhook.SaveDeliveryCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is not signed", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("returns 401 without recording the delivery", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+				Expect(fakeWebhook.SaveDeliveryCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is form-encoded", func() {
+			BeforeEach(func() {
+				contentType = "application/x-www-form-urlencoded"
+			})
+
+			It("returns 415 without recording the delivery", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnsupportedMediaType))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+
+				var delivery atc.WebhookDelivery
+				err := json.NewDecoder(response.Body).Decode(&delivery)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(delivery.Error).To(Equal("payload must be sent as application/json"))
+
+				Expect(fakeWebhook.SaveDeliveryCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is malformed", func() {
+			BeforeEach(func() {
+				payload = []byte(`{`)
+				signature = sign("some-secret", payload)
+			})
+
+			It("returns 400 and records the delivery", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+
+				Expect(fakeWebhook.SaveDeliveryCallCount()).To(Equal(1))
+				delivery := fakeWebhook.SaveDeliveryArgsForCall(0)
+				Expect(delivery.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				fakeTeam.WebhookReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when getting the resources fails", func() {
+			BeforeEach(func() {
+				fakeTeam.ResourcesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -1,13 +1,16 @@
 package api_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -159,6 +162,38 @@ var _ = Describe("Versions API", func() {
 					})
 				})
 
+				Context("when a version is annotated", func() {
+					BeforeEach(func() {
+						fakeResource.PublicReturns(true)
+						fakeResource.VersionsReturns([]atc.ResourceVersion{
+							{
+								ID:      4,
+								Enabled: true,
+								Version: atc.Version{"some": "version"},
+								Annotations: []atc.VersionAnnotation{
+									{Label: "qa-approved", Author: "some-user", CreatedAt: 1611600000},
+								},
+							},
+						}, db.Pagination{}, true, nil)
+					})
+
+					It("returns the annotations with the version", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"id": 4,
+								"enabled": true,
+								"version": {"some":"version"},
+								"annotations": [
+									{"label": "qa-approved", "author": "some-user", "created_at": 1611600000}
+								]
+							}
+						]`))
+					})
+				})
+
 				Context("when resource is not public", func() {
 					Context("when the user is not authenticated", func() {
 						It("returns the json without version metadata", func() {
@@ -681,11 +716,16 @@ var _ = Describe("Versions API", func() {
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/pin", func() {
 		var response *http.Response
 		var fakeResource *dbfakes.FakeResource
+		var body io.Reader
+
+		BeforeEach(func() {
+			body = nil
+		})
 
 		JustBeforeEach(func() {
 			var err error
 
-			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/pin", nil)
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/pin", body)
 			Expect(err).NotTo(HaveOccurred())
 
 			response, err = client.Do(request)
@@ -715,8 +755,59 @@ var _ = Describe("Versions API", func() {
 					})
 
 					It("tries to pin the right resource config version", func() {
-						resourceConfigVersionID := fakeResource.PinVersionArgsForCall(0)
+						resourceConfigVersionID, _, expiresAt := fakeResource.PinVersionArgsForCall(0)
 						Expect(resourceConfigVersionID).To(Equal(42))
+						Expect(expiresAt).To(BeZero())
+					})
+
+					Context("when the pinning user is known", func() {
+						BeforeEach(func() {
+							fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user", PreferredUsername: "some-preferred-user"})
+						})
+
+						It("records who pinned it", func() {
+							_, pinnedBy, _ := fakeResource.PinVersionArgsForCall(0)
+							Expect(pinnedBy).To(Equal("some-preferred-user"))
+						})
+					})
+
+					Context("when the pin has an expiry", func() {
+						var expiresAt time.Time
+
+						BeforeEach(func() {
+							expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+							body = bytes.NewBufferString(fmt.Sprintf(`{"expires_at":%d}`, expiresAt.Unix()))
+							fakeResource.PinVersionReturns(true, nil)
+						})
+
+						It("pins the version until then", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+							_, _, pinExpiresAt := fakeResource.PinVersionArgsForCall(0)
+							Expect(pinExpiresAt).To(BeTemporally("==", expiresAt))
+						})
+					})
+
+					Context("when the pin expiry has passed", func() {
+						BeforeEach(func() {
+							body = bytes.NewBufferString(fmt.Sprintf(`{"expires_at":%d}`, time.Now().Add(-time.Hour).Unix()))
+						})
+
+						It("returns 400 without pinning", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("pin expiry must be in the future")))
+							Expect(fakeResource.PinVersionCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when the body is malformed", func() {
+						BeforeEach(func() {
+							body = bytes.NewBufferString(`{`)
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
 					})
 
 					Context("when pinning the resource succeeds", func() {
@@ -792,6 +883,190 @@ var _ = Describe("Versions API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/annotations/:label", func() {
+		var response *http.Response
+		var fakeResource *dbfakes.FakeResource
+		var label string
+
+		BeforeEach(func() {
+			label = "qa-approved"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/annotations/"+label, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+			})
+
+			Context("when finding the resource succeeds", func() {
+				BeforeEach(func() {
+					fakeResource = new(dbfakes.FakeResource)
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+				})
+
+				Context("when annotating the version succeeds", func() {
+					BeforeEach(func() {
+						fakeResource.AnnotateVersionReturns(true, nil)
+					})
+
+					It("annotates the version on behalf of the user", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("resource-name"))
+
+						rcvID, annotatedLabel, author := fakeResource.AnnotateVersionArgsForCall(0)
+						Expect(rcvID).To(Equal(42))
+						Expect(annotatedLabel).To(Equal("qa-approved"))
+						Expect(author).To(Equal("some-user"))
+					})
+				})
+
+				Context("when the label is invalid", func() {
+					BeforeEach(func() {
+						label = "QA"
+					})
+
+					It("returns 400 without annotating", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("'QA' is not a valid version label"))
+						Expect(fakeResource.AnnotateVersionCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the version does not exist", func() {
+					BeforeEach(func() {
+						fakeResource.AnnotateVersionReturns(false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when annotating the version fails", func() {
+					BeforeEach(func() {
+						fakeResource.AnnotateVersionReturns(false, errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns not found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/annotations/:label", func() {
+		var response *http.Response
+		var fakeResource *dbfakes.FakeResource
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/annotations/qa-approved", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeResource = new(dbfakes.FakeResource)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+			})
+
+			Context("when the version has the label", func() {
+				BeforeEach(func() {
+					fakeResource.RemoveVersionAnnotationReturns(true, nil)
+				})
+
+				It("removes the label", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					rcvID, label := fakeResource.RemoveVersionAnnotationArgsForCall(0)
+					Expect(rcvID).To(Equal(42))
+					Expect(label).To(Equal("qa-approved"))
+				})
+			})
+
+			Context("when the version does not have the label", func() {
+				BeforeEach(func() {
+					fakeResource.RemoveVersionAnnotationReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when removing the label fails", func() {
+				BeforeEach(func() {
+					fakeResource.RemoveVersionAnnotationReturns(false, errors.New("welp"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/input_to", func() {
 		var response *http.Response
 		var stringVersionID string
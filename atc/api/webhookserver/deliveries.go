@@ -0,0 +1,60 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) ListTeamWebhookDeliveries(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookName := rata.Param(r, "webhook_name")
+
+		logger := s.logger.Session("list-team-webhook-deliveries", lager.Data{
+			"webhook": webhookName,
+		})
+
+		limit := db.MaxWebhookDeliveries
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit <= 0 {
+				logger.Info("malformed-limit", lager.Data{"limit": limitStr})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		webhook, found, err := team.Webhook(webhookName)
+		if err != nil {
+			logger.Error("failed-to-get-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("webhook-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		deliveries, err := webhook.Deliveries(limit)
+		if err != nil {
+			logger.Error("failed-to-get-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(deliveries)
+		if err != nil {
+			logger.Error("failed-to-encode-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
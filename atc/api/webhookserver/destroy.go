@@ -0,0 +1,34 @@
+package webhookserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) DestroyTeamWebhook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookName := rata.Param(r, "webhook_name")
+
+		logger := s.logger.Session("destroy-team-webhook", lager.Data{
+			"webhook": webhookName,
+		})
+
+		deleted, err := team.DeleteWebhook(webhookName)
+		if err != nil {
+			logger.Error("failed-to-delete-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !deleted {
+			logger.Info("webhook-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
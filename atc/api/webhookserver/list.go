@@ -0,0 +1,36 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListTeamWebhooks(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-team-webhooks")
+
+		webhooks, err := team.Webhooks()
+		if err != nil {
+			logger.Error("failed-to-get-webhooks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presentedWebhooks := []atc.TeamWebhook{}
+		for _, webhook := range webhooks {
+			presentedWebhooks = append(presentedWebhooks, present.TeamWebhook(webhook))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presentedWebhooks)
+		if err != nil {
+			logger.Error("failed-to-encode-webhooks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,197 @@
+package webhookserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhook"
+	"github.com/tedsuo/rata"
+)
+
+// maxPayloadSize matches the largest payload GitHub will deliver.
+const maxPayloadSize = 25 * 1024 * 1024
+
+// ReceiveTeamWebhook verifies a payload sent to one of the team's webhooks and
+// checks every resource in the team whose source matches it. Every verified
+// delivery is recorded so that misconfigured webhooks can be debugged.
+//
+// Deliveries which can't be verified are only logged: anyone who knows the
+// URL could send them, and recording them would let them push the real
+// deliveries out of the log.
+func (s *Server) ReceiveTeamWebhook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookName := rata.Param(r, "webhook_name")
+
+		logger := s.logger.Session("receive-team-webhook", lager.Data{
+			"team":    team.Name(),
+			"webhook": webhookName,
+		})
+
+		dbWebhook, found, err := team.Webhook(webhookName)
+		if err != nil {
+			logger.Error("failed-to-get-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("webhook-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		config := dbWebhook.Config()
+
+		delivery := atc.WebhookDelivery{
+			Webhook: dbWebhook.Name(),
+			Event:   webhook.Event(config.Type, r.Header),
+		}
+
+		// form-encoded payloads are consumed while the request is routed, so
+		// their signature can't be verified
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if mediaType != "application/json" {
+			logger.Info("unsupported-media-type", lager.Data{"media-type": mediaType})
+			delivery.StatusCode = http.StatusUnsupportedMediaType
+			delivery.Error = "payload must be sent as application/json"
+			s.respond(logger, w, delivery)
+			return
+		}
+
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+		if err != nil {
+			logger.Error("failed-to-read-payload", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = webhook.Verify(config.Type, config.Secret, r.Header, body)
+		if err != nil {
+			logger.Info("invalid-signature", lager.Data{"error": err.Error()})
+			delivery.StatusCode = http.StatusUnauthorized
+			delivery.Error = err.Error()
+			s.respond(logger, w, delivery)
+			return
+		}
+
+		payload, err := webhook.ParsePayload(body)
+		if err != nil {
+			delivery.StatusCode = http.StatusBadRequest
+			delivery.Error = fmt.Sprintf("malformed payload: %s", err)
+			s.deliver(logger, w, dbWebhook, delivery)
+			return
+		}
+
+		rules := config.MatchRules()
+		delivery.Values = webhook.ExtractValues(payload, rules)
+
+		resources, err := team.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		ctx := lagerctx.NewContext(context.Background(), logger)
+		resourceTypesByPipeline := map[int]db.ResourceTypes{}
+
+		for _, resource := range resources {
+			if !matchesType(resource, config.ResourceTypes) || !webhook.Matches(resource.Source(), rules, delivery.Values) {
+				continue
+			}
+
+			checked := atc.WebhookDeliveryResource{
+				PipelineName:         resource.PipelineName(),
+				PipelineInstanceVars: resource.PipelineInstanceVars(),
+				Resource:             resource.Name(),
+			}
+
+			buildID, err := s.check(ctx, resource, resourceTypesByPipeline)
+			if err != nil {
+				logger.Error("failed-to-check-resource", err, lager.Data{"resource": resource.Name()})
+				checked.Error = err.Error()
+			}
+
+			checked.BuildID = buildID
+
+			delivery.CheckedResources = append(delivery.CheckedResources, checked)
+		}
+
+		delivery.StatusCode = http.StatusOK
+		s.deliver(logger, w, dbWebhook, delivery)
+	})
+}
+
+func (s *Server) check(ctx context.Context, resource db.Resource, resourceTypesByPipeline map[int]db.ResourceTypes) (int, error) {
+	resourceTypes, found := resourceTypesByPipeline[resource.PipelineID()]
+	if !found {
+		pipeline, found, err := resource.Pipeline()
+		if err != nil {
+			return 0, err
+		}
+
+		if !found {
+			return 0, fmt.Errorf("pipeline not found")
+		}
+
+		resourceTypes, err = pipeline.ResourceTypes()
+		if err != nil {
+			return 0, err
+		}
+
+		resourceTypesByPipeline[resource.PipelineID()] = resourceTypes
+	}
+
+	build, created, err := s.checkFactory.TryCreateCheck(ctx, resource, resourceTypes, nil, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if !created {
+		return 0, fmt.Errorf("check not created")
+	}
+
+	return build.ID(), nil
+}
+
+func (s *Server) deliver(logger lager.Logger, w http.ResponseWriter, dbWebhook db.TeamWebhook, delivery atc.WebhookDelivery) {
+	err := dbWebhook.SaveDelivery(delivery)
+	if err != nil {
+		logger.Error("failed-to-save-delivery", err)
+	}
+
+	s.respond(logger, w, delivery)
+}
+
+func (s *Server) respond(logger lager.Logger, w http.ResponseWriter, delivery atc.WebhookDelivery) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(delivery.StatusCode)
+
+	err := json.NewEncoder(w).Encode(delivery)
+	if err != nil {
+		logger.Error("failed-to-encode-delivery", err)
+	}
+}
+
+func matchesType(resource db.Resource, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+
+	for _, t := range types {
+		if resource.Type() == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) SetTeamWebhook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookName := rata.Param(r, "webhook_name")
+
+		logger := s.logger.Session("set-team-webhook", lager.Data{
+			"webhook": webhookName,
+		})
+
+		var config atc.TeamWebhook
+		err := json.NewDecoder(r.Body).Decode(&config)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		config.Name = webhookName
+
+		err = config.Validate()
+		if err != nil {
+			logger.Info("invalid-webhook", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		webhook, created, err := team.SetWebhook(config)
+		if err != nil {
+			logger.Error("failed-to-set-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if created {
+			w.WriteHeader(http.StatusCreated)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+
+		err = json.NewEncoder(w).Encode(present.TeamWebhook(webhook))
+		if err != nil {
+			logger.Error("failed-to-encode-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -1024,4 +1024,296 @@ var _ = Describe("Workers API", func() {
 			})
 		})
 	})
+
+	Describe("PUT /api/v1/workers/:worker_name/diagnostics", func() {
+		var (
+			response   *http.Response
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/some-worker/diagnostics", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			fakeWorker.NameReturns("some-worker")
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 202", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+			})
+
+			It("requests diagnostics from the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal("some-worker"))
+				Expect(fakeWorker.RequestDiagnosticsCallCount()).To(Equal(1))
+			})
+
+			Context("when requesting diagnostics fails", func() {
+				BeforeEach(func() {
+					fakeWorker.RequestDiagnosticsReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(false)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not request diagnostics", func() {
+				Expect(fakeWorker.RequestDiagnosticsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/workers/:worker_name/diagnostics", func() {
+		var (
+			response   *http.Response
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/workers/some-worker/diagnostics", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			fakeWorker.NameReturns("some-worker")
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the diagnostics have been reported", func() {
+				BeforeEach(func() {
+					fakeWorker.DiagnosticsReturns(atc.WorkerDiagnostics{
+						CollectedAt: 42,
+						Version:     "1.2.3",
+						Runtime:     "guardian",
+						Containers: []atc.WorkerDiagnosticsContainer{
+							{Handle: "some-handle", State: "active"},
+						},
+						Volumes: []atc.WorkerDiagnosticsVolume{},
+						Logs:    []string{"some-log"},
+					}, true, nil)
+				})
+
+				It("returns 200 with the diagnostics", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"collected_at": 42,
+						"version": "1.2.3",
+						"runtime": "guardian",
+						"containers": [{"handle": "some-handle", "state": "active"}],
+						"volumes": [],
+						"logs": ["some-log"]
+					}`))
+				})
+			})
+
+			Context("when the requested diagnostics have not been reported yet", func() {
+				BeforeEach(func() {
+					fakeWorker.DiagnosticsRequestedReturns(true)
+				})
+
+				It("returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("does not look up stale diagnostics", func() {
+					Expect(fakeWorker.DiagnosticsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the worker has never reported diagnostics", func() {
+				BeforeEach(func() {
+					fakeWorker.DiagnosticsReturns(atc.WorkerDiagnostics{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the diagnostics fails", func() {
+				BeforeEach(func() {
+					fakeWorker.DiagnosticsReturns(atc.WorkerDiagnostics{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(false)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/workers/:worker_name/diagnostics/report", func() {
+		var (
+			response   *http.Response
+			fakeWorker *dbfakes.FakeWorker
+			payload    string
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/some-worker/diagnostics/report", bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			payload = `{"collected_at":42,"version":"1.2.3","runtime":"guardian","containers":[],"volumes":[],"logs":["some-log"]}`
+
+			fakeWorker = new(dbfakes.FakeWorker)
+			fakeWorker.NameReturns("some-worker")
+			fakeWorker.TeamNameReturns("some-team")
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when authenticated as system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsSystemReturns(true)
+			})
+
+			It("returns 204", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the diagnostics", func() {
+				Expect(fakeWorker.SaveDiagnosticsCallCount()).To(Equal(1))
+				Expect(fakeWorker.SaveDiagnosticsArgsForCall(0)).To(Equal(atc.WorkerDiagnostics{
+					CollectedAt: 42,
+					Version:     "1.2.3",
+					Runtime:     "guardian",
+					Containers:  []atc.WorkerDiagnosticsContainer{},
+					Volumes:     []atc.WorkerDiagnosticsVolume{},
+					Logs:        []string{"some-log"},
+				}))
+			})
+
+			Context("when the payload is malformed", func() {
+				BeforeEach(func() {
+					payload = "{"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the worker has gone away", func() {
+				BeforeEach(func() {
+					fakeWorker.SaveDiagnosticsReturns(db.ErrWorkerNotPresent)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when saving the diagnostics fails", func() {
+				BeforeEach(func() {
+					fakeWorker.SaveDiagnosticsReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authorized as some other team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not save the diagnostics", func() {
+				Expect(fakeWorker.SaveDiagnosticsCallCount()).To(BeZero())
+			})
+		})
+	})
 })
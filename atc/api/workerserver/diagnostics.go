@@ -0,0 +1,127 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// RequestWorkerDiagnostics flags the worker so that the next heartbeat asks
+// it to collect a diagnostics bundle.
+func (s *Server) RequestWorkerDiagnostics(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("request-worker-diagnostics")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.RequestDiagnostics()
+	if err == db.ErrWorkerNotPresent {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-request-diagnostics", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// GetWorkerDiagnostics responds with the most recent diagnostics bundle, or
+// with no content if a requested bundle has not been reported yet.
+func (s *Server) GetWorkerDiagnostics(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-worker-diagnostics")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if worker.DiagnosticsRequested() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	diagnostics, found, err := worker.Diagnostics()
+	if err != nil {
+		logger.Error("failed-to-get-diagnostics", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(diagnostics)
+	if err != nil {
+		logger.Error("failed-to-encode-diagnostics", err)
+	}
+}
+
+// ReportWorkerDiagnostics saves a diagnostics bundle collected by a worker,
+// relayed by the TSA.
+func (s *Server) ReportWorkerDiagnostics(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("report-worker-diagnostics")
+	workerName := r.FormValue(":worker_name")
+
+	var diagnostics atc.WorkerDiagnostics
+	err := json.NewDecoder(r.Body).Decode(&diagnostics)
+	if err != nil {
+		logger.Error("failed-to-decode-diagnostics", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.SaveDiagnostics(diagnostics)
+	if err == db.ErrWorkerNotPresent {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-save-diagnostics", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
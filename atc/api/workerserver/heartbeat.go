@@ -57,6 +57,14 @@ func (s *Server) HeartbeatWorker(w http.ResponseWriter, r *http.Request) {
 		Tags:       registration.Tags,
 	}.Emit(s.logger)
 
+	if registration.ResourcePressure != nil {
+		metric.WorkerResourcePressure{
+			WorkerName: registration.Name,
+			Platform:   registration.Platform,
+			Pressure:   *registration.ResourcePressure,
+		}.Emit(s.logger)
+	}
+
 	savedWorker, err := s.dbWorkerFactory.HeartbeatWorker(registration, ttl)
 	if err == db.ErrWorkerNotPresent {
 		logger.Error("failed-to-find-worker", err)
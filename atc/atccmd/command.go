@@ -32,6 +32,7 @@ import (
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/component"
 	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
@@ -150,6 +151,9 @@ type RunCommand struct {
 	GlobalResourceCheckTimeout          time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval            time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
+	ResourceCheckMaxBackoffInterval     time.Duration `long:"resource-check-max-backoff-interval" default:"1h" description:"Maximum interval between checks of a resource whose checks keep failing. The interval doubles with every consecutive failure."`
+	ResourceCheckIdleThreshold          time.Duration `long:"resource-check-idle-threshold" default:"168h" description:"How long a resource may go without a new version before its checking interval starts to lengthen. 0 disables lengthening. Does not apply to resources with check_every configured."`
+	ResourceCheckMaxIdleInterval        time.Duration `long:"resource-check-max-idle-interval" default:"10m" description:"Maximum interval between checks of a resource that has not produced a new version within the idle threshold."`
 	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can be started per second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
 
 	ContainerPlacementStrategyOptions worker.ContainerPlacementStrategyOptions `group:"Container Placement Strategy"`
@@ -197,6 +201,8 @@ type RunCommand struct {
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	PinExpirerInterval time.Duration `long:"pin-expirer-interval" default:"10s" description:"Interval on which to unpin resource versions whose pins have expired."`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
@@ -244,6 +250,7 @@ type RunCommand struct {
 		EnableGlobalResources                bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 		EnableRedactSecrets                  bool `long:"enable-redact-secrets" description:"Enable redacting secrets in build logs."`
 		EnableBuildRerunWhenWorkerDisappears bool `long:"enable-rerun-when-worker-disappears" description:"Enable automatically build rerun when worker disappears or a network error occurs"`
+		EnableStepRescheduling               bool `long:"enable-step-rescheduling" description:"Enable rescheduling in-flight get, put, and check steps onto another worker when their worker starts landing."`
 		EnableAcrossStep                     bool `long:"enable-across-step" description:"Enable the experimental across step to be used in jobs. The API is subject to change."`
 		EnablePipelineInstances              bool `long:"enable-pipeline-instances" description:"Enable pipeline instances"`
 		EnableP2PVolumeStreaming             bool `long:"enable-p2p-volume-streaming" description:"Enable P2P volume streaming"`
@@ -251,6 +258,8 @@ type RunCommand struct {
 
 	BaseResourceTypeDefaults flag.File `long:"base-resource-type-defaults" description:"Base resource type defaults"`
 
+	PipelineLintRules flag.File `long:"pipeline-lint-rules" description:"File configuring the lint rules that pipeline configs must pass to be set. Rules with error severity reject the config; warnings are shown to the user."`
+
 	P2pVolumeStreamingTimeout time.Duration `long:"p2p-volume-streaming-timeout" description:"Timeout value of p2p volume streaming" default:"15m"`
 }
 
@@ -504,6 +513,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	atc.EnableGlobalResources = cmd.FeatureFlags.EnableGlobalResources
 	atc.EnableRedactSecrets = cmd.FeatureFlags.EnableRedactSecrets
 	atc.EnableBuildRerunWhenWorkerDisappears = cmd.FeatureFlags.EnableBuildRerunWhenWorkerDisappears
+	atc.EnableStepRescheduling = cmd.FeatureFlags.EnableStepRescheduling
 	atc.EnableAcrossStep = cmd.FeatureFlags.EnableAcrossStep
 	atc.EnablePipelineInstances = cmd.FeatureFlags.EnablePipelineInstances
 
@@ -786,7 +796,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.GardenRequestTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	pool := worker.NewPool(workerProvider, clock.NewClock())
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -799,7 +809,10 @@ func (cmd *RunCommand) constructAPIMembers(
 		Interval:            cmd.ResourceCheckingInterval,
 		IntervalWithWebhook: cmd.ResourceWithWebhookCheckingInterval,
 		Timeout:             cmd.GlobalResourceCheckTimeout,
-	})
+		MaxBackoffInterval:  cmd.ResourceCheckMaxBackoffInterval,
+		IdleThreshold:       cmd.ResourceCheckIdleThreshold,
+		MaxIdleInterval:     cmd.ResourceCheckMaxIdleInterval,
+	}, clock.NewClock())
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
@@ -996,7 +1009,10 @@ func (cmd *RunCommand) backendComponents(
 		Interval:            cmd.ResourceCheckingInterval,
 		IntervalWithWebhook: cmd.ResourceWithWebhookCheckingInterval,
 		Timeout:             cmd.GlobalResourceCheckTimeout,
-	})
+		MaxBackoffInterval:  cmd.ResourceCheckMaxBackoffInterval,
+		IdleThreshold:       cmd.ResourceCheckIdleThreshold,
+		MaxIdleInterval:     cmd.ResourceCheckMaxIdleInterval,
+	}, clock.NewClock())
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(dbConn, lockFactory)
@@ -1037,7 +1053,7 @@ func (cmd *RunCommand) backendComponents(
 		cmd.GardenRequestTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	pool := worker.NewPool(workerProvider, clock.NewClock())
 	artifactStreamer := worker.NewArtifactStreamer(pool, compressionLib)
 	artifactSourcer := worker.NewArtifactSourcer(compressionLib, pool, cmd.FeatureFlags.EnableP2PVolumeStreaming, cmd.P2pVolumeStreamingTimeout)
 
@@ -1097,7 +1113,7 @@ func (cmd *RunCommand) backendComponents(
 				Name:     atc.ComponentLidarScanner,
 				Interval: cmd.LidarScannerInterval,
 			},
-			Runnable: lidar.NewScanner(dbCheckFactory),
+			Runnable: lidar.NewScanner(dbCheckFactory, clock.NewClock()),
 		},
 		{
 			Component: atc.Component{
@@ -1118,6 +1134,24 @@ func (cmd *RunCommand) backendComponents(
 				cmd.JobSchedulingMaxInFlight,
 			),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentCronTrigger,
+				Interval: 10 * time.Second,
+			},
+			Runnable: scheduler.NewCronTrigger(dbJobFactory, clock.NewClock()),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentPinExpirer,
+				Interval: cmd.PinExpirerInterval,
+			},
+			Runnable: scheduler.NewPinExpirer(
+				db.NewResourceFactory(dbConn, lockFactory),
+				cmd.constructAuditor(logger),
+				clock.NewClock(),
+			),
+		},
 		{
 			Component: atc.Component{
 				Name:     atc.ComponentBuildTracker,
@@ -1178,6 +1212,8 @@ func (cmd *RunCommand) gcComponents(
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(gcConn, lockFactory)
+	dbPipelineFactory := db.NewPipelineFactory(gcConn, lockFactory)
+	dbResourceConfigVersionLifecycle := db.NewResourceConfigVersionLifecycle(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
@@ -1202,6 +1238,7 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
 		atc.ComponentCollectorPipelines:         gc.NewPipelineCollector(dbPipelineLifecycle),
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
+		atc.ComponentCollectorVersions:          gc.NewVersionRetentionCollector(dbPipelineFactory, dbResourceConfigVersionLifecycle),
 	}
 
 	var components []RunnableComponent
@@ -1655,6 +1692,7 @@ func (cmd *RunCommand) constructEngine(
 				defaultLimits,
 				strategy,
 				cmd.GlobalResourceCheckTimeout,
+				clock.NewClock(),
 			),
 			cmd.ExternalURL.String(),
 			rateLimiter,
@@ -1814,6 +1852,21 @@ func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenF
 	return accessor.NewVerifier(claimsCacher, validClients)
 }
 
+func (cmd *RunCommand) constructAuditor(logger lager.Logger) auditor.Auditor {
+	return auditor.NewAuditor(
+		cmd.Auditor.EnableBuildAuditLog,
+		cmd.Auditor.EnableContainerAuditLog,
+		cmd.Auditor.EnableJobAuditLog,
+		cmd.Auditor.EnablePipelineAuditLog,
+		cmd.Auditor.EnableResourceAuditLog,
+		cmd.Auditor.EnableSystemAuditLog,
+		cmd.Auditor.EnableTeamAuditLog,
+		cmd.Auditor.EnableWorkerAuditLog,
+		cmd.Auditor.EnableVolumeAuditLog,
+		logger,
+	)
+}
+
 func (cmd *RunCommand) constructAPIHandler(
 	logger lager.Logger,
 	reconfigurableSink *lager.ReconfigurableSink,
@@ -1845,24 +1898,18 @@ func (cmd *RunCommand) constructAPIHandler(
 
 	rejectArchivedHandlerFactory := pipelineserver.NewRejectArchivedHandlerFactory(teamFactory)
 
-	aud := auditor.NewAuditor(
-		cmd.Auditor.EnableBuildAuditLog,
-		cmd.Auditor.EnableContainerAuditLog,
-		cmd.Auditor.EnableJobAuditLog,
-		cmd.Auditor.EnablePipelineAuditLog,
-		cmd.Auditor.EnableResourceAuditLog,
-		cmd.Auditor.EnableSystemAuditLog,
-		cmd.Auditor.EnableTeamAuditLog,
-		cmd.Auditor.EnableWorkerAuditLog,
-		cmd.Auditor.EnableVolumeAuditLog,
-		logger,
-	)
+	aud := cmd.constructAuditor(logger)
 
 	customRoles, err := cmd.parseCustomRoles()
 	if err != nil {
 		return nil, err
 	}
 
+	pipelineLinter, err := cmd.constructPipelineLinter()
+	if err != nil {
+		return nil, err
+	}
+
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewConcurrentRequestLimitsWrappa(
 			logger,
@@ -1924,10 +1971,24 @@ func (cmd *RunCommand) constructAPIHandler(
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
+		pipelineLinter,
 		clock.NewClock(),
 	)
 }
 
+func (cmd *RunCommand) constructPipelineLinter() (configlint.Linter, error) {
+	if cmd.PipelineLintRules.Path() == "" {
+		return nil, nil
+	}
+
+	settings, err := configlint.LoadSettings(cmd.PipelineLintRules.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pipeline lint rules (%s): %w", cmd.PipelineLintRules, err)
+	}
+
+	return configlint.NewLinter(settings)
+}
+
 type tlsRedirectHandler struct {
 	matchHostname string
 	externalHost  string
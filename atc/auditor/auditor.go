@@ -38,6 +38,7 @@ func NewAuditor(
 
 type Auditor interface {
 	Audit(action string, userName string, r *http.Request)
+	AuditEvent(action string, userName string, data lager.Data)
 }
 
 type auditor struct {
@@ -63,11 +64,13 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.ApproveBuild,
 		atc.GetBuildPreparation,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
 		atc.GetArtifact,
+		atc.PatchArtifact,
 		atc.ListBuildArtifacts:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
@@ -82,6 +85,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.RunJob,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -112,12 +116,15 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.SetPinCommentOnResource,
 		atc.CheckResource,
 		atc.CheckResourceWebHook,
+		atc.ReceiveTeamWebhook,
 		atc.CheckResourceType,
 		atc.ListResourceVersions,
 		atc.GetResourceVersion,
 		atc.EnableResourceVersion,
 		atc.DisableResourceVersion,
 		atc.PinResourceVersion,
+		atc.AnnotateResourceVersion,
+		atc.RemoveVersionAnnotation,
 		atc.GetResourceCausality:
 		return a.EnableResourceAuditLog
 	case
@@ -142,7 +149,11 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.GetTeam,
+		atc.ListTeamWebhooks,
+		atc.SetTeamWebhook,
+		atc.DestroyTeamWebhook,
+		atc.ListTeamWebhookDeliveries:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
@@ -150,7 +161,10 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.PruneWorker,
 		atc.HeartbeatWorker,
 		atc.ListWorkers,
-		atc.DeleteWorker:
+		atc.DeleteWorker,
+		atc.RequestWorkerDiagnostics,
+		atc.GetWorkerDiagnostics,
+		atc.ReportWorkerDiagnostics:
 		return a.EnableWorkerAuditLog
 	case atc.ListVolumes,
 		atc.ListDestroyingVolumes,
@@ -167,3 +181,11 @@ func (a *auditor) Audit(action string, userName string, r *http.Request) {
 		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
 	}
 }
+
+// AuditEvent records an action that was not triggered by an API request, e.g.
+// one taken by a background component on behalf of the system.
+func (a *auditor) AuditEvent(action string, userName string, data lager.Data) {
+	if a.ValidateAction(action) {
+		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": data})
+	}
+}
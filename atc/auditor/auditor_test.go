@@ -3,6 +3,7 @@ package auditor_test
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
@@ -590,4 +591,32 @@ var _ = Describe("Audit", func() {
 			})
 		})
 	})
+
+	Describe("AuditEvent", func() {
+		Context("When the action's audit log is enabled", func() {
+			BeforeEach(func() {
+				EnableResourceAuditLog = true
+			})
+
+			It("creates a log including the action, user and parameters", func() {
+				aud.AuditEvent(atc.UnpinResource, "system", lager.Data{"resource": "some-resource"})
+				logs := logger.Logs()
+				Expect(logs).To(HaveLen(1))
+				Expect(logs[0].Data["action"]).To(Equal(atc.UnpinResource))
+				Expect(logs[0].Data["user"]).To(Equal("system"))
+				Expect(logs[0].Data["parameters"]).To(Equal(map[string]interface{}{"resource": "some-resource"}))
+			})
+		})
+
+		Context("When the action's audit log is disabled", func() {
+			BeforeEach(func() {
+				EnableResourceAuditLog = false
+			})
+
+			It("doesn't create a log", func() {
+				aud.AuditEvent(atc.UnpinResource, "system", lager.Data{"resource": "some-resource"})
+				Expect(logger.Logs()).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"sync"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/auditor"
 )
 
@@ -16,6 +17,13 @@ type FakeAuditor struct {
 		arg2 string
 		arg3 *http.Request
 	}
+	AuditEventStub        func(string, string, lager.Data)
+	auditEventMutex       sync.RWMutex
+	auditEventArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 lager.Data
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -53,11 +61,46 @@ func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request)
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeAuditor) AuditEvent(arg1 string, arg2 string, arg3 lager.Data) {
+	fake.auditEventMutex.Lock()
+	fake.auditEventArgsForCall = append(fake.auditEventArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 lager.Data
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuditEvent", []interface{}{arg1, arg2, arg3})
+	fake.auditEventMutex.Unlock()
+	if fake.AuditEventStub != nil {
+		fake.AuditEventStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeAuditor) AuditEventCallCount() int {
+	fake.auditEventMutex.RLock()
+	defer fake.auditEventMutex.RUnlock()
+	return len(fake.auditEventArgsForCall)
+}
+
+func (fake *FakeAuditor) AuditEventCalls(stub func(string, string, lager.Data)) {
+	fake.auditEventMutex.Lock()
+	defer fake.auditEventMutex.Unlock()
+	fake.AuditEventStub = stub
+}
+
+func (fake *FakeAuditor) AuditEventArgsForCall(i int) (string, string, lager.Data) {
+	fake.auditEventMutex.RLock()
+	defer fake.auditEventMutex.RUnlock()
+	argsForCall := fake.auditEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.auditMutex.RLock()
 	defer fake.auditMutex.RUnlock()
+	fake.auditEventMutex.RLock()
+	defer fake.auditEventMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
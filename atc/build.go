@@ -9,6 +9,10 @@ const (
 	StatusFailed    BuildStatus = "failed"
 	StatusErrored   BuildStatus = "errored"
 	StatusAborted   BuildStatus = "aborted"
+
+	// StatusAwaitingApproval is reported for a started build which is paused
+	// on an approval step.
+	StatusAwaitingApproval BuildStatus = "awaiting_approval"
 )
 
 func (status BuildStatus) String() string {
@@ -31,6 +35,17 @@ type Build struct {
 	ReapTime             int64         `json:"reap_time,omitempty"`
 	RerunNumber          int           `json:"rerun_number,omitempty"`
 	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
+	Cause                string        `json:"cause,omitempty"`
+}
+
+// BuildCauseScheduled is the cause of builds created by a job's schedule.
+const BuildCauseScheduled = "scheduled"
+
+// BuildApproval is the request body for approving or rejecting a build which
+// is awaiting approval.
+type BuildApproval struct {
+	Approved bool   `json:"approved"`
+	Comment  string `json:"comment,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -40,7 +55,7 @@ type RerunOfBuild struct {
 
 func (b Build) IsRunning() bool {
 	switch BuildStatus(b.Status) {
-	case StatusPending, StatusStarted:
+	case StatusPending, StatusStarted, StatusAwaitingApproval:
 		return true
 	default:
 		return false
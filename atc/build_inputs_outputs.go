@@ -22,4 +22,6 @@ type ResourceVersion struct {
 	Metadata []MetadataField `json:"metadata,omitempty"`
 	Version  Version         `json:"version"`
 	Enabled  bool            `json:"enabled"`
+
+	Annotations []VersionAnnotation `json:"annotations,omitempty"`
 }
@@ -37,6 +37,36 @@ func (planner Planner) Create(
 	return visitor.plan, nil
 }
 
+// CreateOneOff plans a job's steps for a one-off build. Get steps named in
+// artifacts fetch the given artifact instead of a resource version, and put
+// and set_pipeline steps are left out so that the build has no effect on the
+// pipeline.
+func (planner Planner) CreateOneOff(
+	planConfig atc.StepConfig,
+	resources db.SchedulerResources,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+	artifacts map[string]int,
+) (atc.Plan, error) {
+	visitor := &planVisitor{
+		planFactory: planner.planFactory,
+
+		resources:     resources,
+		resourceTypes: resourceTypes,
+		inputs:        inputs,
+
+		oneOff:    true,
+		artifacts: artifacts,
+	}
+
+	err := planConfig.Visit(visitor)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	return visitor.plan, nil
+}
+
 type planVisitor struct {
 	planFactory atc.PlanFactory
 
@@ -44,6 +74,9 @@ type planVisitor struct {
 	resourceTypes atc.VersionedResourceTypes
 	inputs        []db.BuildInput
 
+	oneOff    bool
+	artifacts map[string]int
+
 	plan atc.Plan
 }
 
@@ -60,6 +93,7 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
 		Timeout:           step.Timeout,
+		Restartable:       step.Restartable,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -68,6 +102,15 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 }
 
 func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
+	if artifactID, found := visitor.artifacts[step.Name]; found {
+		visitor.plan = visitor.planFactory.NewPlan(atc.ArtifactInputPlan{
+			ArtifactID: artifactID,
+			Name:       step.Name,
+		})
+
+		return nil
+	}
+
 	resourceName := step.Resource
 	if resourceName == "" {
 		resourceName = step.Name
@@ -110,6 +153,11 @@ func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
 }
 
 func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
+	if visitor.oneOff {
+		visitor.plan = visitor.planFactory.NewPlan(atc.DoPlan{})
+		return nil
+	}
+
 	logicalName := step.Name
 
 	resourceName := step.Resource
@@ -247,6 +295,11 @@ func cartesianProduct(vars []atc.AcrossVarConfig) [][]interface{} {
 }
 
 func (visitor *planVisitor) VisitSetPipeline(step *atc.SetPipelineStep) error {
+	if visitor.oneOff {
+		visitor.plan = visitor.planFactory.NewPlan(atc.DoPlan{})
+		return nil
+	}
+
 	visitor.plan = visitor.planFactory.NewPlan(atc.SetPipelinePlan{
 		Name:         step.Name,
 		File:         step.File,
@@ -270,6 +323,26 @@ func (visitor *planVisitor) VisitLoadVar(step *atc.LoadVarStep) error {
 	return nil
 }
 
+func (visitor *planVisitor) VisitApproval(step *atc.ApprovalStep) error {
+	approvers := step.Approvers
+	if approvers == 0 {
+		approvers = 1
+	}
+
+	role := step.Role
+	if role == "" {
+		role = "member"
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.ApprovalPlan{
+		Name:      step.Name,
+		Approvers: approvers,
+		Role:      role,
+	})
+
+	return nil
+}
+
 func (visitor *planVisitor) VisitTry(step *atc.TryStep) error {
 	err := step.Step.Config.Visit(visitor)
 	if err != nil {
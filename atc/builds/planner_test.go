@@ -28,6 +28,9 @@ type PlannerTest struct {
 	Config atc.StepConfig
 	Inputs []db.BuildInput
 
+	OneOff    bool
+	Artifacts map[string]int
+
 	CompareIDs bool
 	PlanJSON   string
 	Err        error
@@ -155,6 +158,56 @@ var factoryTests = []PlannerTest{
 		},
 		Err: builds.VersionNotProvidedError{Input: "some-name"},
 	},
+	{
+		Title: "one-off get step with an artifact",
+		Config: &atc.GetStep{
+			Name:     "some-name",
+			Resource: "some-resource",
+		},
+		OneOff:    true,
+		Artifacts: map[string]int{"some-name": 42},
+		PlanJSON: `{
+			"id": "(unique)",
+			"artifact_input": {
+				"artifact_id": 42,
+				"name": "some-name"
+			}
+		}`,
+	},
+	{
+		Title: "one-off get step without an artifact",
+		Config: &atc.GetStep{
+			Name:     "some-name",
+			Resource: "some-resource",
+		},
+		OneOff:    true,
+		Artifacts: map[string]int{"some-other-name": 42},
+		Err:       builds.VersionNotProvidedError{Input: "some-name"},
+	},
+	{
+		Title: "one-off put step",
+		Config: &atc.PutStep{
+			Name:     "some-name",
+			Resource: "some-resource",
+		},
+		OneOff: true,
+		PlanJSON: `{
+			"id": "(unique)",
+			"do": []
+		}`,
+	},
+	{
+		Title: "one-off set_pipeline step",
+		Config: &atc.SetPipelineStep{
+			Name: "some-pipeline",
+			File: "some-file",
+		},
+		OneOff: true,
+		PlanJSON: `{
+			"id": "(unique)",
+			"do": []
+		}`,
+	},
 	{
 		Title: "put step",
 		Config: &atc.PutStep{
@@ -243,6 +296,7 @@ var factoryTests = []PlannerTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			Restartable:       true,
 		},
 
 		PlanJSON: `{
@@ -262,6 +316,7 @@ var factoryTests = []PlannerTest{
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
 				"timeout": "1h",
+				"restartable": true,
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -316,6 +371,40 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "approval step",
+
+		Config: &atc.ApprovalStep{
+			Name:      "deploy-to-prod",
+			Approvers: 2,
+			Role:      "owner",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"approval": {
+				"name": "deploy-to-prod",
+				"approvers": 2,
+				"role": "owner"
+			}
+		}`,
+	},
+	{
+		Title: "approval step with defaults",
+
+		Config: &atc.ApprovalStep{
+			Name: "deploy-to-prod",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"approval": {
+				"name": "deploy-to-prod",
+				"approvers": 1,
+				"role": "member"
+			}
+		}`,
+	},
 	{
 		Title: "try step",
 
@@ -760,7 +849,13 @@ var factoryTests = []PlannerTest{
 func (test PlannerTest) Run(s *PlannerSuite) {
 	factory := builds.NewPlanner(atc.NewPlanFactory(0))
 
-	actualPlan, actualErr := factory.Create(test.Config, resources, resourceTypes, test.Inputs)
+	var actualPlan atc.Plan
+	var actualErr error
+	if test.OneOff {
+		actualPlan, actualErr = factory.CreateOneOff(test.Config, resources, resourceTypes, test.Inputs, test.Artifacts)
+	} else {
+		actualPlan, actualErr = factory.Create(test.Config, resources, resourceTypes, test.Inputs)
+	}
 
 	if test.Err != nil {
 		s.Equal(test.Err, actualErr)
@@ -4,6 +4,8 @@ import "time"
 
 const (
 	ComponentScheduler                  = "scheduler"
+	ComponentCronTrigger                = "cron_trigger"
+	ComponentPinExpirer                 = "pin_expirer"
 	ComponentBuildTracker               = "tracker"
 	ComponentLidarScanner               = "scanner"
 	ComponentBuildReaper                = "reaper"
@@ -17,6 +19,7 @@ const (
 	ComponentCollectorResourceCacheUses = "collector_resource_cache_uses"
 	ComponentCollectorResourceCaches    = "collector_resource_caches"
 	ComponentCollectorResourceConfigs   = "collector_resource_configs"
+	ComponentCollectorVersions          = "collector_versions"
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 	ComponentCollectorPipelines         = "collector_pipelines"
@@ -187,6 +187,16 @@ type ResourceConfig struct {
 	Tags         Tags        `json:"tags,omitempty"`
 	Version      Version     `json:"version,omitempty"`
 	Icon         string      `json:"icon,omitempty"`
+
+	VersionRetention *VersionRetention `json:"version_retention,omitempty"`
+}
+
+// VersionRetention limits how much of a resource's version history is kept.
+// A version is pruned once it is neither among the latest Versions nor newer
+// than Days; a criterion left at zero retains nothing on its own.
+type VersionRetention struct {
+	Versions int `json:"versions,omitempty"`
+	Days     int `json:"days,omitempty"`
 }
 
 type ResourceType struct {
@@ -337,3 +337,91 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 
 	return diffExists
 }
+
+// ConfigChange is a change to a single group, var source, resource, resource
+// type, or job of a pipeline config, or to its display config.
+type ConfigChange struct {
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name,omitempty"`
+	Change string      `json:"change"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+const (
+	ConfigChangeAdded   = "added"
+	ConfigChangeRemoved = "removed"
+	ConfigChangeChanged = "changed"
+)
+
+// Changes returns the structural differences between the config and the new
+// config, in the same order that Diff renders them.
+func (c Config) Changes(newConfig Config) []ConfigChange {
+	changes := []ConfigChange{}
+
+	appendChanges := func(kind string, diffs Diffs) {
+		for _, diff := range diffs {
+			change := diff.change(kind)
+
+			// a group which has changed and moved shows up twice
+			last := len(changes) - 1
+			if last >= 0 && changes[last].Kind == kind && changes[last].Name == change.Name {
+				continue
+			}
+
+			changes = append(changes, change)
+		}
+	}
+
+	appendChanges("group", groupDiffIndices(GroupIndex(c.Groups), GroupIndex(newConfig.Groups)))
+	appendChanges("var_source", diffIndices(VarSourceIndex(c.VarSources), VarSourceIndex(newConfig.VarSources)))
+	appendChanges("resource", diffIndices(ResourceIndex(c.Resources), ResourceIndex(newConfig.Resources)))
+	appendChanges("resource_type", diffIndices(ResourceTypeIndex(c.ResourceTypes), ResourceTypeIndex(newConfig.ResourceTypes)))
+	appendChanges("job", diffIndices(JobIndex(c.Jobs), JobIndex(newConfig.Jobs)))
+
+	displayDiff, diff := diffDisplay(c.Display, newConfig.Display)
+	if diff {
+		change := ConfigChange{
+			Kind:   "display",
+			Change: ConfigChangeChanged,
+		}
+
+		if displayDiff.Before != nil {
+			change.Before = displayDiff.Before
+		} else {
+			change.Change = ConfigChangeAdded
+		}
+
+		if displayDiff.After != nil {
+			change.After = displayDiff.After
+		} else {
+			change.Change = ConfigChangeRemoved
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func (diff Diff) change(kind string) ConfigChange {
+	change := ConfigChange{
+		Kind:   kind,
+		Before: diff.Before,
+		After:  diff.After,
+	}
+
+	switch {
+	case diff.Before != nil && diff.After != nil:
+		change.Name = name(diff.Before)
+		change.Change = ConfigChangeChanged
+	case diff.Before != nil:
+		change.Name = name(diff.Before)
+		change.Change = ConfigChangeRemoved
+	default:
+		change.Name = name(diff.After)
+		change.Change = ConfigChangeAdded
+	}
+
+	return change
+}
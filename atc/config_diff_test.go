@@ -216,4 +216,90 @@ var _ = Describe("Config diff", func() {
 			})
 		})
 	})
+
+	Describe("Changes", func() {
+		var oldConfig, newConfig Config
+
+		BeforeEach(func() {
+			oldConfig = Config{
+				Groups: GroupConfigs{
+					{Name: "some-group", Jobs: []string{"some-job"}},
+					{Name: "other-group", Jobs: []string{"other-job"}},
+				},
+				Resources: ResourceConfigs{
+					{Name: "some-resource", Type: "git"},
+					{Name: "removed-resource", Type: "git"},
+				},
+				Jobs: JobConfigs{
+					{Name: "some-job", Public: true},
+					{Name: "other-job"},
+				},
+			}
+
+			newConfig = Config{
+				Groups: GroupConfigs{
+					{Name: "other-group", Jobs: []string{"other-job", "some-job"}},
+					{Name: "some-group", Jobs: []string{"some-job"}},
+				},
+				Resources: ResourceConfigs{
+					{Name: "some-resource", Type: "git"},
+				},
+				Jobs: JobConfigs{
+					{Name: "some-job"},
+					{Name: "other-job"},
+					{Name: "added-job"},
+				},
+				Display: &DisplayConfig{
+					BackgroundImage: "some-background.jpg",
+				},
+			}
+		})
+
+		It("returns no changes for equal configs", func() {
+			Expect(oldConfig.Changes(oldConfig)).To(BeEmpty())
+		})
+
+		It("returns a change for each group, resource, and job that differs", func() {
+			Expect(oldConfig.Changes(newConfig)).To(Equal([]ConfigChange{
+				{
+					Kind:   "group",
+					Name:   "some-group",
+					Change: ConfigChangeChanged,
+					Before: oldConfig.Groups[0],
+					After:  newConfig.Groups[1],
+				},
+				{
+					Kind:   "group",
+					Name:   "other-group",
+					Change: ConfigChangeChanged,
+					Before: oldConfig.Groups[1],
+					After:  newConfig.Groups[0],
+				},
+				{
+					Kind:   "resource",
+					Name:   "removed-resource",
+					Change: ConfigChangeRemoved,
+					Before: oldConfig.Resources[1],
+				},
+				{
+					Kind:   "job",
+					Name:   "some-job",
+					Change: ConfigChangeChanged,
+					Before: oldConfig.Jobs[0],
+					After:  newConfig.Jobs[0],
+				},
+				{
+					Kind:   "job",
+					Name:   "added-job",
+					Change: ConfigChangeAdded,
+					After:  newConfig.Jobs[2],
+				},
+				{
+					Kind:   "display",
+					Change: ConfigChangeAdded,
+					After:  newConfig.Display,
+				},
+			}))
+		})
+	})
 })
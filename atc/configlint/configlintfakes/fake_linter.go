@@ -0,0 +1,113 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package configlintfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
+)
+
+type FakeLinter struct {
+	LintStub        func(string, atc.Config) []configlint.Violation
+	lintMutex       sync.RWMutex
+	lintArgsForCall []struct {
+		arg1 string
+		arg2 atc.Config
+	}
+	lintReturns struct {
+		result1 []configlint.Violation
+	}
+	lintReturnsOnCall map[int]struct {
+		result1 []configlint.Violation
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLinter) Lint(arg1 string, arg2 atc.Config) []configlint.Violation {
+	fake.lintMutex.Lock()
+	ret, specificReturn := fake.lintReturnsOnCall[len(fake.lintArgsForCall)]
+	fake.lintArgsForCall = append(fake.lintArgsForCall, struct {
+		arg1 string
+		arg2 atc.Config
+	}{arg1, arg2})
+	fake.recordInvocation("Lint", []interface{}{arg1, arg2})
+	fake.lintMutex.Unlock()
+	if fake.LintStub != nil {
+		return fake.LintStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lintReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLinter) LintCallCount() int {
+	fake.lintMutex.RLock()
+	defer fake.lintMutex.RUnlock()
+	return len(fake.lintArgsForCall)
+}
+
+func (fake *FakeLinter) LintCalls(stub func(string, atc.Config) []configlint.Violation) {
+	fake.lintMutex.Lock()
+	defer fake.lintMutex.Unlock()
+	fake.LintStub = stub
+}
+
+func (fake *FakeLinter) LintArgsForCall(i int) (string, atc.Config) {
+	fake.lintMutex.RLock()
+	defer fake.lintMutex.RUnlock()
+	argsForCall := fake.lintArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLinter) LintReturns(result1 []configlint.Violation) {
+	fake.lintMutex.Lock()
+	defer fake.lintMutex.Unlock()
+	fake.LintStub = nil
+	fake.lintReturns = struct {
+		result1 []configlint.Violation
+	}{result1}
+}
+
+func (fake *FakeLinter) LintReturnsOnCall(i int, result1 []configlint.Violation) {
+	fake.lintMutex.Lock()
+	defer fake.lintMutex.Unlock()
+	fake.LintStub = nil
+	if fake.lintReturnsOnCall == nil {
+		fake.lintReturnsOnCall = make(map[int]struct {
+			result1 []configlint.Violation
+		})
+	}
+	fake.lintReturnsOnCall[i] = struct {
+		result1 []configlint.Violation
+	}{result1}
+}
+
+func (fake *FakeLinter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.lintMutex.RLock()
+	defer fake.lintMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLinter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ configlint.Linter = new(FakeLinter)
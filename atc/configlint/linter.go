@@ -0,0 +1,211 @@
+package configlint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . Linter
+
+// Linter checks a pipeline config against a set of rules.
+type Linter interface {
+	Lint(team string, config atc.Config) []Violation
+}
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityOff     Severity = "off"
+)
+
+func (severity Severity) Validate() error {
+	switch severity {
+	case SeverityError, SeverityWarning, SeverityOff:
+		return nil
+	default:
+		return fmt.Errorf("invalid severity '%s' (must be one of error, warning, off)", severity)
+	}
+}
+
+// Violation is a finding of a rule, annotated with the severity the rule is
+// configured with.
+type Violation struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Location string   `json:"location"`
+	Message  string   `json:"message"`
+}
+
+func (violation Violation) String() string {
+	return fmt.Sprintf("%s: %s (%s)", violation.Location, violation.Message, violation.Rule)
+}
+
+// Finding is a single problem reported by a rule.
+type Finding struct {
+	Location string
+	Message  string
+}
+
+// Target is what a rule checks.
+type Target struct {
+	// Team is the name of the team the pipeline belongs to. It may be empty
+	// when linting offline.
+	Team string
+
+	Config atc.Config
+
+	// Options are the rule's options from the settings, if any.
+	Options Options
+}
+
+// Rule is a single check over a pipeline config.
+type Rule interface {
+	Name() string
+	Description() string
+	DefaultSeverity() Severity
+	Check(Target) ([]Finding, error)
+}
+
+var registeredRules = map[string]Rule{}
+
+// Register makes a rule available to linters. It panics if a rule with the
+// same name is already registered.
+func Register(rule Rule) {
+	if _, exists := registeredRules[rule.Name()]; exists {
+		panic("lint rule registered twice: " + rule.Name())
+	}
+
+	registeredRules[rule.Name()] = rule
+}
+
+// Rules returns every registered rule, sorted by name.
+func Rules() []Rule {
+	rules := make([]Rule, 0, len(registeredRules))
+	for _, rule := range registeredRules {
+		rules = append(rules, rule)
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name() < rules[j].Name()
+	})
+
+	return rules
+}
+
+type configuredRule struct {
+	rule     Rule
+	severity Severity
+	options  Options
+}
+
+type linter struct {
+	rules []configuredRule
+}
+
+// NewLinter returns a Linter running every registered rule, with severities
+// and options overridden by the settings.
+func NewLinter(settings Settings) (Linter, error) {
+	for name, ruleSettings := range settings.Rules {
+		if _, found := registeredRules[name]; !found {
+			return nil, fmt.Errorf("unknown lint rule '%s'", name)
+		}
+
+		if ruleSettings.Severity != "" {
+			err := ruleSettings.Severity.Validate()
+			if err != nil {
+				return nil, fmt.Errorf("rule '%s': %w", name, err)
+			}
+		}
+	}
+
+	var rules []configuredRule
+	for _, rule := range Rules() {
+		configured := configuredRule{
+			rule:     rule,
+			severity: rule.DefaultSeverity(),
+		}
+
+		ruleSettings, found := settings.Rules[rule.Name()]
+		if found {
+			if ruleSettings.Severity != "" {
+				configured.severity = ruleSettings.Severity
+			}
+
+			configured.options = ruleSettings.Options
+		}
+
+		if configured.severity == SeverityOff {
+			continue
+		}
+
+		rules = append(rules, configured)
+	}
+
+	return &linter{rules: rules}, nil
+}
+
+func (l *linter) Lint(team string, config atc.Config) []Violation {
+	var violations []Violation
+
+	for _, configured := range l.rules {
+		findings, err := configured.rule.Check(Target{
+			Team:    team,
+			Config:  config,
+			Options: configured.options,
+		})
+		if err != nil {
+			// a misconfigured rule can't be satisfied, so report it rather
+			// than letting every pipeline through
+			violations = append(violations, Violation{
+				Rule:     configured.rule.Name(),
+				Severity: configured.severity,
+				Message:  fmt.Sprintf("failed to run rule: %s", err),
+			})
+			continue
+		}
+
+		for _, finding := range findings {
+			violations = append(violations, Violation{
+				Rule:     configured.rule.Name(),
+				Severity: configured.severity,
+				Location: finding.Location,
+				Message:  finding.Message,
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].Location != violations[j].Location {
+			return violations[i].Location < violations[j].Location
+		}
+
+		return violations[i].Rule < violations[j].Rule
+	})
+
+	return violations
+}
+
+// Errors returns the violations with error severity.
+func Errors(violations []Violation) []Violation {
+	return filter(violations, SeverityError)
+}
+
+// Warnings returns the violations with warning severity.
+func Warnings(violations []Violation) []Violation {
+	return filter(violations, SeverityWarning)
+}
+
+func filter(violations []Violation, severity Severity) []Violation {
+	var filtered []Violation
+	for _, violation := range violations {
+		if violation.Severity == severity {
+			filtered = append(filtered, violation)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,205 @@
+package configlint_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Linter", func() {
+	var (
+		settings configlint.Settings
+		config   atc.Config
+		team     string
+
+		violations []configlint.Violation
+	)
+
+	BeforeEach(func() {
+		settings = configlint.Settings{}
+		team = "some-team"
+
+		err := atc.UnmarshalConfig([]byte(`
+resources:
+- name: some-resource
+  type: git
+
+jobs:
+- name: some-job
+  on_failure:
+    task: notify
+    privileged: true
+    file: some-resource/notify.yml
+  plan:
+  - get: some-resource
+  - in_parallel:
+    - task: unit
+      privileged: true
+      file: some-resource/unit.yml
+      timeout: 1h
+    - do:
+      - task: lint
+        file: some-resource/lint.yml
+      timeout: 10m
+- name: other-job
+  plan:
+  - get: some-resource
+  - try:
+      task: build
+      privileged: true
+      file: some-resource/build.yml
+`), &config)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		linter, err := configlint.NewLinter(settings)
+		Expect(err).ToNot(HaveOccurred())
+
+		violations = linter.Lint(team, config)
+	})
+
+	Context("with the default settings", func() {
+		It("only runs the rules which are on by default", func() {
+			Expect(violations).To(Equal([]configlint.Violation{
+				{
+					Rule:     "no-privileged-tasks",
+					Severity: configlint.SeverityWarning,
+					Location: "jobs.other-job.plan.do[1].try.task(build)",
+					Message:  "task runs privileged",
+				},
+				{
+					Rule:     "no-privileged-tasks",
+					Severity: configlint.SeverityWarning,
+					Location: "jobs.some-job.plan.do[1].in_parallel.steps[0].task(unit)",
+					Message:  "task runs privileged",
+				},
+				{
+					Rule:     "no-privileged-tasks",
+					Severity: configlint.SeverityWarning,
+					Location: "jobs.some-job.plan.on_failure.task(notify)",
+					Message:  "task runs privileged",
+				},
+			}))
+		})
+	})
+
+	Context("when the team is allowed to run privileged tasks", func() {
+		BeforeEach(func() {
+			settings.Rules = map[string]configlint.RuleSettings{
+				"no-privileged-tasks": {
+					Options: configlint.Options{
+						"allowed_teams": []interface{}{"other-team", "some-team"},
+					},
+				},
+			}
+		})
+
+		It("does not report privileged tasks", func() {
+			Expect(violations).To(BeEmpty())
+		})
+	})
+
+	Context("when the rule options are malformed", func() {
+		BeforeEach(func() {
+			settings.Rules = map[string]configlint.RuleSettings{
+				"no-privileged-tasks": {
+					Options: configlint.Options{"allowed_teams": "some-team"},
+				},
+			}
+		})
+
+		It("reports that the rule failed to run", func() {
+			Expect(violations).To(HaveLen(1))
+			Expect(violations[0].Rule).To(Equal("no-privileged-tasks"))
+			Expect(violations[0].Severity).To(Equal(configlint.SeverityWarning))
+			Expect(violations[0].Message).To(ContainSubstring("failed to run rule"))
+		})
+	})
+
+	Context("when rules are configured with a severity", func() {
+		BeforeEach(func() {
+			settings.Rules = map[string]configlint.RuleSettings{
+				"job-on-failure":      {Severity: configlint.SeverityError},
+				"task-timeout":        {Severity: configlint.SeverityWarning},
+				"no-privileged-tasks": {Severity: configlint.SeverityOff},
+			}
+		})
+
+		It("runs them with that severity", func() {
+			Expect(violations).To(Equal([]configlint.Violation{
+				{
+					Rule:     "job-on-failure",
+					Severity: configlint.SeverityError,
+					Location: "jobs.other-job",
+					Message:  "job has no on_failure hook",
+				},
+				{
+					Rule:     "task-timeout",
+					Severity: configlint.SeverityWarning,
+					Location: "jobs.other-job.plan.do[1].try.task(build)",
+					Message:  "task has no timeout",
+				},
+				{
+					Rule:     "task-timeout",
+					Severity: configlint.SeverityWarning,
+					Location: "jobs.some-job.plan.on_failure.task(notify)",
+					Message:  "task has no timeout",
+				},
+			}))
+		})
+
+		It("can split the violations by severity", func() {
+			Expect(configlint.Errors(violations)).To(HaveLen(1))
+			Expect(configlint.Warnings(violations)).To(HaveLen(2))
+		})
+	})
+
+	Describe("NewLinter", func() {
+		It("errors on unknown rules", func() {
+			_, err := configlint.NewLinter(configlint.Settings{
+				Rules: map[string]configlint.RuleSettings{
+					"bogus": {Severity: configlint.SeverityError},
+				},
+			})
+			Expect(err).To(MatchError("unknown lint rule 'bogus'"))
+		})
+
+		It("errors on invalid severities", func() {
+			_, err := configlint.NewLinter(configlint.Settings{
+				Rules: map[string]configlint.RuleSettings{
+					"task-timeout": {Severity: "fatal"},
+				},
+			})
+			Expect(err).To(MatchError(ContainSubstring("invalid severity 'fatal'")))
+		})
+	})
+})
+
+var _ = Describe("ParseSettings", func() {
+	It("parses the severity and options of each rule", func() {
+		settings, err := configlint.ParseSettings([]byte(`
+rules:
+  job-on-failure:
+    severity: error
+  no-privileged-tasks:
+    options:
+      allowed_teams: [main]
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(settings).To(Equal(configlint.Settings{
+			Rules: map[string]configlint.RuleSettings{
+				"job-on-failure": {Severity: configlint.SeverityError},
+				"no-privileged-tasks": {
+					Options: configlint.Options{"allowed_teams": []interface{}{"main"}},
+				},
+			},
+		}))
+	})
+
+	It("errors on unknown fields", func() {
+		_, err := configlint.ParseSettings([]byte(`rulez: {}`))
+		Expect(err).To(MatchError(ContainSubstring("malformed lint settings")))
+	})
+})
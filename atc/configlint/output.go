@@ -0,0 +1,126 @@
+package configlint
+
+import (
+	"encoding/json"
+	"io"
+)
+
+func WriteJSON(w io.Writer, violations []Violation) error {
+	if violations == nil {
+		violations = []Violation{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(violations)
+}
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// WriteSARIF writes the violations as a SARIF log, so that they can be shown
+// by code scanning tools. The uri is the path of the linted pipeline config.
+func WriteSARIF(w io.Writer, uri string, violations []Violation) error {
+	driver := sarifDriver{
+		Name:           "concourse",
+		InformationURI: "https://concourse-ci.org",
+		Rules:          []sarifRule{},
+	}
+
+	for _, rule := range Rules() {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:               rule.Name(),
+			ShortDescription: sarifMessage{Text: rule.Description()},
+		})
+	}
+
+	results := []sarifResult{}
+	for _, violation := range violations {
+		location := sarifLocation{
+			PhysicalLocation: &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: uri},
+			},
+		}
+
+		if violation.Location != "" {
+			location.LogicalLocations = []sarifLogicalLocation{
+				{FullyQualifiedName: violation.Location},
+			}
+		}
+
+		results = append(results, sarifResult{
+			RuleID:    violation.Rule,
+			Level:     string(violation.Severity),
+			Message:   sarifMessage{Text: violation.Message},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{
+			{Tool: sarifTool{Driver: driver}, Results: results},
+		},
+	})
+}
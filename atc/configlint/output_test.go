@@ -0,0 +1,82 @@
+package configlint_test
+
+import (
+	"bytes"
+
+	"github.com/concourse/concourse/atc/configlint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Output", func() {
+	var violations []configlint.Violation
+
+	BeforeEach(func() {
+		violations = []configlint.Violation{
+			{
+				Rule:     "task-timeout",
+				Severity: configlint.SeverityError,
+				Location: "jobs.some-job.plan.do[0].task(unit)",
+				Message:  "task has no timeout",
+			},
+		}
+	})
+
+	Describe("WriteJSON", func() {
+		It("writes the violations", func() {
+			buf := new(bytes.Buffer)
+			err := configlint.WriteJSON(buf, violations)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buf.String()).To(MatchJSON(`[{
+				"rule": "task-timeout",
+				"severity": "error",
+				"location": "jobs.some-job.plan.do[0].task(unit)",
+				"message": "task has no timeout"
+			}]`))
+		})
+
+		It("writes an empty list when there are no violations", func() {
+			buf := new(bytes.Buffer)
+			err := configlint.WriteJSON(buf, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buf.String()).To(MatchJSON(`[]`))
+		})
+	})
+
+	Describe("WriteSARIF", func() {
+		It("writes a SARIF log with the rules and results", func() {
+			buf := new(bytes.Buffer)
+			err := configlint.WriteSARIF(buf, "pipeline.yml", violations)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buf.String()).To(MatchJSON(`{
+				"version": "2.1.0",
+				"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+				"runs": [{
+					"tool": {
+						"driver": {
+							"name": "concourse",
+							"informationUri": "https://concourse-ci.org",
+							"rules": [
+								{"id": "job-on-failure", "shortDescription": {"text": "every job must have an on_failure hook"}},
+								{"id": "no-privileged-tasks", "shortDescription": {"text": "tasks must not run privileged, unless the team is allowed to"}},
+								{"id": "task-timeout", "shortDescription": {"text": "every task must have a timeout"}}
+							]
+						}
+					},
+					"results": [{
+						"ruleId": "task-timeout",
+						"level": "error",
+						"message": {"text": "task has no timeout"},
+						"locations": [{
+							"physicalLocation": {"artifactLocation": {"uri": "pipeline.yml"}},
+							"logicalLocations": [{"fullyQualifiedName": "jobs.some-job.plan.do[0].task(unit)"}]
+						}]
+					}]
+				}]
+			}`))
+		})
+	})
+})
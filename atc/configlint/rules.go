@@ -0,0 +1,115 @@
+package configlint
+
+import (
+	"github.com/concourse/concourse/atc"
+)
+
+func init() {
+	Register(JobOnFailureRule{})
+	Register(NoPrivilegedTasksRule{})
+	Register(TaskTimeoutRule{})
+}
+
+// JobOnFailureRule requires every job to have an on_failure hook, so that
+// someone finds out when it breaks.
+type JobOnFailureRule struct{}
+
+func (JobOnFailureRule) Name() string { return "job-on-failure" }
+
+func (JobOnFailureRule) Description() string {
+	return "every job must have an on_failure hook"
+}
+
+func (JobOnFailureRule) DefaultSeverity() Severity { return SeverityOff }
+
+func (JobOnFailureRule) Check(target Target) ([]Finding, error) {
+	var findings []Finding
+	for _, job := range target.Config.Jobs {
+		if job.OnFailure == nil {
+			findings = append(findings, Finding{
+				Location: "jobs." + job.Name,
+				Message:  "job has no on_failure hook",
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// NoPrivilegedTasksRule forbids privileged tasks, except in pipelines of the
+// teams listed in the allowed_teams option.
+type NoPrivilegedTasksRule struct{}
+
+type noPrivilegedTasksOptions struct {
+	AllowedTeams []string `json:"allowed_teams"`
+}
+
+func (NoPrivilegedTasksRule) Name() string { return "no-privileged-tasks" }
+
+func (NoPrivilegedTasksRule) Description() string {
+	return "tasks must not run privileged, unless the team is allowed to"
+}
+
+func (NoPrivilegedTasksRule) DefaultSeverity() Severity { return SeverityWarning }
+
+func (NoPrivilegedTasksRule) Check(target Target) ([]Finding, error) {
+	var options noPrivilegedTasksOptions
+	err := target.Options.Decode(&options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range options.AllowedTeams {
+		if team == target.Team {
+			return nil, nil
+		}
+	}
+
+	var findings []Finding
+	WalkSteps(target.Config, func(_ atc.JobConfig, location string, step atc.StepConfig, _ []atc.StepConfig) {
+		task, ok := step.(*atc.TaskStep)
+		if ok && task.Privileged {
+			findings = append(findings, Finding{
+				Location: location,
+				Message:  "task runs privileged",
+			})
+		}
+	})
+
+	return findings, nil
+}
+
+// TaskTimeoutRule requires every task to be bounded by a timeout, either on
+// the task itself or on a step enclosing it.
+type TaskTimeoutRule struct{}
+
+func (TaskTimeoutRule) Name() string { return "task-timeout" }
+
+func (TaskTimeoutRule) Description() string {
+	return "every task must have a timeout"
+}
+
+func (TaskTimeoutRule) DefaultSeverity() Severity { return SeverityOff }
+
+func (TaskTimeoutRule) Check(target Target) ([]Finding, error) {
+	var findings []Finding
+	WalkSteps(target.Config, func(_ atc.JobConfig, location string, step atc.StepConfig, ancestors []atc.StepConfig) {
+		task, ok := step.(*atc.TaskStep)
+		if !ok || task.Timeout != "" {
+			return
+		}
+
+		for _, ancestor := range ancestors {
+			if _, ok := ancestor.(*atc.TimeoutStep); ok {
+				return
+			}
+		}
+
+		findings = append(findings, Finding{
+			Location: location,
+			Message:  "task has no timeout",
+		})
+	})
+
+	return findings, nil
+}
@@ -0,0 +1,63 @@
+package configlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Settings configures the severity and options of each rule, e.g.:
+//
+//	rules:
+//	  job-on-failure:
+//	    severity: error
+//	  no-privileged-tasks:
+//	    options:
+//	      allowed_teams: [main]
+//
+// Rules which aren't listed run with their default severity.
+type Settings struct {
+	Rules map[string]RuleSettings `json:"rules,omitempty"`
+}
+
+type RuleSettings struct {
+	Severity Severity `json:"severity,omitempty"`
+	Options  Options  `json:"options,omitempty"`
+}
+
+type Options map[string]interface{}
+
+// Decode unmarshals the options into the given struct.
+func (options Options) Decode(dest interface{}) error {
+	if len(options) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(payload, dest)
+}
+
+func ParseSettings(payload []byte) (Settings, error) {
+	var settings Settings
+	err := yaml.UnmarshalStrict(payload, &settings)
+	if err != nil {
+		return Settings{}, fmt.Errorf("malformed lint settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func LoadSettings(path string) (Settings, error) {
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	return ParseSettings(payload)
+}
@@ -0,0 +1,194 @@
+package configlint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// WalkFunc is called for every step in a job's plan, including hooks, with
+// the location of the step in the same form that config validation errors
+// use, e.g. 'jobs.foo.plan.do[0].task(bar)'. The ancestors are the steps
+// enclosing the step, outermost first.
+type WalkFunc func(job atc.JobConfig, location string, step atc.StepConfig, ancestors []atc.StepConfig)
+
+// WalkSteps calls fn for every step of every job in the config.
+func WalkSteps(config atc.Config, fn WalkFunc) {
+	for i, job := range config.Jobs {
+		identifier := fmt.Sprintf("jobs[%d]", i)
+		if job.Name != "" {
+			identifier = fmt.Sprintf("jobs.%s", job.Name)
+		}
+
+		walker := &stepWalker{
+			job:     job,
+			fn:      fn,
+			context: []string{identifier, ".plan"},
+		}
+
+		_ = job.StepConfig().Visit(walker)
+	}
+}
+
+// stepWalker is a StepVisitor which tracks the location of each step the same
+// way atc.StepValidator does.
+type stepWalker struct {
+	job       atc.JobConfig
+	fn        WalkFunc
+	context   []string
+	ancestors []atc.StepConfig
+}
+
+func (walker *stepWalker) VisitTask(step *atc.TaskStep) error {
+	return walker.leaf(step, ".task(%s)", step.Name)
+}
+
+func (walker *stepWalker) VisitGet(step *atc.GetStep) error {
+	return walker.leaf(step, ".get(%s)", step.Name)
+}
+
+func (walker *stepWalker) VisitPut(step *atc.PutStep) error {
+	return walker.leaf(step, ".put(%s)", step.Name)
+}
+
+func (walker *stepWalker) VisitSetPipeline(step *atc.SetPipelineStep) error {
+	return walker.leaf(step, ".set_pipeline(%s)", step.Name)
+}
+
+func (walker *stepWalker) VisitLoadVar(step *atc.LoadVarStep) error {
+	return walker.leaf(step, ".load_var(%s)", step.Name)
+}
+
+func (walker *stepWalker) VisitApproval(step *atc.ApprovalStep) error {
+	return walker.leaf(step, ".approval(%s)", step.Name)
+}
+
+func (walker *stepWalker) VisitTry(step *atc.TryStep) error {
+	walker.pushContext(".try")
+	defer walker.popContext()
+
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	return step.Step.Config.Visit(walker)
+}
+
+func (walker *stepWalker) VisitDo(step *atc.DoStep) error {
+	walker.pushContext(".do")
+	defer walker.popContext()
+
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	for i, sub := range step.Steps {
+		walker.pushContext("[%d]", i)
+		_ = sub.Config.Visit(walker)
+		walker.popContext()
+	}
+
+	return nil
+}
+
+func (walker *stepWalker) VisitInParallel(step *atc.InParallelStep) error {
+	walker.pushContext(".in_parallel")
+	defer walker.popContext()
+
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	for i, sub := range step.Config.Steps {
+		walker.pushContext(".steps[%d]", i)
+		_ = sub.Config.Visit(walker)
+		walker.popContext()
+	}
+
+	return nil
+}
+
+func (walker *stepWalker) VisitAcross(step *atc.AcrossStep) error {
+	walker.pushContext(".across")
+	defer walker.popContext()
+
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	return step.Step.Visit(walker)
+}
+
+func (walker *stepWalker) VisitTimeout(step *atc.TimeoutStep) error {
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	return step.Step.Visit(walker)
+}
+
+func (walker *stepWalker) VisitRetry(step *atc.RetryStep) error {
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	return step.Step.Visit(walker)
+}
+
+func (walker *stepWalker) VisitOnSuccess(step *atc.OnSuccessStep) error {
+	return walker.hook(step, step.Step, step.Hook, ".on_success")
+}
+
+func (walker *stepWalker) VisitOnFailure(step *atc.OnFailureStep) error {
+	return walker.hook(step, step.Step, step.Hook, ".on_failure")
+}
+
+func (walker *stepWalker) VisitOnAbort(step *atc.OnAbortStep) error {
+	return walker.hook(step, step.Step, step.Hook, ".on_abort")
+}
+
+func (walker *stepWalker) VisitOnError(step *atc.OnErrorStep) error {
+	return walker.hook(step, step.Step, step.Hook, ".on_error")
+}
+
+func (walker *stepWalker) VisitEnsure(step *atc.EnsureStep) error {
+	return walker.hook(step, step.Step, step.Hook, ".ensure")
+}
+
+func (walker *stepWalker) leaf(step atc.StepConfig, ctx string, args ...interface{}) error {
+	walker.pushContext(ctx, args...)
+	defer walker.popContext()
+
+	walker.visit(step)
+
+	return nil
+}
+
+func (walker *stepWalker) hook(step atc.StepConfig, inner atc.StepConfig, hook atc.Step, ctx string) error {
+	walker.visit(step)
+	defer walker.descend(step)()
+
+	_ = inner.Visit(walker)
+
+	walker.pushContext(ctx)
+	defer walker.popContext()
+
+	return hook.Config.Visit(walker)
+}
+
+func (walker *stepWalker) visit(step atc.StepConfig) {
+	walker.fn(walker.job, strings.Join(walker.context, ""), step, walker.ancestors)
+}
+
+// descend records the step as an ancestor of the steps visited until the
+// returned func is called.
+func (walker *stepWalker) descend(step atc.StepConfig) func() {
+	walker.ancestors = append(walker.ancestors, step)
+
+	return func() {
+		walker.ancestors = walker.ancestors[0 : len(walker.ancestors)-1]
+	}
+}
+
+func (walker *stepWalker) pushContext(ctx string, args ...interface{}) {
+	walker.context = append(walker.context, fmt.Sprintf(ctx, args...))
+}
+
+func (walker *stepWalker) popContext() {
+	walker.context = walker.context[0 : len(walker.context)-1]
+}
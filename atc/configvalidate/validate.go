@@ -185,6 +185,23 @@ func validateResources(c Config) ([]ConfigWarning, error) {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.VersionRetention != nil {
+			if resource.VersionRetention.Versions < 0 {
+				errorMessages = append(errorMessages,
+					identifier+fmt.Sprintf(" has negative version_retention.versions: %d", resource.VersionRetention.Versions))
+			}
+
+			if resource.VersionRetention.Days < 0 {
+				errorMessages = append(errorMessages,
+					identifier+fmt.Sprintf(" has negative version_retention.days: %d", resource.VersionRetention.Days))
+			}
+
+			if resource.VersionRetention.Versions == 0 && resource.VersionRetention.Days == 0 {
+				errorMessages = append(errorMessages,
+					identifier+" has version_retention without versions or days")
+			}
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -347,6 +364,41 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		for j, schedule := range job.Schedules {
+			scheduleIdentifier := identifier + fmt.Sprintf(".schedule[%d]", j)
+
+			if schedule.Cron == "" {
+				errorMessages = append(errorMessages, scheduleIdentifier+" has no cron expression")
+				continue
+			}
+
+			_, err := schedule.Parse()
+			if err != nil {
+				errorMessages = append(errorMessages, scheduleIdentifier+" has an "+err.Error())
+			}
+
+			_, err = schedule.MaxJitter()
+			if err != nil {
+				errorMessages = append(errorMessages, scheduleIdentifier+" has an "+err.Error())
+			}
+		}
+
+		dependencies := map[string]bool{}
+		for _, dependency := range job.DependsOn {
+			switch {
+			case dependency == job.Name:
+				errorMessages = append(errorMessages, identifier+".depends_on cannot include the job itself")
+			case dependencies[dependency]:
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".depends_on includes job '%s' more than once", dependency))
+			default:
+				if _, found := c.Jobs.Lookup(dependency); !found {
+					errorMessages = append(errorMessages, identifier+fmt.Sprintf(".depends_on includes unknown job '%s'", dependency))
+				}
+			}
+
+			dependencies[dependency] = true
+		}
+
 		step := job.Step()
 
 		validator := atc.NewStepValidator(c, []string{identifier, ".plan"})
@@ -358,9 +410,76 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 		errorMessages = append(errorMessages, validator.Errors...)
 	}
 
+	errorMessages = append(errorMessages, validateDependencyCycles(c)...)
+
 	return warnings, compositeErr(errorMessages)
 }
 
+// validateDependencyCycles walks the depends_on graph depth-first, returning
+// an error for every cycle found. Jobs in a cycle would trigger each other
+// forever.
+func validateDependencyCycles(c Config) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var errorMessages []string
+
+	states := map[string]int{}
+	path := []string{}
+
+	var visit func(job atc.JobConfig)
+	visit = func(job atc.JobConfig) {
+		states[job.Name] = visiting
+		path = append(path, job.Name)
+
+		for _, dependency := range job.DependsOn {
+			if dependency == job.Name {
+				// already reported as depending on itself
+				continue
+			}
+
+			dependencyJob, found := c.Jobs.Lookup(dependency)
+			if !found {
+				continue
+			}
+
+			switch states[dependency] {
+			case unvisited:
+				visit(dependencyJob)
+			case visiting:
+				var cycle []string
+				for i, name := range path {
+					if name == dependency {
+						cycle = append(cycle, path[i:]...)
+						break
+					}
+				}
+
+				cycle = append(cycle, dependency)
+
+				errorMessages = append(
+					errorMessages,
+					fmt.Sprintf("jobs.%s.depends_on forms a cycle: %s", job.Name, strings.Join(cycle, " -> ")),
+				)
+			}
+		}
+
+		path = path[:len(path)-1]
+		states[job.Name] = visited
+	}
+
+	for _, job := range c.Jobs {
+		if job.Name != "" && states[job.Name] == unvisited {
+			visit(job)
+		}
+	}
+
+	return errorMessages
+}
+
 func compositeErr(errorMessages []string) error {
 	if len(errorMessages) == 0 {
 		return nil
@@ -513,6 +513,44 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has a version retention", func() {
+			BeforeEach(func() {
+				config.Resources[0].VersionRetention = &atc.VersionRetention{
+					Versions: 100,
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a resource has negative version_retention values", func() {
+			BeforeEach(func() {
+				config.Resources[0].VersionRetention = &atc.VersionRetention{
+					Versions: -1,
+					Days:     -1,
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has negative version_retention.versions: -1"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has negative version_retention.days: -1"))
+			})
+		})
+
+		Context("when a resource has an empty version_retention", func() {
+			BeforeEach(func() {
+				config.Resources[0].VersionRetention = &atc.VersionRetention{}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has version_retention without versions or days"))
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -853,6 +891,159 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				job.Schedules = []atc.JobSchedule{
+					{Cron: "0 9 * * 1-5", Location: "America/New_York", Jitter: "5m"},
+					{Cron: "@daily"},
+				}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job schedule has an invalid cron expression", func() {
+			BeforeEach(func() {
+				job.Schedules = []atc.JobSchedule{{Cron: "every tuesday"}}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule[0] has an invalid cron expression"))
+			})
+		})
+
+		Context("when a job schedule has no cron expression", func() {
+			BeforeEach(func() {
+				job.Schedules = []atc.JobSchedule{{Location: "UTC"}}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule[0] has no cron expression"))
+			})
+		})
+
+		Context("when a job schedule has an unknown location", func() {
+			BeforeEach(func() {
+				job.Schedules = []atc.JobSchedule{{Cron: "@hourly", Location: "Mars/Olympus_Mons"}}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule[0] has an invalid location"))
+			})
+		})
+
+		Context("when a job schedule has a negative jitter", func() {
+			BeforeEach(func() {
+				job.Schedules = []atc.JobSchedule{{Cron: "@hourly", Jitter: "-1m"}}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule[0] has an invalid jitter: must not be negative"))
+			})
+		})
+
+		Context("when a job depends on another job", func() {
+			BeforeEach(func() {
+				job.DependsOn = []string{"some-job"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job depends on an unknown job", func() {
+			BeforeEach(func() {
+				job.DependsOn = []string{"bogus-job"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.depends_on includes unknown job 'bogus-job'"))
+			})
+		})
+
+		Context("when a job depends on itself", func() {
+			BeforeEach(func() {
+				job.DependsOn = []string{"some-other-job"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.depends_on cannot include the job itself"))
+			})
+		})
+
+		Context("when a job depends on the same job twice", func() {
+			BeforeEach(func() {
+				job.DependsOn = []string{"some-job", "some-job"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.depends_on includes job 'some-job' more than once"))
+			})
+		})
+
+		Context("when two jobs depend on each other", func() {
+			BeforeEach(func() {
+				config.Jobs[0].DependsOn = []string{"some-other-job"}
+				job.DependsOn = []string{"some-job"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error naming the cycle", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.depends_on forms a cycle: some-job -> some-other-job -> some-job"))
+			})
+		})
+
+		Context("when three jobs depend on each other in a loop", func() {
+			BeforeEach(func() {
+				config.Jobs[0].DependsOn = []string{"some-third-job"}
+				job.DependsOn = []string{"some-job"}
+				config.Jobs = append(config.Jobs, job, atc.JobConfig{
+					Name:      "some-third-job",
+					DependsOn: []string{"some-other-job"},
+				})
+			})
+
+			It("returns an error naming the cycle", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.depends_on forms a cycle: some-job -> some-third-job -> some-other-job -> some-job"))
+			})
+		})
+
+		Context("when jobs depend on the same job without a cycle", func() {
+			BeforeEach(func() {
+				job.DependsOn = []string{"some-job"}
+				config.Jobs = append(config.Jobs, job, atc.JobConfig{
+					Name:      "some-third-job",
+					DependsOn: []string{"some-job", "some-other-job"},
+				})
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1752,6 +1943,100 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a job's input's passed constraints filter builds by status and age", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							Passed:         []string{"some-job"},
+							PassedStatuses: []atc.BuildStatus{atc.StatusSucceeded, atc.StatusFailed},
+							PassedMaxAge:   "24h",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input's passed constraints have an invalid status and max_age", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:           "some-resource",
+							Passed:         []string{"some-job"},
+							PassedStatuses: []atc.BuildStatus{"started"},
+							PassedMaxAge:   "a while",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed: unknown status 'started'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed: invalid max_age"))
+				})
+			})
+
+			Context("when a job's input filters builds without any passed jobs", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:         "some-resource",
+							PassedMaxAge: "24h",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed: no jobs to apply status or max_age to"))
+				})
+			})
+
+			Context("when a job's input has an invalid version filter", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:    "some-resource",
+							Version: &atc.VersionConfig{Filter: `tag = "1"`},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).version.filter: invalid filter: unknown operator at position 5"))
+				})
+			})
+
+			Context("when a job's input requires an invalid version label", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:    "some-resource",
+							Version: &atc.VersionConfig{Labels: []string{"qa-approved", "QA approved"}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).version.labels[1]: 'QA approved' is not a valid version label"))
+				})
+			})
+
 			Context("when a load_var has no name or file defined", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1768,6 +2053,26 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an approval step has an unknown role and negative approvers", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.ApprovalStep{
+							Name:      "deploy",
+							Approvers: -1,
+							Role:      "viewer",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].approval(deploy): approvers must not be negative"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].approval(deploy): unknown role 'viewer'"))
+				})
+			})
+
 			Context("when two load_var steps have same name", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
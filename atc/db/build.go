@@ -95,7 +95,9 @@ var buildsQuery = psql.Select(`
 		b.rerun_of,
 		rb.name,
 		b.rerun_number,
-		b.span_context
+		b.span_context,
+		b.cause,
+		b.awaiting_approval
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -149,6 +151,7 @@ type Build interface {
 	RerunOf() int
 	RerunOfName() string
 	RerunNumber() int
+	Cause() string
 
 	LagerData() lager.Data
 	TracingAttrs() tracing.Attrs
@@ -192,6 +195,12 @@ type Build interface {
 	IsDrained() bool
 	SetDrained(bool) error
 
+	AwaitingApproval() atc.PlanID
+	SetAwaitingApproval(atc.PlanID) error
+	ClearAwaitingApproval(atc.PlanID) error
+	SaveApproval(BuildApproval) (bool, error)
+	Approvals(atc.PlanID) ([]BuildApproval, error)
+
 	SpanContext() propagation.HTTPSupplier
 
 	SavePipeline(
@@ -230,6 +239,10 @@ type build struct {
 	rerunOfName string
 	rerunNumber int
 
+	cause string
+
+	awaitingApproval atc.PlanID
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -375,6 +388,9 @@ func (b *build) InputsReady() bool    { return b.inputsReady }
 func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
+func (b *build) Cause() string        { return b.cause }
+
+func (b *build) AwaitingApproval() atc.PlanID { return b.awaitingApproval }
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -784,6 +800,123 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// BuildApproval is a user's approval or rejection of an approval step.
+type BuildApproval struct {
+	PlanID    atc.PlanID
+	Username  string
+	Role      string
+	Approved  bool
+	Comment   string
+	CreatedAt time.Time
+}
+
+// SetAwaitingApproval marks the build as paused on the given approval step.
+func (b *build) SetAwaitingApproval(planID atc.PlanID) error {
+	_, err := psql.Update("builds").
+		Set("awaiting_approval", string(planID)).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.awaitingApproval = planID
+
+	return nil
+}
+
+// ClearAwaitingApproval marks the build as no longer paused on the given
+// approval step. It is a no-op if the build has since started waiting on
+// another one.
+func (b *build) ClearAwaitingApproval(planID atc.PlanID) error {
+	_, err := psql.Update("builds").
+		Set("awaiting_approval", nil).
+		Where(sq.Eq{
+			"id":                b.id,
+			"awaiting_approval": string(planID),
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if b.awaitingApproval == planID {
+		b.awaitingApproval = ""
+	}
+
+	return nil
+}
+
+// SaveApproval records a user's approval or rejection of the approval step the
+// build is currently paused on, replacing any earlier one by the same user.
+//
+// If the build is not awaiting approval, nothing is saved and false is
+// returned.
+func (b *build) SaveApproval(approval BuildApproval) (bool, error) {
+	result, err := b.conn.Exec(`
+		INSERT INTO build_approvals (build_id, plan_id, username, role, approved, comment)
+		SELECT id, awaiting_approval, $2, $3, $4, $5
+		FROM builds
+		WHERE id = $1
+		AND awaiting_approval IS NOT NULL
+		ON CONFLICT (build_id, plan_id, username) DO UPDATE SET
+			role = EXCLUDED.role,
+			approved = EXCLUDED.approved,
+			comment = EXCLUDED.comment,
+			created_at = now()
+	`, b.id, approval.Username, approval.Role, approval.Approved, approval.Comment)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// Approvals returns the approvals and rejections of the given approval step,
+// oldest first.
+func (b *build) Approvals(planID atc.PlanID) ([]BuildApproval, error) {
+	rows, err := psql.Select("plan_id", "username", "role", "approved", "comment", "created_at").
+		From("build_approvals").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		OrderBy("created_at ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var approvals []BuildApproval
+	for rows.Next() {
+		var (
+			approval BuildApproval
+			comment  sql.NullString
+		)
+
+		err = rows.Scan(&approval.PlanID, &approval.Username, &approval.Role, &approval.Approved, &comment, &approval.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		approval.Comment = comment.String
+
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -1048,6 +1181,30 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		}
 	}
 
+	rows, err := psql.Select("j.name", "d.resolve_error").
+		From("job_dependencies d").
+		Join("jobs j ON j.id = d.depends_on_job_id").
+		Where(sq.Eq{"d.job_id": job.ID()}).
+		Where(sq.NotEq{"d.resolve_error": nil}).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return BuildPreparation{}, false, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var dependencyName, resolveError string
+		err = rows.Scan(&dependencyName, &resolveError)
+		if err != nil {
+			return BuildPreparation{}, false, err
+		}
+
+		missingInputReasons.RegisterResolveError(dependencyName, resolveError)
+		inputsSatisfiedStatus = BuildPreparationStatusBlocking
+	}
+
 	buildPreparation := BuildPreparation{
 		BuildID:             b.id,
 		PausedPipeline:      pausedPipelineStatus,
@@ -1759,7 +1916,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		jobID, resourceID, resourceTypeID, pipelineID, rerunOf, rerunNumber                                 sql.NullInt64
 		schema, privatePlan, jobName, resourceName, resourceTypeName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                                                            pq.NullTime
-		nonce, spanContext                                                                                  sql.NullString
+		nonce, spanContext, cause, awaitingApproval                                                         sql.NullString
 		drained, aborted, completed                                                                         bool
 		status                                                                                              string
 		pipelineInstanceVars                                                                                sql.NullString
@@ -1798,6 +1955,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOfName,
 		&rerunNumber,
 		&spanContext,
+		&cause,
+		&awaitingApproval,
 	)
 	if err != nil {
 		return err
@@ -1823,6 +1982,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.cause = cause.String
+	b.awaitingApproval = atc.PlanID(awaitingApproval.String)
 
 	var (
 		noncense      *string
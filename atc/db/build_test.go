@@ -489,6 +489,10 @@ var _ = Describe("Build", func() {
 							},
 						},
 					},
+					{
+						Name:      "dependent-job",
+						DependsOn: []string{"some-job"},
+					},
 					{
 						Name: "no-request-job",
 						PlanSequence: []atc.Step{
@@ -773,6 +777,25 @@ var _ = Describe("Build", func() {
 				Expect(downstreamJob.ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
 			})
 
+			It("request schedule on jobs depending on the job", func() {
+				job := scenario.Job("some-job")
+				dependentJob := scenario.Job("dependent-job")
+
+				newBuild, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				requestedSchedule := dependentJob.ScheduleRequestedTime()
+
+				err = newBuild.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := dependentJob.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(dependentJob.ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
+			})
+
 			It("do not request schedule on jobs that are not directly downstream", func() {
 				job := scenario.Job("some-job")
 				noRequestJob := scenario.Job("no-request-job")
@@ -1009,6 +1032,112 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("approvals", func() {
+		var planID atc.PlanID = "some-approval"
+
+		It("is not awaiting approval on creation", func() {
+			Expect(build.AwaitingApproval()).To(BeEmpty())
+		})
+
+		Context("when the build is not awaiting approval", func() {
+			It("does not save approvals", func() {
+				saved, err := build.SaveApproval(db.BuildApproval{Username: "some-user", Role: "member", Approved: true})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(saved).To(BeFalse())
+			})
+		})
+
+		Context("when the build is awaiting approval", func() {
+			BeforeEach(func() {
+				err := build.SetAwaitingApproval(planID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("persists the awaited step", func() {
+				found, err := build.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.AwaitingApproval()).To(Equal(planID))
+			})
+
+			It("saves approvals against the awaited step", func() {
+				saved, err := build.SaveApproval(db.BuildApproval{Username: "some-user", Role: "member", Approved: true, Comment: "lgtm"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(saved).To(BeTrue())
+
+				saved, err = build.SaveApproval(db.BuildApproval{Username: "other-user", Role: "owner", Approved: false})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(saved).To(BeTrue())
+
+				approvals, err := build.Approvals(planID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(approvals).To(HaveLen(2))
+
+				Expect(approvals[0].PlanID).To(Equal(planID))
+				Expect(approvals[0].Username).To(Equal("some-user"))
+				Expect(approvals[0].Role).To(Equal("member"))
+				Expect(approvals[0].Approved).To(BeTrue())
+				Expect(approvals[0].Comment).To(Equal("lgtm"))
+
+				Expect(approvals[1].Username).To(Equal("other-user"))
+				Expect(approvals[1].Approved).To(BeFalse())
+				Expect(approvals[1].Comment).To(BeEmpty())
+			})
+
+			It("replaces an earlier approval by the same user", func() {
+				_, err := build.SaveApproval(db.BuildApproval{Username: "some-user", Role: "member", Approved: true})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = build.SaveApproval(db.BuildApproval{Username: "some-user", Role: "member", Approved: false})
+				Expect(err).ToNot(HaveOccurred())
+
+				approvals, err := build.Approvals(planID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(approvals).To(HaveLen(1))
+				Expect(approvals[0].Approved).To(BeFalse())
+			})
+
+			It("does not return approvals of other steps", func() {
+				_, err := build.SaveApproval(db.BuildApproval{Username: "some-user", Role: "member", Approved: true})
+				Expect(err).ToNot(HaveOccurred())
+
+				approvals, err := build.Approvals("other-approval")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(approvals).To(BeEmpty())
+			})
+
+			Context("when the awaited step is cleared", func() {
+				BeforeEach(func() {
+					err := build.ClearAwaitingApproval(planID)
+					Expect(err).ToNot(HaveOccurred())
+
+					found, err := build.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+
+				It("is no longer awaiting approval", func() {
+					Expect(build.AwaitingApproval()).To(BeEmpty())
+				})
+			})
+
+			Context("when another step is cleared", func() {
+				BeforeEach(func() {
+					err := build.ClearAwaitingApproval("other-approval")
+					Expect(err).ToNot(HaveOccurred())
+
+					found, err := build.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+
+				It("is still awaiting approval", func() {
+					Expect(build.AwaitingApproval()).To(Equal(planID))
+				})
+			})
+		})
+	})
+
 	Describe("Events", func() {
 		It("saves and emits status events", func() {
 			By("allowing you to subscribe when no events have yet occurred")
@@ -1856,6 +1985,73 @@ var _ = Describe("Build", func() {
 				Expect(buildPrep).To(Equal(expectedBuildPrep))
 			})
 		})
+
+		Context("when a dependency has no succeeded builds", func() {
+			BeforeEach(func() {
+				scenario.Run(
+					builder.WithPipeline(atc.Config{
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: dbtest.BaseResourceType,
+								Source: atc.Source{
+									"source-config": "some-value",
+								},
+							},
+						},
+						Jobs: atc.JobConfigs{
+							{
+								Name:           "some-job",
+								RawMaxInFlight: 1,
+								DependsOn:      []string{"upstream-job"},
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.GetStep{
+											Name:     "some-input",
+											Resource: "some-resource",
+										},
+									},
+								},
+							},
+							{
+								Name: "upstream-job",
+							},
+						},
+					}),
+					builder.WithNextInputMapping("some-job", dbtest.JobInputs{
+						{
+							Name:    "some-input",
+							Version: atc.Version{"version": "some-version"},
+						},
+					}),
+
+					// checked after build creation
+					builder.WithResourceVersions("some-resource"),
+				)
+
+				err := scenario.Job("some-job").SaveNextDependencyMapping(db.DependencyMapping{
+					"upstream-job": db.DependencyResult{
+						ResolveError: db.NoSucceededDependencyBuild,
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedBuildPrep.Inputs = map[string]db.BuildPreparationStatus{
+					"some-input": db.BuildPreparationStatusNotBlocking,
+				}
+				expectedBuildPrep.InputsSatisfied = db.BuildPreparationStatusBlocking
+				expectedBuildPrep.MissingInputReasons = db.MissingInputReasons{
+					"upstream-job": string(db.NoSucceededDependencyBuild),
+				}
+			})
+
+			It("returns the dependency's resolve error", func() {
+				buildPrep, found, err := build.Preparation()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(buildPrep).To(Equal(expectedBuildPrep))
+			})
+		})
 	})
 
 	Describe("AdoptInputsAndPipes", func() {
@@ -2341,7 +2537,7 @@ var _ = Describe("Build", func() {
 
 				rcv := scenario.ResourceVersion("some-other-resource", atc.Version{"some": "other-version"})
 
-				found, err := scenario.Resource("some-other-resource").PinVersion(rcv.ID())
+				found, err := scenario.Resource("some-other-resource").PinVersion(rcv.ID(), "", time.Time{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager/lagerctx"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
@@ -27,6 +28,8 @@ type Checkable interface {
 	CheckEvery() *atc.CheckEvery
 	CheckTimeout() string
 	LastCheckEndTime() time.Time
+	CheckFailures() int
+	LastNewVersionTime() time.Time
 	CurrentPinnedVersion() atc.Version
 
 	HasWebhook() bool
@@ -41,6 +44,7 @@ type CheckFactory interface {
 	TryCreateCheck(context.Context, Checkable, ResourceTypes, atc.Version, bool) (Build, bool, error)
 	Resources() ([]Resource, error)
 	ResourceTypes() ([]ResourceType, error)
+	NextCheckTime(Checkable) time.Time
 }
 
 type checkFactory struct {
@@ -55,12 +59,28 @@ type checkFactory struct {
 	defaultCheckTimeout             time.Duration
 	defaultCheckInterval            time.Duration
 	defaultWithWebhookCheckInterval time.Duration
+
+	maxBackoffInterval time.Duration
+	idleThreshold      time.Duration
+	maxIdleInterval    time.Duration
+
+	clock clock.Clock
 }
 
 type CheckDurations struct {
 	Timeout             time.Duration
 	Interval            time.Duration
 	IntervalWithWebhook time.Duration
+
+	// MaxBackoffInterval caps the interval of a checkable whose checks keep
+	// failing. The interval doubles with every consecutive failure.
+	MaxBackoffInterval time.Duration
+
+	// IdleThreshold is how long a checkable may go without producing a new
+	// version before its interval starts to stretch, up to MaxIdleInterval.
+	// Zero disables idle stretching.
+	IdleThreshold   time.Duration
+	MaxIdleInterval time.Duration
 }
 
 func NewCheckFactory(
@@ -69,6 +89,7 @@ func NewCheckFactory(
 	secrets creds.Secrets,
 	varSourcePool creds.VarSourcePool,
 	durations CheckDurations,
+	clock clock.Clock,
 ) CheckFactory {
 	return &checkFactory{
 		conn:        conn,
@@ -82,6 +103,12 @@ func NewCheckFactory(
 		defaultCheckTimeout:             durations.Timeout,
 		defaultCheckInterval:            durations.Interval,
 		defaultWithWebhookCheckInterval: durations.IntervalWithWebhook,
+
+		maxBackoffInterval: durations.MaxBackoffInterval,
+		idleThreshold:      durations.IdleThreshold,
+		maxIdleInterval:    durations.MaxIdleInterval,
+
+		clock: clock,
 	}
 }
 
@@ -104,15 +131,9 @@ func (c *checkFactory) TryCreateCheck(ctx context.Context, checkable Checkable,
 		}
 	}
 
-	interval := c.defaultCheckInterval
-	if checkable.HasWebhook() {
-		interval = c.defaultWithWebhookCheckInterval
-	}
-	if checkable.CheckEvery() != nil && !checkable.CheckEvery().Never {
-		interval = checkable.CheckEvery().Interval
-	}
+	interval := c.checkInterval(checkable)
 
-	if !manuallyTriggered && time.Now().Before(checkable.LastCheckEndTime().Add(interval)) {
+	if !manuallyTriggered && c.clock.Now().Before(checkable.LastCheckEndTime().Add(interval)) {
 		// skip creating the check if its interval hasn't elapsed yet
 		return nil, false, nil
 	}
@@ -135,6 +156,76 @@ func (c *checkFactory) TryCreateCheck(ctx context.Context, checkable Checkable,
 	return build, true, nil
 }
 
+// NextCheckTime returns when the checkable is next due to be checked. A zero
+// time means it is due now, or that it is never checked periodically.
+func (c *checkFactory) NextCheckTime(checkable Checkable) time.Time {
+	if checkable.CheckEvery() != nil && checkable.CheckEvery().Never {
+		return time.Time{}
+	}
+
+	if checkable.LastCheckEndTime().IsZero() {
+		return time.Time{}
+	}
+
+	return checkable.LastCheckEndTime().Add(c.checkInterval(checkable))
+}
+
+func (c *checkFactory) checkInterval(checkable Checkable) time.Duration {
+	interval := c.defaultCheckInterval
+	if checkable.HasWebhook() {
+		interval = c.defaultWithWebhookCheckInterval
+	}
+
+	configured := checkable.CheckEvery() != nil && !checkable.CheckEvery().Never
+	if configured {
+		interval = checkable.CheckEvery().Interval
+	}
+
+	if interval <= 0 {
+		return interval
+	}
+
+	if failures := checkable.CheckFailures(); failures > 0 {
+		return stretchInterval(interval, failures, c.maxBackoffInterval)
+	}
+
+	// an explicit check_every is honoured as long as checks succeed
+	if configured || c.idleThreshold <= 0 {
+		return interval
+	}
+
+	lastNewVersion := checkable.LastNewVersionTime()
+	if lastNewVersion.IsZero() {
+		return interval
+	}
+
+	idle := c.clock.Since(lastNewVersion)
+	if idle < c.idleThreshold {
+		return interval
+	}
+
+	return stretchInterval(interval, int(idle/c.idleThreshold), c.maxIdleInterval)
+}
+
+// stretchInterval doubles the interval the given number of times, stopping at
+// max. The interval is never shortened, even if max is lower than it.
+func stretchInterval(interval time.Duration, times int, max time.Duration) time.Duration {
+	stretched := interval
+	for i := 0; i < times && stretched < max; i++ {
+		stretched *= 2
+	}
+
+	if stretched > max {
+		stretched = max
+	}
+
+	if stretched < interval {
+		return interval
+	}
+
+	return stretched
+}
+
 func (c *checkFactory) Resources() ([]Resource, error) {
 	var resources []Resource
 
@@ -92,7 +92,7 @@ var _ = Describe("CheckFactory", func() {
 
 			Context("when the interval has not elapsed", func() {
 				BeforeEach(func() {
-					fakeResource.LastCheckEndTimeReturns(time.Now().Add(defaultCheckInterval))
+					fakeResource.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(defaultCheckInterval))
 				})
 
 				It("does not create a build for the resource", func() {
@@ -141,7 +141,7 @@ var _ = Describe("CheckFactory", func() {
 
 			Context("when the default webhook interval has not elapsed", func() {
 				BeforeEach(func() {
-					fakeResource.LastCheckEndTimeReturns(time.Now().Add(-(defaultWebhookCheckInterval / 2)))
+					fakeResource.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(-(defaultWebhookCheckInterval / 2)))
 				})
 
 				It("does not create a build for the resource", func() {
@@ -176,6 +176,136 @@ var _ = Describe("CheckFactory", func() {
 			})
 		})
 
+		Context("when previous checks have failed", func() {
+			BeforeEach(func() {
+				fakeResource.CheckFailuresReturns(2)
+			})
+
+			It("backs off exponentially", func() {
+				Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+				_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+				Expect(interval).To(Equal(4 * defaultCheckInterval))
+			})
+
+			Context("when the backed off interval has not elapsed", func() {
+				BeforeEach(func() {
+					fakeResource.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(-4*defaultCheckInterval + time.Second))
+				})
+
+				It("does not create a build for the resource", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(0))
+					Expect(fakeResource.CreateBuildCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the backed off interval has just elapsed", func() {
+				BeforeEach(func() {
+					fakeResource.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(-4 * defaultCheckInterval))
+				})
+
+				It("creates a build for the resource", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+					Expect(fakeResource.CreateBuildCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("many times", func() {
+				BeforeEach(func() {
+					fakeResource.CheckFailuresReturns(100)
+				})
+
+				It("caps the interval at the max backoff interval", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+					_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+					Expect(interval).To(Equal(defaultMaxBackoffInterval))
+				})
+			})
+
+			Context("when an interval is specified", func() {
+				BeforeEach(func() {
+					fakeResource.CheckEveryReturns(&atc.CheckEvery{Interval: 42 * time.Second})
+				})
+
+				It("backs off from the specified interval", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+					_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+					Expect(interval).To(Equal(168 * time.Second))
+				})
+			})
+		})
+
+		Context("when the resource has not had a new version for a long time", func() {
+			BeforeEach(func() {
+				fakeResource.LastNewVersionTimeReturns(fakeCheckClock.Now().Add(-2*defaultIdleThreshold - time.Minute))
+			})
+
+			It("lengthens the interval for every idle threshold that has passed", func() {
+				Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+				_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+				Expect(interval).To(Equal(4 * defaultCheckInterval))
+			})
+
+			Context("for a very long time", func() {
+				BeforeEach(func() {
+					fakeResource.LastNewVersionTimeReturns(fakeCheckClock.Now().Add(-100 * defaultIdleThreshold))
+				})
+
+				It("caps the interval at the max idle interval", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+					_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+					Expect(interval).To(Equal(defaultMaxIdleInterval))
+				})
+			})
+
+			Context("when an interval is specified", func() {
+				BeforeEach(func() {
+					fakeResource.CheckEveryReturns(&atc.CheckEvery{Interval: 42 * time.Second})
+				})
+
+				It("honours the specified interval", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+					_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+					Expect(interval).To(Equal(42 * time.Second))
+				})
+			})
+		})
+
+		Context("when the resource has had a new version recently", func() {
+			BeforeEach(func() {
+				fakeResource.LastNewVersionTimeReturns(fakeCheckClock.Now().Add(-defaultIdleThreshold / 2))
+			})
+
+			It("uses the default interval", func() {
+				Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+				_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+				Expect(interval).To(Equal(defaultCheckInterval))
+			})
+		})
+
+		Context("when the resource has been idle for just under the idle threshold", func() {
+			BeforeEach(func() {
+				fakeResource.LastNewVersionTimeReturns(fakeCheckClock.Now().Add(-defaultIdleThreshold + time.Second))
+			})
+
+			It("uses the default interval", func() {
+				Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+				_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+				Expect(interval).To(Equal(defaultCheckInterval))
+			})
+
+			Context("once the idle threshold has passed", func() {
+				BeforeEach(func() {
+					fakeCheckClock.Increment(time.Second)
+				})
+
+				It("doubles the interval", func() {
+					Expect(fakeResource.CheckPlanCallCount()).To(Equal(1))
+					_, interval, _, _ := fakeResource.CheckPlanArgsForCall(0)
+					Expect(interval).To(Equal(2 * defaultCheckInterval))
+				})
+			})
+		})
+
 		Context("when the resource has a parent type", func() {
 			BeforeEach(func() {
 				fakeResource.TypeReturns("custom-type")
@@ -202,7 +332,7 @@ var _ = Describe("CheckFactory", func() {
 
 				Context("when the parent type's interval has not elapsed", func() {
 					BeforeEach(func() {
-						fakeResourceType.LastCheckEndTimeReturns(time.Now().Add(defaultCheckInterval))
+						fakeResourceType.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(defaultCheckInterval))
 					})
 
 					It("does not create a build for the parent type", func() {
@@ -213,8 +343,8 @@ var _ = Describe("CheckFactory", func() {
 
 				Context("when the parent type's interval has elapsed", func() {
 					BeforeEach(func() {
-						fakeResourceType.LastCheckEndTimeReturns(time.Now().Add(-defaultCheckInterval))
-						fakeResource.LastCheckEndTimeReturns(time.Now().Add(-defaultCheckInterval))
+						fakeResourceType.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(-defaultCheckInterval))
+						fakeResource.LastCheckEndTimeReturns(fakeCheckClock.Now().Add(-defaultCheckInterval))
 					})
 
 					It("creates a check plan", func() {
@@ -244,6 +374,59 @@ var _ = Describe("CheckFactory", func() {
 		})
 	})
 
+	Describe("NextCheckTime", func() {
+		var (
+			fakeResource  *dbfakes.FakeResource
+			lastCheckTime time.Time
+			nextCheckTime time.Time
+		)
+
+		BeforeEach(func() {
+			lastCheckTime = fakeCheckClock.Now().Add(-time.Minute)
+
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.LastCheckEndTimeReturns(lastCheckTime)
+		})
+
+		JustBeforeEach(func() {
+			nextCheckTime = checkFactory.NextCheckTime(fakeResource)
+		})
+
+		It("is the interval after the last check", func() {
+			Expect(nextCheckTime).To(Equal(lastCheckTime.Add(defaultCheckInterval)))
+		})
+
+		Context("when previous checks have failed", func() {
+			BeforeEach(func() {
+				fakeResource.CheckFailuresReturns(3)
+			})
+
+			It("includes the backoff", func() {
+				Expect(nextCheckTime).To(Equal(lastCheckTime.Add(8 * defaultCheckInterval)))
+			})
+		})
+
+		Context("when the resource has never been checked", func() {
+			BeforeEach(func() {
+				fakeResource.LastCheckEndTimeReturns(time.Time{})
+			})
+
+			It("is zero", func() {
+				Expect(nextCheckTime).To(BeZero())
+			})
+		})
+
+		Context("when CheckEvery is never", func() {
+			BeforeEach(func() {
+				fakeResource.CheckEveryReturns(&atc.CheckEvery{Never: true})
+			})
+
+			It("is zero", func() {
+				Expect(nextCheckTime).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Resources", func() {
 		var (
 			resources       []db.Resource
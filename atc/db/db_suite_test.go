@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	sq "github.com/Masterminds/squirrel"
 
@@ -51,6 +52,7 @@ var (
 	userFactory                         db.UserFactory
 	dbWall                              db.Wall
 	fakeClock                           dbfakes.FakeClock
+	fakeCheckClock                      *fakeclock.FakeClock
 
 	builder dbtest.Builder
 
@@ -73,6 +75,9 @@ var (
 	defaultCheckInterval        = time.Minute
 	defaultWebhookCheckInterval = time.Hour
 	defaultCheckTimeout         = 5 * time.Minute
+	defaultMaxBackoffInterval   = 10 * time.Minute
+	defaultIdleThreshold        = 24 * time.Hour
+	defaultMaxIdleInterval      = 5 * time.Minute
 
 	fullMetadata = db.ContainerMetadata{
 		Type: db.ContainerTypeTask,
@@ -125,11 +130,15 @@ var _ = BeforeEach(func() {
 	resourceConfigFactory = db.NewResourceConfigFactory(dbConn, lockFactory)
 	resourceCacheFactory = db.NewResourceCacheFactory(dbConn, lockFactory)
 	taskCacheFactory = db.NewTaskCacheFactory(dbConn)
+	fakeCheckClock = fakeclock.NewFakeClock(time.Now())
 	checkFactory = db.NewCheckFactory(dbConn, lockFactory, fakeSecrets, fakeVarSourcePool, db.CheckDurations{
 		Timeout:             defaultCheckTimeout,
 		Interval:            defaultCheckInterval,
 		IntervalWithWebhook: defaultWebhookCheckInterval,
-	})
+		MaxBackoffInterval:  defaultMaxBackoffInterval,
+		IdleThreshold:       defaultIdleThreshold,
+		MaxIdleInterval:     defaultMaxIdleInterval,
+	}, fakeCheckClock)
 	workerBaseResourceTypeFactory = db.NewWorkerBaseResourceTypeFactory(dbConn)
 	workerTaskCacheFactory = db.NewWorkerTaskCacheFactory(dbConn)
 	userFactory = db.NewUserFactory(dbConn)
@@ -74,6 +74,19 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ApprovalsStub        func(atc.PlanID) ([]db.BuildApproval, error)
+	approvalsMutex       sync.RWMutex
+	approvalsArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalsReturns struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
+	approvalsReturnsOnCall map[int]struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -99,6 +112,37 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	AwaitingApprovalStub        func() atc.PlanID
+	awaitingApprovalMutex       sync.RWMutex
+	awaitingApprovalArgsForCall []struct {
+	}
+	awaitingApprovalReturns struct {
+		result1 atc.PlanID
+	}
+	awaitingApprovalReturnsOnCall map[int]struct {
+		result1 atc.PlanID
+	}
+	CauseStub        func() string
+	causeMutex       sync.RWMutex
+	causeArgsForCall []struct {
+	}
+	causeReturns struct {
+		result1 string
+	}
+	causeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClearAwaitingApprovalStub        func(atc.PlanID) error
+	clearAwaitingApprovalMutex       sync.RWMutex
+	clearAwaitingApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	clearAwaitingApprovalReturns struct {
+		result1 error
+	}
+	clearAwaitingApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -514,6 +558,19 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	SaveApprovalStub        func(db.BuildApproval) (bool, error)
+	saveApprovalMutex       sync.RWMutex
+	saveApprovalArgsForCall []struct {
+		arg1 db.BuildApproval
+	}
+	saveApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	saveApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -582,6 +639,17 @@ type FakeBuild struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetAwaitingApprovalStub        func(atc.PlanID) error
+	setAwaitingApprovalMutex       sync.RWMutex
+	setAwaitingApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	setAwaitingApprovalReturns struct {
+		result1 error
+	}
+	setAwaitingApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetDrainedStub        func(bool) error
 	setDrainedMutex       sync.RWMutex
 	setDrainedArgsForCall []struct {
@@ -945,6 +1013,69 @@ func (fake *FakeBuild) AdoptRerunInputsAndPipesReturnsOnCall(i int, result1 []db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Approvals(arg1 atc.PlanID) ([]db.BuildApproval, error) {
+	fake.approvalsMutex.Lock()
+	ret, specificReturn := fake.approvalsReturnsOnCall[len(fake.approvalsArgsForCall)]
+	fake.approvalsArgsForCall = append(fake.approvalsArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("Approvals", []interface{}{arg1})
+	fake.approvalsMutex.Unlock()
+	if fake.ApprovalsStub != nil {
+		return fake.ApprovalsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approvalsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalsCallCount() int {
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
+	return len(fake.approvalsArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalsCalls(stub func(atc.PlanID) ([]db.BuildApproval, error)) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = stub
+}
+
+func (fake *FakeBuild) ApprovalsArgsForCall(i int) atc.PlanID {
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
+	argsForCall := fake.approvalsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalsReturns(result1 []db.BuildApproval, result2 error) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = nil
+	fake.approvalsReturns = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalsReturnsOnCall(i int, result1 []db.BuildApproval, result2 error) {
+	fake.approvalsMutex.Lock()
+	defer fake.approvalsMutex.Unlock()
+	fake.ApprovalsStub = nil
+	if fake.approvalsReturnsOnCall == nil {
+		fake.approvalsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildApproval
+			result2 error
+		})
+	}
+	fake.approvalsReturnsOnCall[i] = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -1063,6 +1194,170 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) AwaitingApproval() atc.PlanID {
+	fake.awaitingApprovalMutex.Lock()
+	ret, specificReturn := fake.awaitingApprovalReturnsOnCall[len(fake.awaitingApprovalArgsForCall)]
+	fake.awaitingApprovalArgsForCall = append(fake.awaitingApprovalArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AwaitingApproval", []interface{}{})
+	fake.awaitingApprovalMutex.Unlock()
+	if fake.AwaitingApprovalStub != nil {
+		return fake.AwaitingApprovalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.awaitingApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AwaitingApprovalCallCount() int {
+	fake.awaitingApprovalMutex.RLock()
+	defer fake.awaitingApprovalMutex.RUnlock()
+	return len(fake.awaitingApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) AwaitingApprovalCalls(stub func() atc.PlanID) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = stub
+}
+
+func (fake *FakeBuild) AwaitingApprovalReturns(result1 atc.PlanID) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = nil
+	fake.awaitingApprovalReturns = struct {
+		result1 atc.PlanID
+	}{result1}
+}
+
+func (fake *FakeBuild) AwaitingApprovalReturnsOnCall(i int, result1 atc.PlanID) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = nil
+	if fake.awaitingApprovalReturnsOnCall == nil {
+		fake.awaitingApprovalReturnsOnCall = make(map[int]struct {
+			result1 atc.PlanID
+		})
+	}
+	fake.awaitingApprovalReturnsOnCall[i] = struct {
+		result1 atc.PlanID
+	}{result1}
+}
+
+func (fake *FakeBuild) Cause() string {
+	fake.causeMutex.Lock()
+	ret, specificReturn := fake.causeReturnsOnCall[len(fake.causeArgsForCall)]
+	fake.causeArgsForCall = append(fake.causeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Cause", []interface{}{})
+	fake.causeMutex.Unlock()
+	if fake.CauseStub != nil {
+		return fake.CauseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.causeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CauseCallCount() int {
+	fake.causeMutex.RLock()
+	defer fake.causeMutex.RUnlock()
+	return len(fake.causeArgsForCall)
+}
+
+func (fake *FakeBuild) CauseCalls(stub func() string) {
+	fake.causeMutex.Lock()
+	defer fake.causeMutex.Unlock()
+	fake.CauseStub = stub
+}
+
+func (fake *FakeBuild) CauseReturns(result1 string) {
+	fake.causeMutex.Lock()
+	defer fake.causeMutex.Unlock()
+	fake.CauseStub = nil
+	fake.causeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) CauseReturnsOnCall(i int, result1 string) {
+	fake.causeMutex.Lock()
+	defer fake.causeMutex.Unlock()
+	fake.CauseStub = nil
+	if fake.causeReturnsOnCall == nil {
+		fake.causeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.causeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) ClearAwaitingApproval(arg1 atc.PlanID) error {
+	fake.clearAwaitingApprovalMutex.Lock()
+	ret, specificReturn := fake.clearAwaitingApprovalReturnsOnCall[len(fake.clearAwaitingApprovalArgsForCall)]
+	fake.clearAwaitingApprovalArgsForCall = append(fake.clearAwaitingApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("ClearAwaitingApproval", []interface{}{arg1})
+	fake.clearAwaitingApprovalMutex.Unlock()
+	if fake.ClearAwaitingApprovalStub != nil {
+		return fake.ClearAwaitingApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clearAwaitingApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ClearAwaitingApprovalCallCount() int {
+	fake.clearAwaitingApprovalMutex.RLock()
+	defer fake.clearAwaitingApprovalMutex.RUnlock()
+	return len(fake.clearAwaitingApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) ClearAwaitingApprovalCalls(stub func(atc.PlanID) error) {
+	fake.clearAwaitingApprovalMutex.Lock()
+	defer fake.clearAwaitingApprovalMutex.Unlock()
+	fake.ClearAwaitingApprovalStub = stub
+}
+
+func (fake *FakeBuild) ClearAwaitingApprovalArgsForCall(i int) atc.PlanID {
+	fake.clearAwaitingApprovalMutex.RLock()
+	defer fake.clearAwaitingApprovalMutex.RUnlock()
+	argsForCall := fake.clearAwaitingApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ClearAwaitingApprovalReturns(result1 error) {
+	fake.clearAwaitingApprovalMutex.Lock()
+	defer fake.clearAwaitingApprovalMutex.Unlock()
+	fake.ClearAwaitingApprovalStub = nil
+	fake.clearAwaitingApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) ClearAwaitingApprovalReturnsOnCall(i int, result1 error) {
+	fake.clearAwaitingApprovalMutex.Lock()
+	defer fake.clearAwaitingApprovalMutex.Unlock()
+	fake.ClearAwaitingApprovalStub = nil
+	if fake.clearAwaitingApprovalReturnsOnCall == nil {
+		fake.clearAwaitingApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearAwaitingApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -3148,6 +3443,69 @@ func (fake *FakeBuild) ResourcesCheckedReturnsOnCall(i int, result1 bool, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) SaveApproval(arg1 db.BuildApproval) (bool, error) {
+	fake.saveApprovalMutex.Lock()
+	ret, specificReturn := fake.saveApprovalReturnsOnCall[len(fake.saveApprovalArgsForCall)]
+	fake.saveApprovalArgsForCall = append(fake.saveApprovalArgsForCall, struct {
+		arg1 db.BuildApproval
+	}{arg1})
+	fake.recordInvocation("SaveApproval", []interface{}{arg1})
+	fake.saveApprovalMutex.Unlock()
+	if fake.SaveApprovalStub != nil {
+		return fake.SaveApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.saveApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) SaveApprovalCallCount() int {
+	fake.saveApprovalMutex.RLock()
+	defer fake.saveApprovalMutex.RUnlock()
+	return len(fake.saveApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) SaveApprovalCalls(stub func(db.BuildApproval) (bool, error)) {
+	fake.saveApprovalMutex.Lock()
+	defer fake.saveApprovalMutex.Unlock()
+	fake.SaveApprovalStub = stub
+}
+
+func (fake *FakeBuild) SaveApprovalArgsForCall(i int) db.BuildApproval {
+	fake.saveApprovalMutex.RLock()
+	defer fake.saveApprovalMutex.RUnlock()
+	argsForCall := fake.saveApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveApprovalReturns(result1 bool, result2 error) {
+	fake.saveApprovalMutex.Lock()
+	defer fake.saveApprovalMutex.Unlock()
+	fake.SaveApprovalStub = nil
+	fake.saveApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) SaveApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.saveApprovalMutex.Lock()
+	defer fake.saveApprovalMutex.Unlock()
+	fake.SaveApprovalStub = nil
+	if fake.saveApprovalReturnsOnCall == nil {
+		fake.saveApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.saveApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -3456,6 +3814,66 @@ func (fake *FakeBuild) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetAwaitingApproval(arg1 atc.PlanID) error {
+	fake.setAwaitingApprovalMutex.Lock()
+	ret, specificReturn := fake.setAwaitingApprovalReturnsOnCall[len(fake.setAwaitingApprovalArgsForCall)]
+	fake.setAwaitingApprovalArgsForCall = append(fake.setAwaitingApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("SetAwaitingApproval", []interface{}{arg1})
+	fake.setAwaitingApprovalMutex.Unlock()
+	if fake.SetAwaitingApprovalStub != nil {
+		return fake.SetAwaitingApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setAwaitingApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetAwaitingApprovalCallCount() int {
+	fake.setAwaitingApprovalMutex.RLock()
+	defer fake.setAwaitingApprovalMutex.RUnlock()
+	return len(fake.setAwaitingApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) SetAwaitingApprovalCalls(stub func(atc.PlanID) error) {
+	fake.setAwaitingApprovalMutex.Lock()
+	defer fake.setAwaitingApprovalMutex.Unlock()
+	fake.SetAwaitingApprovalStub = stub
+}
+
+func (fake *FakeBuild) SetAwaitingApprovalArgsForCall(i int) atc.PlanID {
+	fake.setAwaitingApprovalMutex.RLock()
+	defer fake.setAwaitingApprovalMutex.RUnlock()
+	argsForCall := fake.setAwaitingApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetAwaitingApprovalReturns(result1 error) {
+	fake.setAwaitingApprovalMutex.Lock()
+	defer fake.setAwaitingApprovalMutex.Unlock()
+	fake.SetAwaitingApprovalStub = nil
+	fake.setAwaitingApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetAwaitingApprovalReturnsOnCall(i int, result1 error) {
+	fake.setAwaitingApprovalMutex.Lock()
+	defer fake.setAwaitingApprovalMutex.Unlock()
+	fake.SetAwaitingApprovalStub = nil
+	if fake.setAwaitingApprovalReturnsOnCall == nil {
+		fake.setAwaitingApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setAwaitingApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetDrained(arg1 bool) error {
 	fake.setDrainedMutex.Lock()
 	ret, specificReturn := fake.setDrainedReturnsOnCall[len(fake.setDrainedArgsForCall)]
@@ -4087,10 +4505,18 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
+	fake.approvalsMutex.RLock()
+	defer fake.approvalsMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.awaitingApprovalMutex.RLock()
+	defer fake.awaitingApprovalMutex.RUnlock()
+	fake.causeMutex.RLock()
+	defer fake.causeMutex.RUnlock()
+	fake.clearAwaitingApprovalMutex.RLock()
+	defer fake.clearAwaitingApprovalMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -4169,6 +4595,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.resourcesMutex.RUnlock()
 	fake.resourcesCheckedMutex.RLock()
 	defer fake.resourcesCheckedMutex.RUnlock()
+	fake.saveApprovalMutex.RLock()
+	defer fake.saveApprovalMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
@@ -4179,6 +4607,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.setAwaitingApprovalMutex.RLock()
+	defer fake.setAwaitingApprovalMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
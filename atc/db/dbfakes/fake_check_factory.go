@@ -4,12 +4,24 @@ package dbfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type FakeCheckFactory struct {
+	NextCheckTimeStub        func(db.Checkable) time.Time
+	nextCheckTimeMutex       sync.RWMutex
+	nextCheckTimeArgsForCall []struct {
+		arg1 db.Checkable
+	}
+	nextCheckTimeReturns struct {
+		result1 time.Time
+	}
+	nextCheckTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	ResourceTypesStub        func() ([]db.ResourceType, error)
 	resourceTypesMutex       sync.RWMutex
 	resourceTypesArgsForCall []struct {
@@ -57,6 +69,66 @@ type FakeCheckFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCheckFactory) NextCheckTime(arg1 db.Checkable) time.Time {
+	fake.nextCheckTimeMutex.Lock()
+	ret, specificReturn := fake.nextCheckTimeReturnsOnCall[len(fake.nextCheckTimeArgsForCall)]
+	fake.nextCheckTimeArgsForCall = append(fake.nextCheckTimeArgsForCall, struct {
+		arg1 db.Checkable
+	}{arg1})
+	fake.recordInvocation("NextCheckTime", []interface{}{arg1})
+	fake.nextCheckTimeMutex.Unlock()
+	if fake.NextCheckTimeStub != nil {
+		return fake.NextCheckTimeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.nextCheckTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckFactory) NextCheckTimeCallCount() int {
+	fake.nextCheckTimeMutex.RLock()
+	defer fake.nextCheckTimeMutex.RUnlock()
+	return len(fake.nextCheckTimeArgsForCall)
+}
+
+func (fake *FakeCheckFactory) NextCheckTimeCalls(stub func(db.Checkable) time.Time) {
+	fake.nextCheckTimeMutex.Lock()
+	defer fake.nextCheckTimeMutex.Unlock()
+	fake.NextCheckTimeStub = stub
+}
+
+func (fake *FakeCheckFactory) NextCheckTimeArgsForCall(i int) db.Checkable {
+	fake.nextCheckTimeMutex.RLock()
+	defer fake.nextCheckTimeMutex.RUnlock()
+	argsForCall := fake.nextCheckTimeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCheckFactory) NextCheckTimeReturns(result1 time.Time) {
+	fake.nextCheckTimeMutex.Lock()
+	defer fake.nextCheckTimeMutex.Unlock()
+	fake.NextCheckTimeStub = nil
+	fake.nextCheckTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheckFactory) NextCheckTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.nextCheckTimeMutex.Lock()
+	defer fake.nextCheckTimeMutex.Unlock()
+	fake.NextCheckTimeStub = nil
+	if fake.nextCheckTimeReturnsOnCall == nil {
+		fake.nextCheckTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.nextCheckTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeCheckFactory) ResourceTypes() ([]db.ResourceType, error) {
 	fake.resourceTypesMutex.Lock()
 	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
@@ -240,6 +312,8 @@ func (fake *FakeCheckFactory) TryCreateCheckReturnsOnCall(i int, result1 db.Buil
 func (fake *FakeCheckFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.nextCheckTimeMutex.RLock()
+	defer fake.nextCheckTimeMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.resourcesMutex.RLock()
@@ -21,6 +21,16 @@ type FakeCheckable struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 *atc.CheckEvery
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CheckPlanStub        func(atc.Version, time.Duration, db.ResourceTypes, atc.Source) atc.CheckPlan
 	checkPlanMutex       sync.RWMutex
 	checkPlanArgsForCall []struct {
@@ -92,6 +102,16 @@ type FakeCheckable struct {
 	lastCheckEndTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LastNewVersionTimeStub        func() time.Time
+	lastNewVersionTimeMutex       sync.RWMutex
+	lastNewVersionTimeArgsForCall []struct {
+	}
+	lastNewVersionTimeReturns struct {
+		result1 time.Time
+	}
+	lastNewVersionTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -272,6 +292,58 @@ func (fake *FakeCheckable) CheckEveryReturnsOnCall(i int, result1 *atc.CheckEver
 	}{result1}
 }
 
+func (fake *FakeCheckable) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if fake.CheckFailuresStub != nil {
+		return fake.CheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckable) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeCheckable) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeCheckable) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeCheckable) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeCheckable) CheckPlan(arg1 atc.Version, arg2 time.Duration, arg3 db.ResourceTypes, arg4 atc.Source) atc.CheckPlan {
 	fake.checkPlanMutex.Lock()
 	ret, specificReturn := fake.checkPlanReturnsOnCall[len(fake.checkPlanArgsForCall)]
@@ -611,6 +683,58 @@ func (fake *FakeCheckable) LastCheckEndTimeReturnsOnCall(i int, result1 time.Tim
 	}{result1}
 }
 
+func (fake *FakeCheckable) LastNewVersionTime() time.Time {
+	fake.lastNewVersionTimeMutex.Lock()
+	ret, specificReturn := fake.lastNewVersionTimeReturnsOnCall[len(fake.lastNewVersionTimeArgsForCall)]
+	fake.lastNewVersionTimeArgsForCall = append(fake.lastNewVersionTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastNewVersionTime", []interface{}{})
+	fake.lastNewVersionTimeMutex.Unlock()
+	if fake.LastNewVersionTimeStub != nil {
+		return fake.LastNewVersionTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastNewVersionTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheckable) LastNewVersionTimeCallCount() int {
+	fake.lastNewVersionTimeMutex.RLock()
+	defer fake.lastNewVersionTimeMutex.RUnlock()
+	return len(fake.lastNewVersionTimeArgsForCall)
+}
+
+func (fake *FakeCheckable) LastNewVersionTimeCalls(stub func() time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = stub
+}
+
+func (fake *FakeCheckable) LastNewVersionTimeReturns(result1 time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = nil
+	fake.lastNewVersionTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCheckable) LastNewVersionTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = nil
+	if fake.lastNewVersionTimeReturnsOnCall == nil {
+		fake.lastNewVersionTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastNewVersionTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeCheckable) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1246,6 +1370,8 @@ func (fake *FakeCheckable) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
 	fake.checkPlanMutex.RLock()
 	defer fake.checkPlanMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
@@ -1258,6 +1384,8 @@ func (fake *FakeCheckable) Invocations() map[string][][]interface{} {
 	defer fake.hasWebhookMutex.RUnlock()
 	fake.lastCheckEndTimeMutex.RLock()
 	defer fake.lastCheckEndTimeMutex.RUnlock()
+	fake.lastNewVersionTimeMutex.RLock()
+	defer fake.lastNewVersionTimeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pipelineMutex.RLock()
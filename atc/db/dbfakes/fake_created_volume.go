@@ -31,6 +31,18 @@ type FakeCreatedVolume struct {
 	containerHandleReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CreateChildForArtifactStub        func() (db.CreatingVolume, error)
+	createChildForArtifactMutex       sync.RWMutex
+	createChildForArtifactArgsForCall []struct {
+	}
+	createChildForArtifactReturns struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
+	createChildForArtifactReturnsOnCall map[int]struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
 	CreateChildForContainerStub        func(db.CreatingContainer, string) (db.CreatingVolume, error)
 	createChildForContainerMutex       sync.RWMutex
 	createChildForContainerArgsForCall []struct {
@@ -45,6 +57,19 @@ type FakeCreatedVolume struct {
 		result1 db.CreatingVolume
 		result2 error
 	}
+	CreateChildForResourceCacheStub        func(db.UsedResourceCache) (db.CreatingVolume, error)
+	createChildForResourceCacheMutex       sync.RWMutex
+	createChildForResourceCacheArgsForCall []struct {
+		arg1 db.UsedResourceCache
+	}
+	createChildForResourceCacheReturns struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
+	createChildForResourceCacheReturnsOnCall map[int]struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
 	DestroyingStub        func() (db.DestroyingVolume, error)
 	destroyingMutex       sync.RWMutex
 	destroyingArgsForCall []struct {
@@ -316,6 +341,61 @@ func (fake *FakeCreatedVolume) ContainerHandleReturnsOnCall(i int, result1 strin
 	}{result1}
 }
 
+func (fake *FakeCreatedVolume) CreateChildForArtifact() (db.CreatingVolume, error) {
+	fake.createChildForArtifactMutex.Lock()
+	ret, specificReturn := fake.createChildForArtifactReturnsOnCall[len(fake.createChildForArtifactArgsForCall)]
+	fake.createChildForArtifactArgsForCall = append(fake.createChildForArtifactArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateChildForArtifact", []interface{}{})
+	fake.createChildForArtifactMutex.Unlock()
+	if fake.CreateChildForArtifactStub != nil {
+		return fake.CreateChildForArtifactStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactCallCount() int {
+	fake.createChildForArtifactMutex.RLock()
+	defer fake.createChildForArtifactMutex.RUnlock()
+	return len(fake.createChildForArtifactArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactCalls(stub func() (db.CreatingVolume, error)) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = stub
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactReturns(result1 db.CreatingVolume, result2 error) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = nil
+	fake.createChildForArtifactReturns = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactReturnsOnCall(i int, result1 db.CreatingVolume, result2 error) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = nil
+	if fake.createChildForArtifactReturnsOnCall == nil {
+		fake.createChildForArtifactReturnsOnCall = make(map[int]struct {
+			result1 db.CreatingVolume
+			result2 error
+		})
+	}
+	fake.createChildForArtifactReturnsOnCall[i] = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreatedVolume) CreateChildForContainer(arg1 db.CreatingContainer, arg2 string) (db.CreatingVolume, error) {
 	fake.createChildForContainerMutex.Lock()
 	ret, specificReturn := fake.createChildForContainerReturnsOnCall[len(fake.createChildForContainerArgsForCall)]
@@ -380,6 +460,69 @@ func (fake *FakeCreatedVolume) CreateChildForContainerReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeCreatedVolume) CreateChildForResourceCache(arg1 db.UsedResourceCache) (db.CreatingVolume, error) {
+	fake.createChildForResourceCacheMutex.Lock()
+	ret, specificReturn := fake.createChildForResourceCacheReturnsOnCall[len(fake.createChildForResourceCacheArgsForCall)]
+	fake.createChildForResourceCacheArgsForCall = append(fake.createChildForResourceCacheArgsForCall, struct {
+		arg1 db.UsedResourceCache
+	}{arg1})
+	fake.recordInvocation("CreateChildForResourceCache", []interface{}{arg1})
+	fake.createChildForResourceCacheMutex.Unlock()
+	if fake.CreateChildForResourceCacheStub != nil {
+		return fake.CreateChildForResourceCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildForResourceCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreatedVolume) CreateChildForResourceCacheCallCount() int {
+	fake.createChildForResourceCacheMutex.RLock()
+	defer fake.createChildForResourceCacheMutex.RUnlock()
+	return len(fake.createChildForResourceCacheArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) CreateChildForResourceCacheCalls(stub func(db.UsedResourceCache) (db.CreatingVolume, error)) {
+	fake.createChildForResourceCacheMutex.Lock()
+	defer fake.createChildForResourceCacheMutex.Unlock()
+	fake.CreateChildForResourceCacheStub = stub
+}
+
+func (fake *FakeCreatedVolume) CreateChildForResourceCacheArgsForCall(i int) db.UsedResourceCache {
+	fake.createChildForResourceCacheMutex.RLock()
+	defer fake.createChildForResourceCacheMutex.RUnlock()
+	argsForCall := fake.createChildForResourceCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCreatedVolume) CreateChildForResourceCacheReturns(result1 db.CreatingVolume, result2 error) {
+	fake.createChildForResourceCacheMutex.Lock()
+	defer fake.createChildForResourceCacheMutex.Unlock()
+	fake.CreateChildForResourceCacheStub = nil
+	fake.createChildForResourceCacheReturns = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedVolume) CreateChildForResourceCacheReturnsOnCall(i int, result1 db.CreatingVolume, result2 error) {
+	fake.createChildForResourceCacheMutex.Lock()
+	defer fake.createChildForResourceCacheMutex.Unlock()
+	fake.CreateChildForResourceCacheStub = nil
+	if fake.createChildForResourceCacheReturnsOnCall == nil {
+		fake.createChildForResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 db.CreatingVolume
+			result2 error
+		})
+	}
+	fake.createChildForResourceCacheReturnsOnCall[i] = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreatedVolume) Destroying() (db.DestroyingVolume, error) {
 	fake.destroyingMutex.Lock()
 	ret, specificReturn := fake.destroyingReturnsOnCall[len(fake.destroyingArgsForCall)]
@@ -1163,8 +1306,12 @@ func (fake *FakeCreatedVolume) Invocations() map[string][][]interface{} {
 	defer fake.baseResourceTypeMutex.RUnlock()
 	fake.containerHandleMutex.RLock()
 	defer fake.containerHandleMutex.RUnlock()
+	fake.createChildForArtifactMutex.RLock()
+	defer fake.createChildForArtifactMutex.RUnlock()
 	fake.createChildForContainerMutex.RLock()
 	defer fake.createChildForContainerMutex.RUnlock()
+	fake.createChildForResourceCacheMutex.RLock()
+	defer fake.createChildForResourceCacheMutex.RUnlock()
 	fake.destroyingMutex.RLock()
 	defer fake.destroyingMutex.RUnlock()
 	fake.getResourceCacheIDMutex.RLock()
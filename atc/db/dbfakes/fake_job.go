@@ -28,6 +28,18 @@ type FakeJob struct {
 		result2 bool
 		result3 error
 	}
+	AlgorithmDependenciesStub        func() (db.DependencyConfigs, error)
+	algorithmDependenciesMutex       sync.RWMutex
+	algorithmDependenciesArgsForCall []struct {
+	}
+	algorithmDependenciesReturns struct {
+		result1 db.DependencyConfigs
+		result2 error
+	}
+	algorithmDependenciesReturnsOnCall map[int]struct {
+		result1 db.DependencyConfigs
+		result2 error
+	}
 	AlgorithmInputsStub        func() (db.InputConfigs, error)
 	algorithmInputsMutex       sync.RWMutex
 	algorithmInputsArgsForCall []struct {
@@ -123,6 +135,21 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateScheduledBuildStub        func(time.Time) (db.Build, bool, error)
+	createScheduledBuildMutex       sync.RWMutex
+	createScheduledBuildArgsForCall []struct {
+		arg1 time.Time
+	}
+	createScheduledBuildReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	createScheduledBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	DisableManualTriggerStub        func() bool
 	disableManualTriggerMutex       sync.RWMutex
 	disableManualTriggerArgsForCall []struct {
@@ -389,6 +416,17 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	SaveNextDependencyMappingStub        func(db.DependencyMapping) error
+	saveNextDependencyMappingMutex       sync.RWMutex
+	saveNextDependencyMappingArgsForCall []struct {
+		arg1 db.DependencyMapping
+	}
+	saveNextDependencyMappingReturns struct {
+		result1 error
+	}
+	saveNextDependencyMappingReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveNextInputMappingStub        func(db.InputMapping, bool) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -414,6 +452,16 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	ScheduleEvaluatedTimeStub        func() time.Time
+	scheduleEvaluatedTimeMutex       sync.RWMutex
+	scheduleEvaluatedTimeArgsForCall []struct {
+	}
+	scheduleEvaluatedTimeReturns struct {
+		result1 time.Time
+	}
+	scheduleEvaluatedTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	ScheduleRequestedTimeStub        func() time.Time
 	scheduleRequestedTimeMutex       sync.RWMutex
 	scheduleRequestedTimeArgsForCall []struct {
@@ -567,6 +615,61 @@ func (fake *FakeJob) AcquireSchedulingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeJob) AlgorithmDependencies() (db.DependencyConfigs, error) {
+	fake.algorithmDependenciesMutex.Lock()
+	ret, specificReturn := fake.algorithmDependenciesReturnsOnCall[len(fake.algorithmDependenciesArgsForCall)]
+	fake.algorithmDependenciesArgsForCall = append(fake.algorithmDependenciesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AlgorithmDependencies", []interface{}{})
+	fake.algorithmDependenciesMutex.Unlock()
+	if fake.AlgorithmDependenciesStub != nil {
+		return fake.AlgorithmDependenciesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.algorithmDependenciesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) AlgorithmDependenciesCallCount() int {
+	fake.algorithmDependenciesMutex.RLock()
+	defer fake.algorithmDependenciesMutex.RUnlock()
+	return len(fake.algorithmDependenciesArgsForCall)
+}
+
+func (fake *FakeJob) AlgorithmDependenciesCalls(stub func() (db.DependencyConfigs, error)) {
+	fake.algorithmDependenciesMutex.Lock()
+	defer fake.algorithmDependenciesMutex.Unlock()
+	fake.AlgorithmDependenciesStub = stub
+}
+
+func (fake *FakeJob) AlgorithmDependenciesReturns(result1 db.DependencyConfigs, result2 error) {
+	fake.algorithmDependenciesMutex.Lock()
+	defer fake.algorithmDependenciesMutex.Unlock()
+	fake.AlgorithmDependenciesStub = nil
+	fake.algorithmDependenciesReturns = struct {
+		result1 db.DependencyConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) AlgorithmDependenciesReturnsOnCall(i int, result1 db.DependencyConfigs, result2 error) {
+	fake.algorithmDependenciesMutex.Lock()
+	defer fake.algorithmDependenciesMutex.Unlock()
+	fake.AlgorithmDependenciesStub = nil
+	if fake.algorithmDependenciesReturnsOnCall == nil {
+		fake.algorithmDependenciesReturnsOnCall = make(map[int]struct {
+			result1 db.DependencyConfigs
+			result2 error
+		})
+	}
+	fake.algorithmDependenciesReturnsOnCall[i] = struct {
+		result1 db.DependencyConfigs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) AlgorithmInputs() (db.InputConfigs, error) {
 	fake.algorithmInputsMutex.Lock()
 	ret, specificReturn := fake.algorithmInputsReturnsOnCall[len(fake.algorithmInputsArgsForCall)]
@@ -994,6 +1097,72 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateScheduledBuild(arg1 time.Time) (db.Build, bool, error) {
+	fake.createScheduledBuildMutex.Lock()
+	ret, specificReturn := fake.createScheduledBuildReturnsOnCall[len(fake.createScheduledBuildArgsForCall)]
+	fake.createScheduledBuildArgsForCall = append(fake.createScheduledBuildArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("CreateScheduledBuild", []interface{}{arg1})
+	fake.createScheduledBuildMutex.Unlock()
+	if fake.CreateScheduledBuildStub != nil {
+		return fake.CreateScheduledBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createScheduledBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) CreateScheduledBuildCallCount() int {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	return len(fake.createScheduledBuildArgsForCall)
+}
+
+func (fake *FakeJob) CreateScheduledBuildCalls(stub func(time.Time) (db.Build, bool, error)) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = stub
+}
+
+func (fake *FakeJob) CreateScheduledBuildArgsForCall(i int) time.Time {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	argsForCall := fake.createScheduledBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	fake.createScheduledBuildReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	if fake.createScheduledBuildReturnsOnCall == nil {
+		fake.createScheduledBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createScheduledBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) DisableManualTrigger() bool {
 	fake.disableManualTriggerMutex.Lock()
 	ret, specificReturn := fake.disableManualTriggerReturnsOnCall[len(fake.disableManualTriggerArgsForCall)]
@@ -2294,6 +2463,66 @@ func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeJob) SaveNextDependencyMapping(arg1 db.DependencyMapping) error {
+	fake.saveNextDependencyMappingMutex.Lock()
+	ret, specificReturn := fake.saveNextDependencyMappingReturnsOnCall[len(fake.saveNextDependencyMappingArgsForCall)]
+	fake.saveNextDependencyMappingArgsForCall = append(fake.saveNextDependencyMappingArgsForCall, struct {
+		arg1 db.DependencyMapping
+	}{arg1})
+	fake.recordInvocation("SaveNextDependencyMapping", []interface{}{arg1})
+	fake.saveNextDependencyMappingMutex.Unlock()
+	if fake.SaveNextDependencyMappingStub != nil {
+		return fake.SaveNextDependencyMappingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveNextDependencyMappingReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SaveNextDependencyMappingCallCount() int {
+	fake.saveNextDependencyMappingMutex.RLock()
+	defer fake.saveNextDependencyMappingMutex.RUnlock()
+	return len(fake.saveNextDependencyMappingArgsForCall)
+}
+
+func (fake *FakeJob) SaveNextDependencyMappingCalls(stub func(db.DependencyMapping) error) {
+	fake.saveNextDependencyMappingMutex.Lock()
+	defer fake.saveNextDependencyMappingMutex.Unlock()
+	fake.SaveNextDependencyMappingStub = stub
+}
+
+func (fake *FakeJob) SaveNextDependencyMappingArgsForCall(i int) db.DependencyMapping {
+	fake.saveNextDependencyMappingMutex.RLock()
+	defer fake.saveNextDependencyMappingMutex.RUnlock()
+	argsForCall := fake.saveNextDependencyMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SaveNextDependencyMappingReturns(result1 error) {
+	fake.saveNextDependencyMappingMutex.Lock()
+	defer fake.saveNextDependencyMappingMutex.Unlock()
+	fake.SaveNextDependencyMappingStub = nil
+	fake.saveNextDependencyMappingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SaveNextDependencyMappingReturnsOnCall(i int, result1 error) {
+	fake.saveNextDependencyMappingMutex.Lock()
+	defer fake.saveNextDependencyMappingMutex.Unlock()
+	fake.SaveNextDependencyMappingStub = nil
+	if fake.saveNextDependencyMappingReturnsOnCall == nil {
+		fake.saveNextDependencyMappingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveNextDependencyMappingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) SaveNextInputMapping(arg1 db.InputMapping, arg2 bool) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -2418,6 +2647,58 @@ func (fake *FakeJob) ScheduleBuildReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeJob) ScheduleEvaluatedTime() time.Time {
+	fake.scheduleEvaluatedTimeMutex.Lock()
+	ret, specificReturn := fake.scheduleEvaluatedTimeReturnsOnCall[len(fake.scheduleEvaluatedTimeArgsForCall)]
+	fake.scheduleEvaluatedTimeArgsForCall = append(fake.scheduleEvaluatedTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ScheduleEvaluatedTime", []interface{}{})
+	fake.scheduleEvaluatedTimeMutex.Unlock()
+	if fake.ScheduleEvaluatedTimeStub != nil {
+		return fake.ScheduleEvaluatedTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleEvaluatedTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleEvaluatedTimeCallCount() int {
+	fake.scheduleEvaluatedTimeMutex.RLock()
+	defer fake.scheduleEvaluatedTimeMutex.RUnlock()
+	return len(fake.scheduleEvaluatedTimeArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleEvaluatedTimeCalls(stub func() time.Time) {
+	fake.scheduleEvaluatedTimeMutex.Lock()
+	defer fake.scheduleEvaluatedTimeMutex.Unlock()
+	fake.ScheduleEvaluatedTimeStub = stub
+}
+
+func (fake *FakeJob) ScheduleEvaluatedTimeReturns(result1 time.Time) {
+	fake.scheduleEvaluatedTimeMutex.Lock()
+	defer fake.scheduleEvaluatedTimeMutex.Unlock()
+	fake.ScheduleEvaluatedTimeStub = nil
+	fake.scheduleEvaluatedTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleEvaluatedTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.scheduleEvaluatedTimeMutex.Lock()
+	defer fake.scheduleEvaluatedTimeMutex.Unlock()
+	fake.ScheduleEvaluatedTimeStub = nil
+	if fake.scheduleEvaluatedTimeReturnsOnCall == nil {
+		fake.scheduleEvaluatedTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.scheduleEvaluatedTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleRequestedTime() time.Time {
 	fake.scheduleRequestedTimeMutex.Lock()
 	ret, specificReturn := fake.scheduleRequestedTimeReturnsOnCall[len(fake.scheduleRequestedTimeArgsForCall)]
@@ -2863,6 +3144,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.acquireSchedulingLockMutex.RLock()
 	defer fake.acquireSchedulingLockMutex.RUnlock()
+	fake.algorithmDependenciesMutex.RLock()
+	defer fake.algorithmDependenciesMutex.RUnlock()
 	fake.algorithmInputsMutex.RLock()
 	defer fake.algorithmInputsMutex.RUnlock()
 	fake.buildMutex.RLock()
@@ -2877,6 +3160,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
 	fake.disableManualTriggerMutex.RLock()
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -2925,10 +3210,14 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.requestScheduleMutex.RUnlock()
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
+	fake.saveNextDependencyMappingMutex.RLock()
+	defer fake.saveNextDependencyMappingMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
+	fake.scheduleEvaluatedTimeMutex.RLock()
+	defer fake.scheduleEvaluatedTimeMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
@@ -100,6 +100,21 @@ type FakePipeline struct {
 		result1 atc.Config
 		result2 error
 	}
+	ConfigRevisionStub        func(db.ConfigVersion) (atc.Config, bool, error)
+	configRevisionMutex       sync.RWMutex
+	configRevisionArgsForCall []struct {
+		arg1 db.ConfigVersion
+	}
+	configRevisionReturns struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
+	configRevisionReturnsOnCall map[int]struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
 	ConfigVersionStub        func() db.ConfigVersion
 	configVersionMutex       sync.RWMutex
 	configVersionArgsForCall []struct {
@@ -956,6 +971,72 @@ func (fake *FakePipeline) ConfigReturnsOnCall(i int, result1 atc.Config, result2
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) ConfigRevision(arg1 db.ConfigVersion) (atc.Config, bool, error) {
+	fake.configRevisionMutex.Lock()
+	ret, specificReturn := fake.configRevisionReturnsOnCall[len(fake.configRevisionArgsForCall)]
+	fake.configRevisionArgsForCall = append(fake.configRevisionArgsForCall, struct {
+		arg1 db.ConfigVersion
+	}{arg1})
+	fake.recordInvocation("ConfigRevision", []interface{}{arg1})
+	fake.configRevisionMutex.Unlock()
+	if fake.ConfigRevisionStub != nil {
+		return fake.ConfigRevisionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.configRevisionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) ConfigRevisionCallCount() int {
+	fake.configRevisionMutex.RLock()
+	defer fake.configRevisionMutex.RUnlock()
+	return len(fake.configRevisionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigRevisionCalls(stub func(db.ConfigVersion) (atc.Config, bool, error)) {
+	fake.configRevisionMutex.Lock()
+	defer fake.configRevisionMutex.Unlock()
+	fake.ConfigRevisionStub = stub
+}
+
+func (fake *FakePipeline) ConfigRevisionArgsForCall(i int) db.ConfigVersion {
+	fake.configRevisionMutex.RLock()
+	defer fake.configRevisionMutex.RUnlock()
+	argsForCall := fake.configRevisionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ConfigRevisionReturns(result1 atc.Config, result2 bool, result3 error) {
+	fake.configRevisionMutex.Lock()
+	defer fake.configRevisionMutex.Unlock()
+	fake.ConfigRevisionStub = nil
+	fake.configRevisionReturns = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigRevisionReturnsOnCall(i int, result1 atc.Config, result2 bool, result3 error) {
+	fake.configRevisionMutex.Lock()
+	defer fake.configRevisionMutex.Unlock()
+	fake.ConfigRevisionStub = nil
+	if fake.configRevisionReturnsOnCall == nil {
+		fake.configRevisionReturnsOnCall = make(map[int]struct {
+			result1 atc.Config
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configRevisionReturnsOnCall[i] = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) ConfigVersion() db.ConfigVersion {
 	fake.configVersionMutex.Lock()
 	ret, specificReturn := fake.configVersionReturnsOnCall[len(fake.configVersionArgsForCall)]
@@ -3124,6 +3205,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.checkPausedMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
+	fake.configRevisionMutex.RLock()
+	defer fake.configRevisionMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -21,6 +21,21 @@ type FakeResource struct {
 	aPIPinnedVersionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	AnnotateVersionStub        func(int, string, string) (bool, error)
+	annotateVersionMutex       sync.RWMutex
+	annotateVersionArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	annotateVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	annotateVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	BuildSummaryStub        func() *atc.BuildSummary
 	buildSummaryMutex       sync.RWMutex
 	buildSummaryArgsForCall []struct {
@@ -41,6 +56,16 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 *atc.CheckEvery
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CheckPlanStub        func(atc.Version, time.Duration, db.ResourceTypes, atc.Source) atc.CheckPlan
 	checkPlanMutex       sync.RWMutex
 	checkPlanArgsForCall []struct {
@@ -199,6 +224,16 @@ type FakeResource struct {
 	lastCheckStartTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LastNewVersionTimeStub        func() time.Time
+	lastNewVersionTimeMutex       sync.RWMutex
+	lastNewVersionTimeArgsForCall []struct {
+	}
+	lastNewVersionTimeReturns struct {
+		result1 time.Time
+	}
+	lastNewVersionTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -229,10 +264,22 @@ type FakeResource struct {
 	pinCommentReturnsOnCall map[int]struct {
 		result1 string
 	}
-	PinVersionStub        func(int) (bool, error)
+	PinExpiresAtStub        func() time.Time
+	pinExpiresAtMutex       sync.RWMutex
+	pinExpiresAtArgsForCall []struct {
+	}
+	pinExpiresAtReturns struct {
+		result1 time.Time
+	}
+	pinExpiresAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	PinVersionStub        func(int, string, time.Time) (bool, error)
 	pinVersionMutex       sync.RWMutex
 	pinVersionArgsForCall []struct {
 		arg1 int
+		arg2 string
+		arg3 time.Time
 	}
 	pinVersionReturns struct {
 		result1 bool
@@ -242,6 +289,16 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	PinnedByStub        func() string
+	pinnedByMutex       sync.RWMutex
+	pinnedByArgsForCall []struct {
+	}
+	pinnedByReturns struct {
+		result1 string
+	}
+	pinnedByReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -318,6 +375,20 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	RemoveVersionAnnotationStub        func(int, string) (bool, error)
+	removeVersionAnnotationMutex       sync.RWMutex
+	removeVersionAnnotationArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	removeVersionAnnotationReturns struct {
+		result1 bool
+		result2 error
+	}
+	removeVersionAnnotationReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ResourceConfigIDStub        func() int
 	resourceConfigIDMutex       sync.RWMutex
 	resourceConfigIDArgsForCall []struct {
@@ -410,6 +481,19 @@ type FakeResource struct {
 	typeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnpinExpiredVersionStub        func(time.Time) (bool, error)
+	unpinExpiredVersionMutex       sync.RWMutex
+	unpinExpiredVersionArgsForCall []struct {
+		arg1 time.Time
+	}
+	unpinExpiredVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	unpinExpiredVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UnpinVersionStub        func() error
 	unpinVersionMutex       sync.RWMutex
 	unpinVersionArgsForCall []struct {
@@ -518,6 +602,71 @@ func (fake *FakeResource) APIPinnedVersionReturnsOnCall(i int, result1 atc.Versi
 	}{result1}
 }
 
+func (fake *FakeResource) AnnotateVersion(arg1 int, arg2 string, arg3 string) (bool, error) {
+	fake.annotateVersionMutex.Lock()
+	ret, specificReturn := fake.annotateVersionReturnsOnCall[len(fake.annotateVersionArgsForCall)]
+	fake.annotateVersionArgsForCall = append(fake.annotateVersionArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AnnotateVersion", []interface{}{arg1, arg2, arg3})
+	fake.annotateVersionMutex.Unlock()
+	if fake.AnnotateVersionStub != nil {
+		return fake.AnnotateVersionStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.annotateVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) AnnotateVersionCallCount() int {
+	fake.annotateVersionMutex.RLock()
+	defer fake.annotateVersionMutex.RUnlock()
+	return len(fake.annotateVersionArgsForCall)
+}
+
+func (fake *FakeResource) AnnotateVersionCalls(stub func(int, string, string) (bool, error)) {
+	fake.annotateVersionMutex.Lock()
+	defer fake.annotateVersionMutex.Unlock()
+	fake.AnnotateVersionStub = stub
+}
+
+func (fake *FakeResource) AnnotateVersionArgsForCall(i int) (int, string, string) {
+	fake.annotateVersionMutex.RLock()
+	defer fake.annotateVersionMutex.RUnlock()
+	argsForCall := fake.annotateVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeResource) AnnotateVersionReturns(result1 bool, result2 error) {
+	fake.annotateVersionMutex.Lock()
+	defer fake.annotateVersionMutex.Unlock()
+	fake.AnnotateVersionStub = nil
+	fake.annotateVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) AnnotateVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.annotateVersionMutex.Lock()
+	defer fake.annotateVersionMutex.Unlock()
+	fake.AnnotateVersionStub = nil
+	if fake.annotateVersionReturnsOnCall == nil {
+		fake.annotateVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.annotateVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) BuildSummary() *atc.BuildSummary {
 	fake.buildSummaryMutex.Lock()
 	ret, specificReturn := fake.buildSummaryReturnsOnCall[len(fake.buildSummaryArgsForCall)]
@@ -622,6 +771,58 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 *atc.CheckEvery
 	}{result1}
 }
 
+func (fake *FakeResource) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if fake.CheckFailuresStub != nil {
+		return fake.CheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeResource) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeResource) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResource) CheckPlan(arg1 atc.Version, arg2 time.Duration, arg3 db.ResourceTypes, arg4 atc.Source) atc.CheckPlan {
 	fake.checkPlanMutex.Lock()
 	ret, specificReturn := fake.checkPlanReturnsOnCall[len(fake.checkPlanArgsForCall)]
@@ -1407,6 +1608,58 @@ func (fake *FakeResource) LastCheckStartTimeReturnsOnCall(i int, result1 time.Ti
 	}{result1}
 }
 
+func (fake *FakeResource) LastNewVersionTime() time.Time {
+	fake.lastNewVersionTimeMutex.Lock()
+	ret, specificReturn := fake.lastNewVersionTimeReturnsOnCall[len(fake.lastNewVersionTimeArgsForCall)]
+	fake.lastNewVersionTimeArgsForCall = append(fake.lastNewVersionTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastNewVersionTime", []interface{}{})
+	fake.lastNewVersionTimeMutex.Unlock()
+	if fake.LastNewVersionTimeStub != nil {
+		return fake.LastNewVersionTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastNewVersionTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) LastNewVersionTimeCallCount() int {
+	fake.lastNewVersionTimeMutex.RLock()
+	defer fake.lastNewVersionTimeMutex.RUnlock()
+	return len(fake.lastNewVersionTimeArgsForCall)
+}
+
+func (fake *FakeResource) LastNewVersionTimeCalls(stub func() time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = stub
+}
+
+func (fake *FakeResource) LastNewVersionTimeReturns(result1 time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = nil
+	fake.lastNewVersionTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) LastNewVersionTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = nil
+	if fake.lastNewVersionTimeReturnsOnCall == nil {
+		fake.lastNewVersionTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastNewVersionTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeResource) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1563,16 +1816,70 @@ func (fake *FakeResource) PinCommentReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakeResource) PinVersion(arg1 int) (bool, error) {
+func (fake *FakeResource) PinExpiresAt() time.Time {
+	fake.pinExpiresAtMutex.Lock()
+	ret, specificReturn := fake.pinExpiresAtReturnsOnCall[len(fake.pinExpiresAtArgsForCall)]
+	fake.pinExpiresAtArgsForCall = append(fake.pinExpiresAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinExpiresAt", []interface{}{})
+	fake.pinExpiresAtMutex.Unlock()
+	if fake.PinExpiresAtStub != nil {
+		return fake.PinExpiresAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinExpiresAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinExpiresAtCallCount() int {
+	fake.pinExpiresAtMutex.RLock()
+	defer fake.pinExpiresAtMutex.RUnlock()
+	return len(fake.pinExpiresAtArgsForCall)
+}
+
+func (fake *FakeResource) PinExpiresAtCalls(stub func() time.Time) {
+	fake.pinExpiresAtMutex.Lock()
+	defer fake.pinExpiresAtMutex.Unlock()
+	fake.PinExpiresAtStub = stub
+}
+
+func (fake *FakeResource) PinExpiresAtReturns(result1 time.Time) {
+	fake.pinExpiresAtMutex.Lock()
+	defer fake.pinExpiresAtMutex.Unlock()
+	fake.PinExpiresAtStub = nil
+	fake.pinExpiresAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) PinExpiresAtReturnsOnCall(i int, result1 time.Time) {
+	fake.pinExpiresAtMutex.Lock()
+	defer fake.pinExpiresAtMutex.Unlock()
+	fake.PinExpiresAtStub = nil
+	if fake.pinExpiresAtReturnsOnCall == nil {
+		fake.pinExpiresAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.pinExpiresAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) PinVersion(arg1 int, arg2 string, arg3 time.Time) (bool, error) {
 	fake.pinVersionMutex.Lock()
 	ret, specificReturn := fake.pinVersionReturnsOnCall[len(fake.pinVersionArgsForCall)]
 	fake.pinVersionArgsForCall = append(fake.pinVersionArgsForCall, struct {
 		arg1 int
-	}{arg1})
-	fake.recordInvocation("PinVersion", []interface{}{arg1})
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PinVersion", []interface{}{arg1, arg2, arg3})
 	fake.pinVersionMutex.Unlock()
 	if fake.PinVersionStub != nil {
-		return fake.PinVersionStub(arg1)
+		return fake.PinVersionStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1587,17 +1894,17 @@ func (fake *FakeResource) PinVersionCallCount() int {
 	return len(fake.pinVersionArgsForCall)
 }
 
-func (fake *FakeResource) PinVersionCalls(stub func(int) (bool, error)) {
+func (fake *FakeResource) PinVersionCalls(stub func(int, string, time.Time) (bool, error)) {
 	fake.pinVersionMutex.Lock()
 	defer fake.pinVersionMutex.Unlock()
 	fake.PinVersionStub = stub
 }
 
-func (fake *FakeResource) PinVersionArgsForCall(i int) int {
+func (fake *FakeResource) PinVersionArgsForCall(i int) (int, string, time.Time) {
 	fake.pinVersionMutex.RLock()
 	defer fake.pinVersionMutex.RUnlock()
 	argsForCall := fake.pinVersionArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeResource) PinVersionReturns(result1 bool, result2 error) {
@@ -1626,6 +1933,58 @@ func (fake *FakeResource) PinVersionReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeResource) PinnedBy() string {
+	fake.pinnedByMutex.Lock()
+	ret, specificReturn := fake.pinnedByReturnsOnCall[len(fake.pinnedByArgsForCall)]
+	fake.pinnedByArgsForCall = append(fake.pinnedByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PinnedBy", []interface{}{})
+	fake.pinnedByMutex.Unlock()
+	if fake.PinnedByStub != nil {
+		return fake.PinnedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pinnedByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PinnedByCallCount() int {
+	fake.pinnedByMutex.RLock()
+	defer fake.pinnedByMutex.RUnlock()
+	return len(fake.pinnedByArgsForCall)
+}
+
+func (fake *FakeResource) PinnedByCalls(stub func() string) {
+	fake.pinnedByMutex.Lock()
+	defer fake.pinnedByMutex.Unlock()
+	fake.PinnedByStub = stub
+}
+
+func (fake *FakeResource) PinnedByReturns(result1 string) {
+	fake.pinnedByMutex.Lock()
+	defer fake.pinnedByMutex.Unlock()
+	fake.PinnedByStub = nil
+	fake.pinnedByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) PinnedByReturnsOnCall(i int, result1 string) {
+	fake.pinnedByMutex.Lock()
+	defer fake.pinnedByMutex.Unlock()
+	fake.PinnedByStub = nil
+	if fake.pinnedByReturnsOnCall == nil {
+		fake.pinnedByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pinnedByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeResource) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1999,6 +2358,70 @@ func (fake *FakeResource) ReloadReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeResource) RemoveVersionAnnotation(arg1 int, arg2 string) (bool, error) {
+	fake.removeVersionAnnotationMutex.Lock()
+	ret, specificReturn := fake.removeVersionAnnotationReturnsOnCall[len(fake.removeVersionAnnotationArgsForCall)]
+	fake.removeVersionAnnotationArgsForCall = append(fake.removeVersionAnnotationArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RemoveVersionAnnotation", []interface{}{arg1, arg2})
+	fake.removeVersionAnnotationMutex.Unlock()
+	if fake.RemoveVersionAnnotationStub != nil {
+		return fake.RemoveVersionAnnotationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeVersionAnnotationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) RemoveVersionAnnotationCallCount() int {
+	fake.removeVersionAnnotationMutex.RLock()
+	defer fake.removeVersionAnnotationMutex.RUnlock()
+	return len(fake.removeVersionAnnotationArgsForCall)
+}
+
+func (fake *FakeResource) RemoveVersionAnnotationCalls(stub func(int, string) (bool, error)) {
+	fake.removeVersionAnnotationMutex.Lock()
+	defer fake.removeVersionAnnotationMutex.Unlock()
+	fake.RemoveVersionAnnotationStub = stub
+}
+
+func (fake *FakeResource) RemoveVersionAnnotationArgsForCall(i int) (int, string) {
+	fake.removeVersionAnnotationMutex.RLock()
+	defer fake.removeVersionAnnotationMutex.RUnlock()
+	argsForCall := fake.removeVersionAnnotationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) RemoveVersionAnnotationReturns(result1 bool, result2 error) {
+	fake.removeVersionAnnotationMutex.Lock()
+	defer fake.removeVersionAnnotationMutex.Unlock()
+	fake.RemoveVersionAnnotationStub = nil
+	fake.removeVersionAnnotationReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) RemoveVersionAnnotationReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.removeVersionAnnotationMutex.Lock()
+	defer fake.removeVersionAnnotationMutex.Unlock()
+	fake.RemoveVersionAnnotationStub = nil
+	if fake.removeVersionAnnotationReturnsOnCall == nil {
+		fake.removeVersionAnnotationReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.removeVersionAnnotationReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) ResourceConfigID() int {
 	fake.resourceConfigIDMutex.Lock()
 	ret, specificReturn := fake.resourceConfigIDReturnsOnCall[len(fake.resourceConfigIDArgsForCall)]
@@ -2483,6 +2906,69 @@ func (fake *FakeResource) TypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) UnpinExpiredVersion(arg1 time.Time) (bool, error) {
+	fake.unpinExpiredVersionMutex.Lock()
+	ret, specificReturn := fake.unpinExpiredVersionReturnsOnCall[len(fake.unpinExpiredVersionArgsForCall)]
+	fake.unpinExpiredVersionArgsForCall = append(fake.unpinExpiredVersionArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("UnpinExpiredVersion", []interface{}{arg1})
+	fake.unpinExpiredVersionMutex.Unlock()
+	if fake.UnpinExpiredVersionStub != nil {
+		return fake.UnpinExpiredVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unpinExpiredVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) UnpinExpiredVersionCallCount() int {
+	fake.unpinExpiredVersionMutex.RLock()
+	defer fake.unpinExpiredVersionMutex.RUnlock()
+	return len(fake.unpinExpiredVersionArgsForCall)
+}
+
+func (fake *FakeResource) UnpinExpiredVersionCalls(stub func(time.Time) (bool, error)) {
+	fake.unpinExpiredVersionMutex.Lock()
+	defer fake.unpinExpiredVersionMutex.Unlock()
+	fake.UnpinExpiredVersionStub = stub
+}
+
+func (fake *FakeResource) UnpinExpiredVersionArgsForCall(i int) time.Time {
+	fake.unpinExpiredVersionMutex.RLock()
+	defer fake.unpinExpiredVersionMutex.RUnlock()
+	argsForCall := fake.unpinExpiredVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) UnpinExpiredVersionReturns(result1 bool, result2 error) {
+	fake.unpinExpiredVersionMutex.Lock()
+	defer fake.unpinExpiredVersionMutex.Unlock()
+	fake.UnpinExpiredVersionStub = nil
+	fake.unpinExpiredVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) UnpinExpiredVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.unpinExpiredVersionMutex.Lock()
+	defer fake.unpinExpiredVersionMutex.Unlock()
+	fake.UnpinExpiredVersionStub = nil
+	if fake.unpinExpiredVersionReturnsOnCall == nil {
+		fake.unpinExpiredVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.unpinExpiredVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) UnpinVersion() error {
 	fake.unpinVersionMutex.Lock()
 	ret, specificReturn := fake.unpinVersionReturnsOnCall[len(fake.unpinVersionArgsForCall)]
@@ -2726,10 +3212,14 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.aPIPinnedVersionMutex.RLock()
 	defer fake.aPIPinnedVersionMutex.RUnlock()
+	fake.annotateVersionMutex.RLock()
+	defer fake.annotateVersionMutex.RUnlock()
 	fake.buildSummaryMutex.RLock()
 	defer fake.buildSummaryMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
 	fake.checkPlanMutex.RLock()
 	defer fake.checkPlanMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
@@ -2758,14 +3248,20 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.lastCheckEndTimeMutex.RUnlock()
 	fake.lastCheckStartTimeMutex.RLock()
 	defer fake.lastCheckStartTimeMutex.RUnlock()
+	fake.lastNewVersionTimeMutex.RLock()
+	defer fake.lastNewVersionTimeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.notifyScanMutex.RLock()
 	defer fake.notifyScanMutex.RUnlock()
 	fake.pinCommentMutex.RLock()
 	defer fake.pinCommentMutex.RUnlock()
+	fake.pinExpiresAtMutex.RLock()
+	defer fake.pinExpiresAtMutex.RUnlock()
 	fake.pinVersionMutex.RLock()
 	defer fake.pinVersionMutex.RUnlock()
+	fake.pinnedByMutex.RLock()
+	defer fake.pinnedByMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -2780,6 +3276,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.removeVersionAnnotationMutex.RLock()
+	defer fake.removeVersionAnnotationMutex.RUnlock()
 	fake.resourceConfigIDMutex.RLock()
 	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.resourceConfigScopeIDMutex.RLock()
@@ -2798,6 +3296,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.typeMutex.RLock()
 	defer fake.typeMutex.RUnlock()
+	fake.unpinExpiredVersionMutex.RLock()
+	defer fake.unpinExpiredVersionMutex.RUnlock()
 	fake.unpinVersionMutex.RLock()
 	defer fake.unpinVersionMutex.RUnlock()
 	fake.updateMetadataMutex.RLock()
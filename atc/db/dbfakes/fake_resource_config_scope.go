@@ -110,9 +110,10 @@ type FakeResourceConfigScope struct {
 	saveVersionsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateLastCheckEndTimeStub        func() (bool, error)
+	UpdateLastCheckEndTimeStub        func(bool) (bool, error)
 	updateLastCheckEndTimeMutex       sync.RWMutex
 	updateLastCheckEndTimeArgsForCall []struct {
+		arg1 bool
 	}
 	updateLastCheckEndTimeReturns struct {
 		result1 bool
@@ -605,15 +606,16 @@ func (fake *FakeResourceConfigScope) SaveVersionsReturnsOnCall(i int, result1 er
 	}{result1}
 }
 
-func (fake *FakeResourceConfigScope) UpdateLastCheckEndTime() (bool, error) {
+func (fake *FakeResourceConfigScope) UpdateLastCheckEndTime(arg1 bool) (bool, error) {
 	fake.updateLastCheckEndTimeMutex.Lock()
 	ret, specificReturn := fake.updateLastCheckEndTimeReturnsOnCall[len(fake.updateLastCheckEndTimeArgsForCall)]
 	fake.updateLastCheckEndTimeArgsForCall = append(fake.updateLastCheckEndTimeArgsForCall, struct {
-	}{})
-	fake.recordInvocation("UpdateLastCheckEndTime", []interface{}{})
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("UpdateLastCheckEndTime", []interface{}{arg1})
 	fake.updateLastCheckEndTimeMutex.Unlock()
 	if fake.UpdateLastCheckEndTimeStub != nil {
-		return fake.UpdateLastCheckEndTimeStub()
+		return fake.UpdateLastCheckEndTimeStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -628,12 +630,19 @@ func (fake *FakeResourceConfigScope) UpdateLastCheckEndTimeCallCount() int {
 	return len(fake.updateLastCheckEndTimeArgsForCall)
 }
 
-func (fake *FakeResourceConfigScope) UpdateLastCheckEndTimeCalls(stub func() (bool, error)) {
+func (fake *FakeResourceConfigScope) UpdateLastCheckEndTimeCalls(stub func(bool) (bool, error)) {
 	fake.updateLastCheckEndTimeMutex.Lock()
 	defer fake.updateLastCheckEndTimeMutex.Unlock()
 	fake.UpdateLastCheckEndTimeStub = stub
 }
 
+func (fake *FakeResourceConfigScope) UpdateLastCheckEndTimeArgsForCall(i int) bool {
+	fake.updateLastCheckEndTimeMutex.RLock()
+	defer fake.updateLastCheckEndTimeMutex.RUnlock()
+	argsForCall := fake.updateLastCheckEndTimeArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeResourceConfigScope) UpdateLastCheckEndTimeReturns(result1 bool, result2 error) {
 	fake.updateLastCheckEndTimeMutex.Lock()
 	defer fake.updateLastCheckEndTimeMutex.Unlock()
@@ -0,0 +1,125 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeResourceConfigVersionLifecycle struct {
+	PruneVersionsStub        func(int, atc.VersionRetention, []atc.Version) (int, error)
+	pruneVersionsMutex       sync.RWMutex
+	pruneVersionsArgsForCall []struct {
+		arg1 int
+		arg2 atc.VersionRetention
+		arg3 []atc.Version
+	}
+	pruneVersionsReturns struct {
+		result1 int
+		result2 error
+	}
+	pruneVersionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) PruneVersions(arg1 int, arg2 atc.VersionRetention, arg3 []atc.Version) (int, error) {
+	var arg3Copy []atc.Version
+	if arg3 != nil {
+		arg3Copy = make([]atc.Version, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.pruneVersionsMutex.Lock()
+	ret, specificReturn := fake.pruneVersionsReturnsOnCall[len(fake.pruneVersionsArgsForCall)]
+	fake.pruneVersionsArgsForCall = append(fake.pruneVersionsArgsForCall, struct {
+		arg1 int
+		arg2 atc.VersionRetention
+		arg3 []atc.Version
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("PruneVersions", []interface{}{arg1, arg2, arg3Copy})
+	fake.pruneVersionsMutex.Unlock()
+	if fake.PruneVersionsStub != nil {
+		return fake.PruneVersionsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pruneVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) PruneVersionsCallCount() int {
+	fake.pruneVersionsMutex.RLock()
+	defer fake.pruneVersionsMutex.RUnlock()
+	return len(fake.pruneVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) PruneVersionsCalls(stub func(int, atc.VersionRetention, []atc.Version) (int, error)) {
+	fake.pruneVersionsMutex.Lock()
+	defer fake.pruneVersionsMutex.Unlock()
+	fake.PruneVersionsStub = stub
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) PruneVersionsArgsForCall(i int) (int, atc.VersionRetention, []atc.Version) {
+	fake.pruneVersionsMutex.RLock()
+	defer fake.pruneVersionsMutex.RUnlock()
+	argsForCall := fake.pruneVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) PruneVersionsReturns(result1 int, result2 error) {
+	fake.pruneVersionsMutex.Lock()
+	defer fake.pruneVersionsMutex.Unlock()
+	fake.PruneVersionsStub = nil
+	fake.pruneVersionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) PruneVersionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.pruneVersionsMutex.Lock()
+	defer fake.pruneVersionsMutex.Unlock()
+	fake.PruneVersionsStub = nil
+	if fake.pruneVersionsReturnsOnCall == nil {
+		fake.pruneVersionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.pruneVersionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pruneVersionsMutex.RLock()
+	defer fake.pruneVersionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ResourceConfigVersionLifecycle = new(FakeResourceConfigVersionLifecycle)
@@ -21,6 +21,16 @@ type FakeResourceType struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 *atc.CheckEvery
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
 	CheckPlanStub        func(atc.Version, time.Duration, db.ResourceTypes, atc.Source) atc.CheckPlan
 	checkPlanMutex       sync.RWMutex
 	checkPlanArgsForCall []struct {
@@ -122,6 +132,16 @@ type FakeResourceType struct {
 	lastCheckStartTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LastNewVersionTimeStub        func() time.Time
+	lastNewVersionTimeMutex       sync.RWMutex
+	lastNewVersionTimeArgsForCall []struct {
+	}
+	lastNewVersionTimeReturns struct {
+		result1 time.Time
+	}
+	lastNewVersionTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -355,6 +375,58 @@ func (fake *FakeResourceType) CheckEveryReturnsOnCall(i int, result1 *atc.CheckE
 	}{result1}
 }
 
+func (fake *FakeResourceType) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if fake.CheckFailuresStub != nil {
+		return fake.CheckFailuresStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkFailuresReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeResourceType) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeResourceType) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResourceType) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeResourceType) CheckPlan(arg1 atc.Version, arg2 time.Duration, arg3 db.ResourceTypes, arg4 atc.Source) atc.CheckPlan {
 	fake.checkPlanMutex.Lock()
 	ret, specificReturn := fake.checkPlanReturnsOnCall[len(fake.checkPlanArgsForCall)]
@@ -850,6 +922,58 @@ func (fake *FakeResourceType) LastCheckStartTimeReturnsOnCall(i int, result1 tim
 	}{result1}
 }
 
+func (fake *FakeResourceType) LastNewVersionTime() time.Time {
+	fake.lastNewVersionTimeMutex.Lock()
+	ret, specificReturn := fake.lastNewVersionTimeReturnsOnCall[len(fake.lastNewVersionTimeArgsForCall)]
+	fake.lastNewVersionTimeArgsForCall = append(fake.lastNewVersionTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastNewVersionTime", []interface{}{})
+	fake.lastNewVersionTimeMutex.Unlock()
+	if fake.LastNewVersionTimeStub != nil {
+		return fake.LastNewVersionTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastNewVersionTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) LastNewVersionTimeCallCount() int {
+	fake.lastNewVersionTimeMutex.RLock()
+	defer fake.lastNewVersionTimeMutex.RUnlock()
+	return len(fake.lastNewVersionTimeArgsForCall)
+}
+
+func (fake *FakeResourceType) LastNewVersionTimeCalls(stub func() time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = stub
+}
+
+func (fake *FakeResourceType) LastNewVersionTimeReturns(result1 time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = nil
+	fake.lastNewVersionTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResourceType) LastNewVersionTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.lastNewVersionTimeMutex.Lock()
+	defer fake.lastNewVersionTimeMutex.Unlock()
+	fake.LastNewVersionTimeStub = nil
+	if fake.lastNewVersionTimeReturnsOnCall == nil {
+		fake.lastNewVersionTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastNewVersionTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeResourceType) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1756,6 +1880,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
 	fake.checkPlanMutex.RLock()
 	defer fake.checkPlanMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
@@ -1774,6 +1900,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.lastCheckEndTimeMutex.RUnlock()
 	fake.lastCheckStartTimeMutex.RLock()
 	defer fake.lastCheckStartTimeMutex.RUnlock()
+	fake.lastNewVersionTimeMutex.RLock()
+	defer fake.lastNewVersionTimeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.paramsMutex.RLock()
@@ -109,6 +109,19 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteWebhookStub        func(string) (bool, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 string
+	}
+	deleteWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
@@ -353,6 +366,18 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	ResourcesStub        func() ([]db.Resource, error)
+	resourcesMutex       sync.RWMutex
+	resourcesArgsForCall []struct {
+	}
+	resourcesReturns struct {
+		result1 []db.Resource
+		result2 error
+	}
+	resourcesReturnsOnCall map[int]struct {
+		result1 []db.Resource
+		result2 error
+	}
 	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
@@ -385,6 +410,21 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SetWebhookStub        func(atc.TeamWebhook) (db.TeamWebhook, bool, error)
+	setWebhookMutex       sync.RWMutex
+	setWebhookArgsForCall []struct {
+		arg1 atc.TeamWebhook
+	}
+	setWebhookReturns struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}
+	setWebhookReturnsOnCall map[int]struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -396,6 +436,33 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WebhookStub        func(string) (db.TeamWebhook, bool, error)
+	webhookMutex       sync.RWMutex
+	webhookArgsForCall []struct {
+		arg1 string
+	}
+	webhookReturns struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}
+	webhookReturnsOnCall map[int]struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}
+	WebhooksStub        func() ([]db.TeamWebhook, error)
+	webhooksMutex       sync.RWMutex
+	webhooksArgsForCall []struct {
+	}
+	webhooksReturns struct {
+		result1 []db.TeamWebhook
+		result2 error
+	}
+	webhooksReturnsOnCall map[int]struct {
+		result1 []db.TeamWebhook
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -873,6 +940,69 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DeleteWebhook(arg1 string) (bool, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteWebhookCalls(stub func(string) (bool, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeTeam) DeleteWebhookArgsForCall(i int) string {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteWebhookReturns(result1 bool, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
@@ -1998,6 +2128,61 @@ func (fake *FakeTeam) RenamePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Resources() ([]db.Resource, error) {
+	fake.resourcesMutex.Lock()
+	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
+	fake.resourcesArgsForCall = append(fake.resourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Resources", []interface{}{})
+	fake.resourcesMutex.Unlock()
+	if fake.ResourcesStub != nil {
+		return fake.ResourcesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resourcesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ResourcesCallCount() int {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	return len(fake.resourcesArgsForCall)
+}
+
+func (fake *FakeTeam) ResourcesCalls(stub func() ([]db.Resource, error)) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = stub
+}
+
+func (fake *FakeTeam) ResourcesReturns(result1 []db.Resource, result2 error) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	fake.resourcesReturns = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ResourcesReturnsOnCall(i int, result1 []db.Resource, result2 error) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	if fake.resourcesReturnsOnCall == nil {
+		fake.resourcesReturnsOnCall = make(map[int]struct {
+			result1 []db.Resource
+			result2 error
+		})
+	}
+	fake.resourcesReturnsOnCall[i] = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
@@ -2131,6 +2316,72 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetWebhook(arg1 atc.TeamWebhook) (db.TeamWebhook, bool, error) {
+	fake.setWebhookMutex.Lock()
+	ret, specificReturn := fake.setWebhookReturnsOnCall[len(fake.setWebhookArgsForCall)]
+	fake.setWebhookArgsForCall = append(fake.setWebhookArgsForCall, struct {
+		arg1 atc.TeamWebhook
+	}{arg1})
+	fake.recordInvocation("SetWebhook", []interface{}{arg1})
+	fake.setWebhookMutex.Unlock()
+	if fake.SetWebhookStub != nil {
+		return fake.SetWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.setWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SetWebhookCallCount() int {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	return len(fake.setWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) SetWebhookCalls(stub func(atc.TeamWebhook) (db.TeamWebhook, bool, error)) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = stub
+}
+
+func (fake *FakeTeam) SetWebhookArgsForCall(i int) atc.TeamWebhook {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	argsForCall := fake.setWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetWebhookReturns(result1 db.TeamWebhook, result2 bool, result3 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	fake.setWebhookReturns = struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SetWebhookReturnsOnCall(i int, result1 db.TeamWebhook, result2 bool, result3 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	if fake.setWebhookReturnsOnCall == nil {
+		fake.setWebhookReturnsOnCall = make(map[int]struct {
+			result1 db.TeamWebhook
+			result2 bool
+			result3 error
+		})
+	}
+	fake.setWebhookReturnsOnCall[i] = struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2191,6 +2442,127 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) Webhook(arg1 string) (db.TeamWebhook, bool, error) {
+	fake.webhookMutex.Lock()
+	ret, specificReturn := fake.webhookReturnsOnCall[len(fake.webhookArgsForCall)]
+	fake.webhookArgsForCall = append(fake.webhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Webhook", []interface{}{arg1})
+	fake.webhookMutex.Unlock()
+	if fake.WebhookStub != nil {
+		return fake.WebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.webhookReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) WebhookCallCount() int {
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	return len(fake.webhookArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookCalls(stub func(string) (db.TeamWebhook, bool, error)) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = stub
+}
+
+func (fake *FakeTeam) WebhookArgsForCall(i int) string {
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	argsForCall := fake.webhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) WebhookReturns(result1 db.TeamWebhook, result2 bool, result3 error) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	fake.webhookReturns = struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) WebhookReturnsOnCall(i int, result1 db.TeamWebhook, result2 bool, result3 error) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	if fake.webhookReturnsOnCall == nil {
+		fake.webhookReturnsOnCall = make(map[int]struct {
+			result1 db.TeamWebhook
+			result2 bool
+			result3 error
+		})
+	}
+	fake.webhookReturnsOnCall[i] = struct {
+		result1 db.TeamWebhook
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) Webhooks() ([]db.TeamWebhook, error) {
+	fake.webhooksMutex.Lock()
+	ret, specificReturn := fake.webhooksReturnsOnCall[len(fake.webhooksArgsForCall)]
+	fake.webhooksArgsForCall = append(fake.webhooksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Webhooks", []interface{}{})
+	fake.webhooksMutex.Unlock()
+	if fake.WebhooksStub != nil {
+		return fake.WebhooksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhooksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WebhooksCallCount() int {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return len(fake.webhooksArgsForCall)
+}
+
+func (fake *FakeTeam) WebhooksCalls(stub func() ([]db.TeamWebhook, error)) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = stub
+}
+
+func (fake *FakeTeam) WebhooksReturns(result1 []db.TeamWebhook, result2 error) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = nil
+	fake.webhooksReturns = struct {
+		result1 []db.TeamWebhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WebhooksReturnsOnCall(i int, result1 []db.TeamWebhook, result2 error) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = nil
+	if fake.webhooksReturnsOnCall == nil {
+		fake.webhooksReturnsOnCall = make(map[int]struct {
+			result1 []db.TeamWebhook
+			result2 error
+		})
+	}
+	fake.webhooksReturnsOnCall[i] = struct {
+		result1 []db.TeamWebhook
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2265,6 +2637,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createStartedBuildMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2301,12 +2675,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.renameMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
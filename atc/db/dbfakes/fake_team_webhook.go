@@ -0,0 +1,445 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeTeamWebhook struct {
+	ConfigStub        func() atc.TeamWebhook
+	configMutex       sync.RWMutex
+	configArgsForCall []struct {
+	}
+	configReturns struct {
+		result1 atc.TeamWebhook
+	}
+	configReturnsOnCall map[int]struct {
+		result1 atc.TeamWebhook
+	}
+	DeliveriesStub        func(int) ([]atc.WebhookDelivery, error)
+	deliveriesMutex       sync.RWMutex
+	deliveriesArgsForCall []struct {
+		arg1 int
+	}
+	deliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	deliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	IDStub        func() int
+	iDMutex       sync.RWMutex
+	iDArgsForCall []struct {
+	}
+	iDReturns struct {
+		result1 int
+	}
+	iDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	NameStub        func() string
+	nameMutex       sync.RWMutex
+	nameArgsForCall []struct {
+	}
+	nameReturns struct {
+		result1 string
+	}
+	nameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SaveDeliveryStub        func(atc.WebhookDelivery) error
+	saveDeliveryMutex       sync.RWMutex
+	saveDeliveryArgsForCall []struct {
+		arg1 atc.WebhookDelivery
+	}
+	saveDeliveryReturns struct {
+		result1 error
+	}
+	saveDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TeamIDStub        func() int
+	teamIDMutex       sync.RWMutex
+	teamIDArgsForCall []struct {
+	}
+	teamIDReturns struct {
+		result1 int
+	}
+	teamIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTeamWebhook) Config() atc.TeamWebhook {
+	fake.configMutex.Lock()
+	ret, specificReturn := fake.configReturnsOnCall[len(fake.configArgsForCall)]
+	fake.configArgsForCall = append(fake.configArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Config", []interface{}{})
+	fake.configMutex.Unlock()
+	if fake.ConfigStub != nil {
+		return fake.ConfigStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.configReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeamWebhook) ConfigCallCount() int {
+	fake.configMutex.RLock()
+	defer fake.configMutex.RUnlock()
+	return len(fake.configArgsForCall)
+}
+
+func (fake *FakeTeamWebhook) ConfigCalls(stub func() atc.TeamWebhook) {
+	fake.configMutex.Lock()
+	defer fake.configMutex.Unlock()
+	fake.ConfigStub = stub
+}
+
+func (fake *FakeTeamWebhook) ConfigReturns(result1 atc.TeamWebhook) {
+	fake.configMutex.Lock()
+	defer fake.configMutex.Unlock()
+	fake.ConfigStub = nil
+	fake.configReturns = struct {
+		result1 atc.TeamWebhook
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) ConfigReturnsOnCall(i int, result1 atc.TeamWebhook) {
+	fake.configMutex.Lock()
+	defer fake.configMutex.Unlock()
+	fake.ConfigStub = nil
+	if fake.configReturnsOnCall == nil {
+		fake.configReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamWebhook
+		})
+	}
+	fake.configReturnsOnCall[i] = struct {
+		result1 atc.TeamWebhook
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) Deliveries(arg1 int) ([]atc.WebhookDelivery, error) {
+	fake.deliveriesMutex.Lock()
+	ret, specificReturn := fake.deliveriesReturnsOnCall[len(fake.deliveriesArgsForCall)]
+	fake.deliveriesArgsForCall = append(fake.deliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Deliveries", []interface{}{arg1})
+	fake.deliveriesMutex.Unlock()
+	if fake.DeliveriesStub != nil {
+		return fake.DeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamWebhook) DeliveriesCallCount() int {
+	fake.deliveriesMutex.RLock()
+	defer fake.deliveriesMutex.RUnlock()
+	return len(fake.deliveriesArgsForCall)
+}
+
+func (fake *FakeTeamWebhook) DeliveriesCalls(stub func(int) ([]atc.WebhookDelivery, error)) {
+	fake.deliveriesMutex.Lock()
+	defer fake.deliveriesMutex.Unlock()
+	fake.DeliveriesStub = stub
+}
+
+func (fake *FakeTeamWebhook) DeliveriesArgsForCall(i int) int {
+	fake.deliveriesMutex.RLock()
+	defer fake.deliveriesMutex.RUnlock()
+	argsForCall := fake.deliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamWebhook) DeliveriesReturns(result1 []atc.WebhookDelivery, result2 error) {
+	fake.deliveriesMutex.Lock()
+	defer fake.deliveriesMutex.Unlock()
+	fake.DeliveriesStub = nil
+	fake.deliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamWebhook) DeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 error) {
+	fake.deliveriesMutex.Lock()
+	defer fake.deliveriesMutex.Unlock()
+	fake.DeliveriesStub = nil
+	if fake.deliveriesReturnsOnCall == nil {
+		fake.deliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.deliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamWebhook) ID() int {
+	fake.iDMutex.Lock()
+	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
+	fake.iDArgsForCall = append(fake.iDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ID", []interface{}{})
+	fake.iDMutex.Unlock()
+	if fake.IDStub != nil {
+		return fake.IDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.iDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeamWebhook) IDCallCount() int {
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	return len(fake.iDArgsForCall)
+}
+
+func (fake *FakeTeamWebhook) IDCalls(stub func() int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = stub
+}
+
+func (fake *FakeTeamWebhook) IDReturns(result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	fake.iDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) IDReturnsOnCall(i int, result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	if fake.iDReturnsOnCall == nil {
+		fake.iDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.iDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) Name() string {
+	fake.nameMutex.Lock()
+	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
+	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Name", []interface{}{})
+	fake.nameMutex.Unlock()
+	if fake.NameStub != nil {
+		return fake.NameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.nameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeamWebhook) NameCallCount() int {
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	return len(fake.nameArgsForCall)
+}
+
+func (fake *FakeTeamWebhook) NameCalls(stub func() string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = stub
+}
+
+func (fake *FakeTeamWebhook) NameReturns(result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	fake.nameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) NameReturnsOnCall(i int, result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	if fake.nameReturnsOnCall == nil {
+		fake.nameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.nameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) SaveDelivery(arg1 atc.WebhookDelivery) error {
+	fake.saveDeliveryMutex.Lock()
+	ret, specificReturn := fake.saveDeliveryReturnsOnCall[len(fake.saveDeliveryArgsForCall)]
+	fake.saveDeliveryArgsForCall = append(fake.saveDeliveryArgsForCall, struct {
+		arg1 atc.WebhookDelivery
+	}{arg1})
+	fake.recordInvocation("SaveDelivery", []interface{}{arg1})
+	fake.saveDeliveryMutex.Unlock()
+	if fake.SaveDeliveryStub != nil {
+		return fake.SaveDeliveryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveDeliveryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeamWebhook) SaveDeliveryCallCount() int {
+	fake.saveDeliveryMutex.RLock()
+	defer fake.saveDeliveryMutex.RUnlock()
+	return len(fake.saveDeliveryArgsForCall)
+}
+
+func (fake *FakeTeamWebhook) SaveDeliveryCalls(stub func(atc.WebhookDelivery) error) {
+	fake.saveDeliveryMutex.Lock()
+	defer fake.saveDeliveryMutex.Unlock()
+	fake.SaveDeliveryStub = stub
+}
+
+func (fake *FakeTeamWebhook) SaveDeliveryArgsForCall(i int) atc.WebhookDelivery {
+	fake.saveDeliveryMutex.RLock()
+	defer fake.saveDeliveryMutex.RUnlock()
+	argsForCall := fake.saveDeliveryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamWebhook) SaveDeliveryReturns(result1 error) {
+	fake.saveDeliveryMutex.Lock()
+	defer fake.saveDeliveryMutex.Unlock()
+	fake.SaveDeliveryStub = nil
+	fake.saveDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) SaveDeliveryReturnsOnCall(i int, result1 error) {
+	fake.saveDeliveryMutex.Lock()
+	defer fake.saveDeliveryMutex.Unlock()
+	fake.SaveDeliveryStub = nil
+	if fake.saveDeliveryReturnsOnCall == nil {
+		fake.saveDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) TeamID() int {
+	fake.teamIDMutex.Lock()
+	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
+	fake.teamIDArgsForCall = append(fake.teamIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TeamID", []interface{}{})
+	fake.teamIDMutex.Unlock()
+	if fake.TeamIDStub != nil {
+		return fake.TeamIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.teamIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeamWebhook) TeamIDCallCount() int {
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	return len(fake.teamIDArgsForCall)
+}
+
+func (fake *FakeTeamWebhook) TeamIDCalls(stub func() int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = stub
+}
+
+func (fake *FakeTeamWebhook) TeamIDReturns(result1 int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = nil
+	fake.teamIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) TeamIDReturnsOnCall(i int, result1 int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = nil
+	if fake.teamIDReturnsOnCall == nil {
+		fake.teamIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.teamIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeamWebhook) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.configMutex.RLock()
+	defer fake.configMutex.RUnlock()
+	fake.deliveriesMutex.RLock()
+	defer fake.deliveriesMutex.RUnlock()
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	fake.saveDeliveryMutex.RLock()
+	defer fake.saveDeliveryMutex.RUnlock()
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTeamWebhook) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.TeamWebhook = new(FakeTeamWebhook)
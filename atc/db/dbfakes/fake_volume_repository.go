@@ -155,6 +155,22 @@ type FakeVolumeRepository struct {
 		result2 bool
 		result3 error
 	}
+	FindCreatingOrCreatedResourceCacheVolumeStub        func(string, db.UsedResourceCache) (db.CreatingVolume, db.CreatedVolume, error)
+	findCreatingOrCreatedResourceCacheVolumeMutex       sync.RWMutex
+	findCreatingOrCreatedResourceCacheVolumeArgsForCall []struct {
+		arg1 string
+		arg2 db.UsedResourceCache
+	}
+	findCreatingOrCreatedResourceCacheVolumeReturns struct {
+		result1 db.CreatingVolume
+		result2 db.CreatedVolume
+		result3 error
+	}
+	findCreatingOrCreatedResourceCacheVolumeReturnsOnCall map[int]struct {
+		result1 db.CreatingVolume
+		result2 db.CreatedVolume
+		result3 error
+	}
 	FindResourceCacheVolumeStub        func(string, db.UsedResourceCache) (db.CreatedVolume, bool, error)
 	findResourceCacheVolumeMutex       sync.RWMutex
 	findResourceCacheVolumeArgsForCall []struct {
@@ -171,6 +187,22 @@ type FakeVolumeRepository struct {
 		result2 bool
 		result3 error
 	}
+	FindResourceCacheVolumeByDigestStub        func(string, db.UsedResourceCache) (db.CreatedVolume, bool, error)
+	findResourceCacheVolumeByDigestMutex       sync.RWMutex
+	findResourceCacheVolumeByDigestArgsForCall []struct {
+		arg1 string
+		arg2 db.UsedResourceCache
+	}
+	findResourceCacheVolumeByDigestReturns struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}
+	findResourceCacheVolumeByDigestReturnsOnCall map[int]struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}
 	FindResourceCertsVolumeStub        func(string, *db.UsedWorkerResourceCerts) (db.CreatingVolume, db.CreatedVolume, error)
 	findResourceCertsVolumeMutex       sync.RWMutex
 	findResourceCertsVolumeArgsForCall []struct {
@@ -945,6 +977,73 @@ func (fake *FakeVolumeRepository) FindCreatedVolumeReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeRepository) FindCreatingOrCreatedResourceCacheVolume(arg1 string, arg2 db.UsedResourceCache) (db.CreatingVolume, db.CreatedVolume, error) {
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.Lock()
+	ret, specificReturn := fake.findCreatingOrCreatedResourceCacheVolumeReturnsOnCall[len(fake.findCreatingOrCreatedResourceCacheVolumeArgsForCall)]
+	fake.findCreatingOrCreatedResourceCacheVolumeArgsForCall = append(fake.findCreatingOrCreatedResourceCacheVolumeArgsForCall, struct {
+		arg1 string
+		arg2 db.UsedResourceCache
+	}{arg1, arg2})
+	fake.recordInvocation("FindCreatingOrCreatedResourceCacheVolume", []interface{}{arg1, arg2})
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.Unlock()
+	if fake.FindCreatingOrCreatedResourceCacheVolumeStub != nil {
+		return fake.FindCreatingOrCreatedResourceCacheVolumeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findCreatingOrCreatedResourceCacheVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVolumeRepository) FindCreatingOrCreatedResourceCacheVolumeCallCount() int {
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.RLock()
+	defer fake.findCreatingOrCreatedResourceCacheVolumeMutex.RUnlock()
+	return len(fake.findCreatingOrCreatedResourceCacheVolumeArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) FindCreatingOrCreatedResourceCacheVolumeCalls(stub func(string, db.UsedResourceCache) (db.CreatingVolume, db.CreatedVolume, error)) {
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.Lock()
+	defer fake.findCreatingOrCreatedResourceCacheVolumeMutex.Unlock()
+	fake.FindCreatingOrCreatedResourceCacheVolumeStub = stub
+}
+
+func (fake *FakeVolumeRepository) FindCreatingOrCreatedResourceCacheVolumeArgsForCall(i int) (string, db.UsedResourceCache) {
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.RLock()
+	defer fake.findCreatingOrCreatedResourceCacheVolumeMutex.RUnlock()
+	argsForCall := fake.findCreatingOrCreatedResourceCacheVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) FindCreatingOrCreatedResourceCacheVolumeReturns(result1 db.CreatingVolume, result2 db.CreatedVolume, result3 error) {
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.Lock()
+	defer fake.findCreatingOrCreatedResourceCacheVolumeMutex.Unlock()
+	fake.FindCreatingOrCreatedResourceCacheVolumeStub = nil
+	fake.findCreatingOrCreatedResourceCacheVolumeReturns = struct {
+		result1 db.CreatingVolume
+		result2 db.CreatedVolume
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVolumeRepository) FindCreatingOrCreatedResourceCacheVolumeReturnsOnCall(i int, result1 db.CreatingVolume, result2 db.CreatedVolume, result3 error) {
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.Lock()
+	defer fake.findCreatingOrCreatedResourceCacheVolumeMutex.Unlock()
+	fake.FindCreatingOrCreatedResourceCacheVolumeStub = nil
+	if fake.findCreatingOrCreatedResourceCacheVolumeReturnsOnCall == nil {
+		fake.findCreatingOrCreatedResourceCacheVolumeReturnsOnCall = make(map[int]struct {
+			result1 db.CreatingVolume
+			result2 db.CreatedVolume
+			result3 error
+		})
+	}
+	fake.findCreatingOrCreatedResourceCacheVolumeReturnsOnCall[i] = struct {
+		result1 db.CreatingVolume
+		result2 db.CreatedVolume
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVolumeRepository) FindResourceCacheVolume(arg1 string, arg2 db.UsedResourceCache) (db.CreatedVolume, bool, error) {
 	fake.findResourceCacheVolumeMutex.Lock()
 	ret, specificReturn := fake.findResourceCacheVolumeReturnsOnCall[len(fake.findResourceCacheVolumeArgsForCall)]
@@ -1012,6 +1111,73 @@ func (fake *FakeVolumeRepository) FindResourceCacheVolumeReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeRepository) FindResourceCacheVolumeByDigest(arg1 string, arg2 db.UsedResourceCache) (db.CreatedVolume, bool, error) {
+	fake.findResourceCacheVolumeByDigestMutex.Lock()
+	ret, specificReturn := fake.findResourceCacheVolumeByDigestReturnsOnCall[len(fake.findResourceCacheVolumeByDigestArgsForCall)]
+	fake.findResourceCacheVolumeByDigestArgsForCall = append(fake.findResourceCacheVolumeByDigestArgsForCall, struct {
+		arg1 string
+		arg2 db.UsedResourceCache
+	}{arg1, arg2})
+	fake.recordInvocation("FindResourceCacheVolumeByDigest", []interface{}{arg1, arg2})
+	fake.findResourceCacheVolumeByDigestMutex.Unlock()
+	if fake.FindResourceCacheVolumeByDigestStub != nil {
+		return fake.FindResourceCacheVolumeByDigestStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findResourceCacheVolumeByDigestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVolumeRepository) FindResourceCacheVolumeByDigestCallCount() int {
+	fake.findResourceCacheVolumeByDigestMutex.RLock()
+	defer fake.findResourceCacheVolumeByDigestMutex.RUnlock()
+	return len(fake.findResourceCacheVolumeByDigestArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) FindResourceCacheVolumeByDigestCalls(stub func(string, db.UsedResourceCache) (db.CreatedVolume, bool, error)) {
+	fake.findResourceCacheVolumeByDigestMutex.Lock()
+	defer fake.findResourceCacheVolumeByDigestMutex.Unlock()
+	fake.FindResourceCacheVolumeByDigestStub = stub
+}
+
+func (fake *FakeVolumeRepository) FindResourceCacheVolumeByDigestArgsForCall(i int) (string, db.UsedResourceCache) {
+	fake.findResourceCacheVolumeByDigestMutex.RLock()
+	defer fake.findResourceCacheVolumeByDigestMutex.RUnlock()
+	argsForCall := fake.findResourceCacheVolumeByDigestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) FindResourceCacheVolumeByDigestReturns(result1 db.CreatedVolume, result2 bool, result3 error) {
+	fake.findResourceCacheVolumeByDigestMutex.Lock()
+	defer fake.findResourceCacheVolumeByDigestMutex.Unlock()
+	fake.FindResourceCacheVolumeByDigestStub = nil
+	fake.findResourceCacheVolumeByDigestReturns = struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVolumeRepository) FindResourceCacheVolumeByDigestReturnsOnCall(i int, result1 db.CreatedVolume, result2 bool, result3 error) {
+	fake.findResourceCacheVolumeByDigestMutex.Lock()
+	defer fake.findResourceCacheVolumeByDigestMutex.Unlock()
+	fake.FindResourceCacheVolumeByDigestStub = nil
+	if fake.findResourceCacheVolumeByDigestReturnsOnCall == nil {
+		fake.findResourceCacheVolumeByDigestReturnsOnCall = make(map[int]struct {
+			result1 db.CreatedVolume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findResourceCacheVolumeByDigestReturnsOnCall[i] = struct {
+		result1 db.CreatedVolume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVolumeRepository) FindResourceCertsVolume(arg1 string, arg2 *db.UsedWorkerResourceCerts) (db.CreatingVolume, db.CreatedVolume, error) {
 	fake.findResourceCertsVolumeMutex.Lock()
 	ret, specificReturn := fake.findResourceCertsVolumeReturnsOnCall[len(fake.findResourceCertsVolumeArgsForCall)]
@@ -1612,8 +1778,12 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.findContainerVolumeMutex.RUnlock()
 	fake.findCreatedVolumeMutex.RLock()
 	defer fake.findCreatedVolumeMutex.RUnlock()
+	fake.findCreatingOrCreatedResourceCacheVolumeMutex.RLock()
+	defer fake.findCreatingOrCreatedResourceCacheVolumeMutex.RUnlock()
 	fake.findResourceCacheVolumeMutex.RLock()
 	defer fake.findResourceCacheVolumeMutex.RUnlock()
+	fake.findResourceCacheVolumeByDigestMutex.RLock()
+	defer fake.findResourceCacheVolumeByDigestMutex.RUnlock()
 	fake.findResourceCertsVolumeMutex.RLock()
 	defer fake.findResourceCertsVolumeMutex.RUnlock()
 	fake.findTaskCacheVolumeMutex.RLock()
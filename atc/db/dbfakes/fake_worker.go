@@ -76,6 +76,20 @@ type FakeWorker struct {
 		result1 db.CreatingContainer
 		result2 error
 	}
+	CurrentStateStub        func() (db.WorkerState, bool, error)
+	currentStateMutex       sync.RWMutex
+	currentStateArgsForCall []struct {
+	}
+	currentStateReturns struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}
+	currentStateReturnsOnCall map[int]struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}
 	DecreaseActiveTasksStub        func() error
 	decreaseActiveTasksMutex       sync.RWMutex
 	decreaseActiveTasksArgsForCall []struct {
@@ -96,6 +110,30 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiagnosticsStub        func() (atc.WorkerDiagnostics, bool, error)
+	diagnosticsMutex       sync.RWMutex
+	diagnosticsArgsForCall []struct {
+	}
+	diagnosticsReturns struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}
+	diagnosticsReturnsOnCall map[int]struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}
+	DiagnosticsRequestedStub        func() bool
+	diagnosticsRequestedMutex       sync.RWMutex
+	diagnosticsRequestedArgsForCall []struct {
+	}
+	diagnosticsRequestedReturns struct {
+		result1 bool
+	}
+	diagnosticsRequestedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -233,6 +271,16 @@ type FakeWorker struct {
 		result1 bool
 		result2 error
 	}
+	RequestDiagnosticsStub        func() error
+	requestDiagnosticsMutex       sync.RWMutex
+	requestDiagnosticsArgsForCall []struct {
+	}
+	requestDiagnosticsReturns struct {
+		result1 error
+	}
+	requestDiagnosticsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResourceCertsStub        func() (*db.UsedWorkerResourceCerts, bool, error)
 	resourceCertsMutex       sync.RWMutex
 	resourceCertsArgsForCall []struct {
@@ -247,6 +295,16 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	ResourcePressureStub        func() *atc.WorkerResourcePressure
+	resourcePressureMutex       sync.RWMutex
+	resourcePressureArgsForCall []struct {
+	}
+	resourcePressureReturns struct {
+		result1 *atc.WorkerResourcePressure
+	}
+	resourcePressureReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResourcePressure
+	}
 	ResourceTypesStub        func() []atc.WorkerResourceType
 	resourceTypesMutex       sync.RWMutex
 	resourceTypesArgsForCall []struct {
@@ -267,6 +325,27 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveDiagnosticsStub        func(atc.WorkerDiagnostics) error
+	saveDiagnosticsMutex       sync.RWMutex
+	saveDiagnosticsArgsForCall []struct {
+		arg1 atc.WorkerDiagnostics
+	}
+	saveDiagnosticsReturns struct {
+		result1 error
+	}
+	saveDiagnosticsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StalledReasonStub        func() string
+	stalledReasonMutex       sync.RWMutex
+	stalledReasonArgsForCall []struct {
+	}
+	stalledReasonReturns struct {
+		result1 string
+	}
+	stalledReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	StartTimeStub        func() time.Time
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -658,6 +737,64 @@ func (fake *FakeWorker) CreateContainerReturnsOnCall(i int, result1 db.CreatingC
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) CurrentState() (db.WorkerState, bool, error) {
+	fake.currentStateMutex.Lock()
+	ret, specificReturn := fake.currentStateReturnsOnCall[len(fake.currentStateArgsForCall)]
+	fake.currentStateArgsForCall = append(fake.currentStateArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentState", []interface{}{})
+	fake.currentStateMutex.Unlock()
+	if fake.CurrentStateStub != nil {
+		return fake.CurrentStateStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.currentStateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) CurrentStateCallCount() int {
+	fake.currentStateMutex.RLock()
+	defer fake.currentStateMutex.RUnlock()
+	return len(fake.currentStateArgsForCall)
+}
+
+func (fake *FakeWorker) CurrentStateCalls(stub func() (db.WorkerState, bool, error)) {
+	fake.currentStateMutex.Lock()
+	defer fake.currentStateMutex.Unlock()
+	fake.CurrentStateStub = stub
+}
+
+func (fake *FakeWorker) CurrentStateReturns(result1 db.WorkerState, result2 bool, result3 error) {
+	fake.currentStateMutex.Lock()
+	defer fake.currentStateMutex.Unlock()
+	fake.CurrentStateStub = nil
+	fake.currentStateReturns = struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) CurrentStateReturnsOnCall(i int, result1 db.WorkerState, result2 bool, result3 error) {
+	fake.currentStateMutex.Lock()
+	defer fake.currentStateMutex.Unlock()
+	fake.CurrentStateStub = nil
+	if fake.currentStateReturnsOnCall == nil {
+		fake.currentStateReturnsOnCall = make(map[int]struct {
+			result1 db.WorkerState
+			result2 bool
+			result3 error
+		})
+	}
+	fake.currentStateReturnsOnCall[i] = struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) DecreaseActiveTasks() error {
 	fake.decreaseActiveTasksMutex.Lock()
 	ret, specificReturn := fake.decreaseActiveTasksReturnsOnCall[len(fake.decreaseActiveTasksArgsForCall)]
@@ -762,6 +899,116 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Diagnostics() (atc.WorkerDiagnostics, bool, error) {
+	fake.diagnosticsMutex.Lock()
+	ret, specificReturn := fake.diagnosticsReturnsOnCall[len(fake.diagnosticsArgsForCall)]
+	fake.diagnosticsArgsForCall = append(fake.diagnosticsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Diagnostics", []interface{}{})
+	fake.diagnosticsMutex.Unlock()
+	if fake.DiagnosticsStub != nil {
+		return fake.DiagnosticsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.diagnosticsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) DiagnosticsCallCount() int {
+	fake.diagnosticsMutex.RLock()
+	defer fake.diagnosticsMutex.RUnlock()
+	return len(fake.diagnosticsArgsForCall)
+}
+
+func (fake *FakeWorker) DiagnosticsCalls(stub func() (atc.WorkerDiagnostics, bool, error)) {
+	fake.diagnosticsMutex.Lock()
+	defer fake.diagnosticsMutex.Unlock()
+	fake.DiagnosticsStub = stub
+}
+
+func (fake *FakeWorker) DiagnosticsReturns(result1 atc.WorkerDiagnostics, result2 bool, result3 error) {
+	fake.diagnosticsMutex.Lock()
+	defer fake.diagnosticsMutex.Unlock()
+	fake.DiagnosticsStub = nil
+	fake.diagnosticsReturns = struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) DiagnosticsReturnsOnCall(i int, result1 atc.WorkerDiagnostics, result2 bool, result3 error) {
+	fake.diagnosticsMutex.Lock()
+	defer fake.diagnosticsMutex.Unlock()
+	fake.DiagnosticsStub = nil
+	if fake.diagnosticsReturnsOnCall == nil {
+		fake.diagnosticsReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerDiagnostics
+			result2 bool
+			result3 error
+		})
+	}
+	fake.diagnosticsReturnsOnCall[i] = struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) DiagnosticsRequested() bool {
+	fake.diagnosticsRequestedMutex.Lock()
+	ret, specificReturn := fake.diagnosticsRequestedReturnsOnCall[len(fake.diagnosticsRequestedArgsForCall)]
+	fake.diagnosticsRequestedArgsForCall = append(fake.diagnosticsRequestedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DiagnosticsRequested", []interface{}{})
+	fake.diagnosticsRequestedMutex.Unlock()
+	if fake.DiagnosticsRequestedStub != nil {
+		return fake.DiagnosticsRequestedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.diagnosticsRequestedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DiagnosticsRequestedCallCount() int {
+	fake.diagnosticsRequestedMutex.RLock()
+	defer fake.diagnosticsRequestedMutex.RUnlock()
+	return len(fake.diagnosticsRequestedArgsForCall)
+}
+
+func (fake *FakeWorker) DiagnosticsRequestedCalls(stub func() bool) {
+	fake.diagnosticsRequestedMutex.Lock()
+	defer fake.diagnosticsRequestedMutex.Unlock()
+	fake.DiagnosticsRequestedStub = stub
+}
+
+func (fake *FakeWorker) DiagnosticsRequestedReturns(result1 bool) {
+	fake.diagnosticsRequestedMutex.Lock()
+	defer fake.diagnosticsRequestedMutex.Unlock()
+	fake.DiagnosticsRequestedStub = nil
+	fake.diagnosticsRequestedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) DiagnosticsRequestedReturnsOnCall(i int, result1 bool) {
+	fake.diagnosticsRequestedMutex.Lock()
+	defer fake.diagnosticsRequestedMutex.Unlock()
+	fake.DiagnosticsRequestedStub = nil
+	if fake.diagnosticsRequestedReturnsOnCall == nil {
+		fake.diagnosticsRequestedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.diagnosticsRequestedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
@@ -1455,6 +1702,58 @@ func (fake *FakeWorker) ReloadReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) RequestDiagnostics() error {
+	fake.requestDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.requestDiagnosticsReturnsOnCall[len(fake.requestDiagnosticsArgsForCall)]
+	fake.requestDiagnosticsArgsForCall = append(fake.requestDiagnosticsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestDiagnostics", []interface{}{})
+	fake.requestDiagnosticsMutex.Unlock()
+	if fake.RequestDiagnosticsStub != nil {
+		return fake.RequestDiagnosticsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestDiagnosticsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) RequestDiagnosticsCallCount() int {
+	fake.requestDiagnosticsMutex.RLock()
+	defer fake.requestDiagnosticsMutex.RUnlock()
+	return len(fake.requestDiagnosticsArgsForCall)
+}
+
+func (fake *FakeWorker) RequestDiagnosticsCalls(stub func() error) {
+	fake.requestDiagnosticsMutex.Lock()
+	defer fake.requestDiagnosticsMutex.Unlock()
+	fake.RequestDiagnosticsStub = stub
+}
+
+func (fake *FakeWorker) RequestDiagnosticsReturns(result1 error) {
+	fake.requestDiagnosticsMutex.Lock()
+	defer fake.requestDiagnosticsMutex.Unlock()
+	fake.RequestDiagnosticsStub = nil
+	fake.requestDiagnosticsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) RequestDiagnosticsReturnsOnCall(i int, result1 error) {
+	fake.requestDiagnosticsMutex.Lock()
+	defer fake.requestDiagnosticsMutex.Unlock()
+	fake.RequestDiagnosticsStub = nil
+	if fake.requestDiagnosticsReturnsOnCall == nil {
+		fake.requestDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestDiagnosticsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) ResourceCerts() (*db.UsedWorkerResourceCerts, bool, error) {
 	fake.resourceCertsMutex.Lock()
 	ret, specificReturn := fake.resourceCertsReturnsOnCall[len(fake.resourceCertsArgsForCall)]
@@ -1513,6 +1812,58 @@ func (fake *FakeWorker) ResourceCertsReturnsOnCall(i int, result1 *db.UsedWorker
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) ResourcePressure() *atc.WorkerResourcePressure {
+	fake.resourcePressureMutex.Lock()
+	ret, specificReturn := fake.resourcePressureReturnsOnCall[len(fake.resourcePressureArgsForCall)]
+	fake.resourcePressureArgsForCall = append(fake.resourcePressureArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourcePressure", []interface{}{})
+	fake.resourcePressureMutex.Unlock()
+	if fake.ResourcePressureStub != nil {
+		return fake.ResourcePressureStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourcePressureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourcePressureCallCount() int {
+	fake.resourcePressureMutex.RLock()
+	defer fake.resourcePressureMutex.RUnlock()
+	return len(fake.resourcePressureArgsForCall)
+}
+
+func (fake *FakeWorker) ResourcePressureCalls(stub func() *atc.WorkerResourcePressure) {
+	fake.resourcePressureMutex.Lock()
+	defer fake.resourcePressureMutex.Unlock()
+	fake.ResourcePressureStub = stub
+}
+
+func (fake *FakeWorker) ResourcePressureReturns(result1 *atc.WorkerResourcePressure) {
+	fake.resourcePressureMutex.Lock()
+	defer fake.resourcePressureMutex.Unlock()
+	fake.ResourcePressureStub = nil
+	fake.resourcePressureReturns = struct {
+		result1 *atc.WorkerResourcePressure
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourcePressureReturnsOnCall(i int, result1 *atc.WorkerResourcePressure) {
+	fake.resourcePressureMutex.Lock()
+	defer fake.resourcePressureMutex.Unlock()
+	fake.ResourcePressureStub = nil
+	if fake.resourcePressureReturnsOnCall == nil {
+		fake.resourcePressureReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResourcePressure
+		})
+	}
+	fake.resourcePressureReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResourcePressure
+	}{result1}
+}
+
 func (fake *FakeWorker) ResourceTypes() []atc.WorkerResourceType {
 	fake.resourceTypesMutex.Lock()
 	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
@@ -1617,6 +1968,118 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) SaveDiagnostics(arg1 atc.WorkerDiagnostics) error {
+	fake.saveDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.saveDiagnosticsReturnsOnCall[len(fake.saveDiagnosticsArgsForCall)]
+	fake.saveDiagnosticsArgsForCall = append(fake.saveDiagnosticsArgsForCall, struct {
+		arg1 atc.WorkerDiagnostics
+	}{arg1})
+	fake.recordInvocation("SaveDiagnostics", []interface{}{arg1})
+	fake.saveDiagnosticsMutex.Unlock()
+	if fake.SaveDiagnosticsStub != nil {
+		return fake.SaveDiagnosticsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveDiagnosticsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) SaveDiagnosticsCallCount() int {
+	fake.saveDiagnosticsMutex.RLock()
+	defer fake.saveDiagnosticsMutex.RUnlock()
+	return len(fake.saveDiagnosticsArgsForCall)
+}
+
+func (fake *FakeWorker) SaveDiagnosticsCalls(stub func(atc.WorkerDiagnostics) error) {
+	fake.saveDiagnosticsMutex.Lock()
+	defer fake.saveDiagnosticsMutex.Unlock()
+	fake.SaveDiagnosticsStub = stub
+}
+
+func (fake *FakeWorker) SaveDiagnosticsArgsForCall(i int) atc.WorkerDiagnostics {
+	fake.saveDiagnosticsMutex.RLock()
+	defer fake.saveDiagnosticsMutex.RUnlock()
+	argsForCall := fake.saveDiagnosticsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) SaveDiagnosticsReturns(result1 error) {
+	fake.saveDiagnosticsMutex.Lock()
+	defer fake.saveDiagnosticsMutex.Unlock()
+	fake.SaveDiagnosticsStub = nil
+	fake.saveDiagnosticsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) SaveDiagnosticsReturnsOnCall(i int, result1 error) {
+	fake.saveDiagnosticsMutex.Lock()
+	defer fake.saveDiagnosticsMutex.Unlock()
+	fake.SaveDiagnosticsStub = nil
+	if fake.saveDiagnosticsReturnsOnCall == nil {
+		fake.saveDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveDiagnosticsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) StalledReason() string {
+	fake.stalledReasonMutex.Lock()
+	ret, specificReturn := fake.stalledReasonReturnsOnCall[len(fake.stalledReasonArgsForCall)]
+	fake.stalledReasonArgsForCall = append(fake.stalledReasonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StalledReason", []interface{}{})
+	fake.stalledReasonMutex.Unlock()
+	if fake.StalledReasonStub != nil {
+		return fake.StalledReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.stalledReasonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) StalledReasonCallCount() int {
+	fake.stalledReasonMutex.RLock()
+	defer fake.stalledReasonMutex.RUnlock()
+	return len(fake.stalledReasonArgsForCall)
+}
+
+func (fake *FakeWorker) StalledReasonCalls(stub func() string) {
+	fake.stalledReasonMutex.Lock()
+	defer fake.stalledReasonMutex.Unlock()
+	fake.StalledReasonStub = stub
+}
+
+func (fake *FakeWorker) StalledReasonReturns(result1 string) {
+	fake.stalledReasonMutex.Lock()
+	defer fake.stalledReasonMutex.Unlock()
+	fake.StalledReasonStub = nil
+	fake.stalledReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) StalledReasonReturnsOnCall(i int, result1 string) {
+	fake.stalledReasonMutex.Lock()
+	defer fake.stalledReasonMutex.Unlock()
+	fake.StalledReasonStub = nil
+	if fake.stalledReasonReturnsOnCall == nil {
+		fake.stalledReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.stalledReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeWorker) StartTime() time.Time {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -1944,10 +2407,16 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.certsPathMutex.RUnlock()
 	fake.createContainerMutex.RLock()
 	defer fake.createContainerMutex.RUnlock()
+	fake.currentStateMutex.RLock()
+	defer fake.currentStateMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
 	defer fake.decreaseActiveTasksMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.diagnosticsMutex.RLock()
+	defer fake.diagnosticsMutex.RUnlock()
+	fake.diagnosticsRequestedMutex.RLock()
+	defer fake.diagnosticsRequestedMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
@@ -1974,12 +2443,20 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.pruneMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.requestDiagnosticsMutex.RLock()
+	defer fake.requestDiagnosticsMutex.RUnlock()
 	fake.resourceCertsMutex.RLock()
 	defer fake.resourceCertsMutex.RUnlock()
+	fake.resourcePressureMutex.RLock()
+	defer fake.resourcePressureMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.saveDiagnosticsMutex.RLock()
+	defer fake.saveDiagnosticsMutex.RUnlock()
+	fake.stalledReasonMutex.RLock()
+	defer fake.stalledReasonMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
@@ -196,7 +196,7 @@ func (builder Builder) WithResourceVersions(resourceName string, versions ...atc
 			return fmt.Errorf("save versions: %w", err)
 		}
 
-		_, err = scope.UpdateLastCheckEndTime()
+		_, err = scope.UpdateLastCheckEndTime(true)
 		if err != nil {
 			return fmt.Errorf("update last check end time: %w", err)
 		}
@@ -565,7 +565,7 @@ func (builder Builder) WithPinnedVersion(resourceName string, pinnedVersion atc.
 			}
 		}
 
-		_, err = resource.PinVersion(version.ID())
+		_, err = resource.PinVersion(version.ID(), "", time.Time{})
 		if err != nil {
 			return err
 		}
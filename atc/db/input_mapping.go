@@ -2,7 +2,10 @@ package db
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 )
 
@@ -12,6 +15,10 @@ const (
 	LatestVersionNotFound ResolutionFailure = "latest version of resource not found"
 	VersionNotFound       ResolutionFailure = "version of resource not found"
 	NoSatisfiableBuilds   ResolutionFailure = "no satisfiable builds from passed jobs found for set of inputs"
+
+	NoVersionMatchesFilter     ResolutionFailure = "no version of resource matches the version filter"
+	NoVersionHasLabels         ResolutionFailure = "no version of resource has the required labels"
+	NoSucceededDependencyBuild ResolutionFailure = "no succeeded builds of job depended on"
 )
 
 type PinnedVersionNotFound struct {
@@ -26,8 +33,102 @@ func (p PinnedVersionNotFound) String() ResolutionFailure {
 	return ResolutionFailure(fmt.Sprintf("pinned version%s not found", text))
 }
 
+type NoSatisfiableBuildsMatchingFilter struct {
+	Filter BuildFilter
+}
+
+func (f NoSatisfiableBuildsMatchingFilter) String() ResolutionFailure {
+	text := "no satisfiable builds from passed jobs"
+
+	if len(f.Filter.Statuses) != 0 {
+		statuses := make([]string, len(f.Filter.Statuses))
+		for i, status := range f.Filter.Statuses {
+			statuses[i] = string(status)
+		}
+
+		text += fmt.Sprintf(" with status %s", strings.Join(statuses, " or "))
+	}
+
+	if f.Filter.MaxAge != 0 {
+		text += fmt.Sprintf(" finished within %s", f.Filter.MaxAge)
+	}
+
+	return ResolutionFailure(text + " found for set of inputs")
+}
+
 type JobSet map[int]bool
 
+// BuildFilter narrows down which builds of an input's passed jobs may provide
+// its versions. The zero value only allows succeeded builds, of any age.
+type BuildFilter struct {
+	Statuses []BuildStatus
+	MaxAge   time.Duration
+}
+
+// IsDefault returns true if the filter allows exactly the builds that passed
+// constraints have always allowed: succeeded builds of any age.
+func (f BuildFilter) IsDefault() bool {
+	if f.MaxAge != 0 {
+		return false
+	}
+
+	for _, status := range f.Statuses {
+		if status != BuildStatusSucceeded {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal returns true if both filters allow the same builds.
+func (f BuildFilter) Equal(other BuildFilter) bool {
+	if f.MaxAge != other.MaxAge {
+		return false
+	}
+
+	statuses := map[string]bool{}
+	for _, status := range f.statuses() {
+		statuses[status] = true
+	}
+
+	otherStatuses := map[string]bool{}
+	for _, status := range other.statuses() {
+		if !statuses[status] {
+			return false
+		}
+
+		otherStatuses[status] = true
+	}
+
+	return len(statuses) == len(otherStatuses)
+}
+
+func (f BuildFilter) condition() sq.Sqlizer {
+	condition := sq.And{
+		sq.Eq{"status": f.statuses()},
+	}
+
+	if f.MaxAge != 0 {
+		condition = append(condition, sq.Expr("end_time >= now() - make_interval(secs => ?)", f.MaxAge.Seconds()))
+	}
+
+	return condition
+}
+
+func (f BuildFilter) statuses() []string {
+	if len(f.Statuses) == 0 {
+		return []string{string(BuildStatusSucceeded)}
+	}
+
+	statuses := make([]string, len(f.Statuses))
+	for i, status := range f.Statuses {
+		statuses[i] = string(status)
+	}
+
+	return statuses
+}
+
 type InputMapping map[string]InputResult
 
 type InputResult struct {
@@ -36,6 +137,16 @@ type InputResult struct {
 	ResolveError   ResolutionFailure
 }
 
+// DependencyMapping holds, for each job the job depends on, the successful
+// build its next build will follow on from.
+type DependencyMapping map[string]DependencyResult
+
+type DependencyResult struct {
+	BuildID         int
+	FirstOccurrence bool
+	ResolveError    ResolutionFailure
+}
+
 type ResourceVersion string
 
 type AlgorithmVersion struct {
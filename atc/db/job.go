@@ -14,6 +14,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/atc/versionfilter"
 	"github.com/concourse/concourse/tracing"
 	"github.com/lib/pq"
 )
@@ -24,7 +25,10 @@ type InputConfig struct {
 	Name            string
 	Trigger         bool
 	Passed          JobSet
+	PassedFilter    BuildFilter
 	UseEveryVersion bool
+	VersionFilter   versionfilter.Filter
+	VersionLabels   []string
 	PinnedVersion   atc.Version
 	ResourceID      int
 	JobID           int
@@ -43,6 +47,16 @@ func (cfgs InputConfigs) String() string {
 	return strings.Join(names, ",")
 }
 
+// DependencyConfig is a job, listed under depends_on, whose successful builds
+// trigger the job depending on it.
+type DependencyConfig struct {
+	JobName        string
+	JobID          int
+	DependentJobID int
+}
+
+type DependencyConfigs []DependencyConfig
+
 type InputVersionEmptyError struct {
 	InputName string
 }
@@ -65,6 +79,7 @@ type Job interface {
 	Tags() []string
 	Public() bool
 	ScheduleRequestedTime() time.Time
+	ScheduleEvaluatedTime() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
 
@@ -72,6 +87,7 @@ type Job interface {
 	Inputs() ([]atc.JobInput, error)
 	Outputs() ([]atc.JobOutput, error)
 	AlgorithmInputs() (InputConfigs, error)
+	AlgorithmDependencies() (DependencyConfigs, error)
 
 	Reload() (bool, error)
 
@@ -80,6 +96,7 @@ type Job interface {
 
 	ScheduleBuild(Build) (bool, error)
 	CreateBuild() (Build, error)
+	CreateScheduledBuild(evaluatedAt time.Time) (Build, bool, error)
 	RerunBuild(Build) (Build, error)
 
 	RequestSchedule() error
@@ -96,6 +113,7 @@ type Job interface {
 	GetNextBuildInputs() ([]BuildInput, error)
 	GetFullNextBuildInputs() ([]BuildInput, bool, error)
 	SaveNextInputMapping(inputMapping InputMapping, inputsDetermined bool) error
+	SaveNextDependencyMapping(dependencyMapping DependencyMapping) error
 
 	ClearTaskCache(string, string) (int64, error)
 
@@ -105,7 +123,7 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger", "j.schedule_evaluated_at").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -133,6 +151,7 @@ type job struct {
 	tags                  []string
 	hasNewInputs          bool
 	scheduleRequestedTime time.Time
+	scheduleEvaluatedTime time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
 
@@ -194,6 +213,7 @@ func (j *job) TeamName() string                 { return j.teamName }
 func (j *job) Tags() []string                   { return j.tags }
 func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
+func (j *job) ScheduleEvaluatedTime() time.Time { return j.scheduleEvaluatedTime }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
 
@@ -224,13 +244,13 @@ func (j *job) Config() (atc.JobConfig, error) {
 }
 
 func (j *job) AlgorithmInputs() (InputConfigs, error) {
-	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger").
+	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger", "ji.passed_statuses", "EXTRACT(EPOCH FROM ji.passed_max_age)").
 		From("job_inputs ji").
 		LeftJoin("resource_pins rp ON rp.resource_id = ji.resource_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger").
+		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger, ji.passed_statuses, ji.passed_max_age").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -244,8 +264,10 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 		var inputName string
 		var resourceID int
 		var trigger bool
+		var passedStatuses []string
+		var passedMaxAge sql.NullFloat64
 
-		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger)
+		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger, pq.Array(&passedStatuses), &passedMaxAge)
 		if err != nil {
 			return nil, err
 		}
@@ -274,6 +296,15 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 
 			inputConfig.UseEveryVersion = version.Every
 
+			if version.Filter != "" {
+				inputConfig.VersionFilter, err = versionfilter.Parse(version.Filter)
+				if err != nil {
+					return nil, fmt.Errorf("parse version filter of input '%s': %w", inputName, err)
+				}
+			}
+
+			inputConfig.VersionLabels = version.Labels
+
 			if version.Pinned != nil {
 				inputConfig.PinnedVersion = version.Pinned
 			}
@@ -288,6 +319,14 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 
 		if len(passed) > 0 {
 			inputConfig.Passed = passed
+
+			for _, status := range passedStatuses {
+				inputConfig.PassedFilter.Statuses = append(inputConfig.PassedFilter.Statuses, BuildStatus(status))
+			}
+
+			if passedMaxAge.Valid {
+				inputConfig.PassedFilter.MaxAge = time.Duration(passedMaxAge.Float64 * float64(time.Second))
+			}
 		}
 
 		inputs = append(inputs, inputConfig)
@@ -296,6 +335,39 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 	return inputs, nil
 }
 
+func (j *job) AlgorithmDependencies() (DependencyConfigs, error) {
+	rows, err := psql.Select("d.depends_on_job_id", "dj.name").
+		From("job_dependencies d").
+		Join("jobs dj ON dj.id = d.depends_on_job_id").
+		Where(sq.Eq{
+			"d.job_id": j.id,
+		}).
+		OrderBy("dj.name").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var dependencies DependencyConfigs
+	for rows.Next() {
+		dependency := DependencyConfig{
+			DependentJobID: j.id,
+		}
+
+		err = rows.Scan(&dependency.JobID, &dependency.JobName)
+		if err != nil {
+			return nil, err
+		}
+
+		dependencies = append(dependencies, dependency)
+	}
+
+	return dependencies, nil
+}
+
 func (j *job) Inputs() ([]atc.JobInput, error) {
 	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version").
 		From("job_inputs ji").
@@ -821,6 +893,83 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
+// CreateScheduledBuild creates a build triggered by the job's schedule and
+// records that the schedule has been evaluated up to the given time.
+//
+// If the schedule has been evaluated since the job was loaded, e.g. by
+// another ATC, no build is created and false is returned.
+func (j *job) CreateScheduledBuild(evaluatedAt time.Time) (Build, bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("jobs").
+		Set("schedule_evaluated_at", evaluatedAt).
+		Where(sq.Eq{
+			"id":                    j.id,
+			"schedule_evaluated_at": j.scheduleEvaluatedTime,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":        buildName,
+		"job_id":      j.id,
+		"pipeline_id": j.pipelineID,
+		"team_id":     j.teamID,
+		"status":      BuildStatusPending,
+		"cause":       atc.BuildCauseScheduled,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	latestNonRerunID, err := latestCompletedNonRerunBuild(tx, j.id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id, latestNonRerunID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = requestSchedule(tx, j.id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	j.scheduleEvaluatedTime = evaluatedAt
+
+	return build, true, nil
+}
+
 func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
 	for {
 		rerunBuild, err := j.tryRerunBuild(buildToRerun)
@@ -1215,6 +1364,52 @@ func (j *job) SaveNextInputMapping(inputMapping InputMapping, inputsDetermined b
 	return tx.Commit()
 }
 
+// SaveNextDependencyMapping records the builds of the job's dependencies
+// that its next build will follow on from, and why any dependencies could not
+// be resolved. It must be called after SaveNextInputMapping, which clears the
+// previously recorded builds.
+func (j *job) SaveNextDependencyMapping(dependencyMapping DependencyMapping) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	pipesBuilder := psql.Insert("next_build_pipes").
+		Columns("to_job_id", "from_build_id")
+
+	insertPipes := false
+	for jobName, dependency := range dependencyMapping {
+		var resolveError sql.NullString
+		if dependency.ResolveError != "" {
+			resolveError = sql.NullString{String: string(dependency.ResolveError), Valid: true}
+		} else {
+			pipesBuilder = pipesBuilder.Values(j.id, dependency.BuildID)
+			insertPipes = true
+		}
+
+		_, err = psql.Update("job_dependencies").
+			Set("resolve_error", resolveError).
+			Where(sq.Eq{"job_id": j.id}).
+			Where(sq.Expr("depends_on_job_id = (SELECT id FROM jobs WHERE pipeline_id = ? AND name = ?)", j.pipelineID, jobName)).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	if insertPipes {
+		_, err = pipesBuilder.Suffix("ON CONFLICT DO NOTHING").RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (j *job) nextBuild(tx Tx) (Build, error) {
 	var next Build
 
@@ -1377,13 +1572,16 @@ func scanJob(j *job, row scannable) error {
 		config               sql.NullString
 		nonce                sql.NullString
 		pipelineInstanceVars sql.NullString
+		scheduleEvaluatedAt  pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &scheduleEvaluatedAt)
 	if err != nil {
 		return err
 	}
 
+	j.scheduleEvaluatedTime = scheduleEvaluatedAt.Time
+
 	if nonce.Valid {
 		j.nonce = &nonce.String
 	}
@@ -1448,12 +1646,13 @@ func requestSchedule(tx Tx, jobID int) error {
 // Updating multiple rows using a SELECT subquery does not preserve the same
 // order for the updates, which can lead to deadlocking.
 func requestScheduleOnDownstreamJobs(tx Tx, jobID int) error {
-	rows, err := psql.Select("DISTINCT job_id").
-		From("job_inputs").
-		Where(sq.Eq{
-			"passed_job_id": jobID,
+	rows, err := psql.Select("j.id").
+		From("jobs j").
+		Where(sq.Or{
+			sq.Expr("EXISTS (SELECT 1 FROM job_inputs ji WHERE ji.job_id = j.id AND ji.passed_job_id = ?)", jobID),
+			sq.Expr("EXISTS (SELECT 1 FROM job_dependencies d WHERE d.job_id = j.id AND d.depends_on_job_id = ?)", jobID),
 		}).
-		OrderBy("job_id DESC").
+		OrderBy("j.id DESC").
 		RunWith(tx).
 		Query()
 	if err != nil {
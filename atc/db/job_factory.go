@@ -21,6 +21,7 @@ type JobFactory interface {
 	VisibleJobs([]string) ([]atc.JobSummary, error)
 	AllActiveJobs() ([]atc.JobSummary, error)
 	JobsToSchedule() (SchedulerJobs, error)
+	JobsWithSchedules() (Jobs, error)
 }
 
 type jobFactory struct {
@@ -73,6 +74,25 @@ func (resources SchedulerResources) Lookup(name string) (*SchedulerResource, boo
 	return nil, false
 }
 
+// JobsWithSchedules returns the active, unpaused jobs which are configured to
+// be triggered on a schedule.
+func (j *jobFactory) JobsWithSchedules() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.Eq{
+			"j.has_schedule": true,
+			"j.active":       true,
+			"j.paused":       false,
+			"p.paused":       false,
+		}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
 func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -275,7 +295,12 @@ func (d dashboardFactory) buildDashboard() ([]atc.JobSummary, error) {
 		return nil, err
 	}
 
-	return d.combineJobInputsAndOutputsWithDashboardJobs(dashboard, jobInputs, jobOutputs), nil
+	jobDependencies, err := d.fetchJobDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.combineJobInputsAndOutputsWithDashboardJobs(dashboard, jobInputs, jobOutputs, jobDependencies), nil
 }
 
 func (d dashboardFactory) constructJobsForDashboard() ([]atc.JobSummary, error) {
@@ -467,7 +492,40 @@ func (d dashboardFactory) fetchJobOutputs() (map[int][]atc.JobOutputSummary, err
 	return jobOutputs, err
 }
 
-func (d dashboardFactory) combineJobInputsAndOutputsWithDashboardJobs(dashboard []atc.JobSummary, jobInputs map[int][]atc.JobInputSummary, jobOutputs map[int][]atc.JobOutputSummary) []atc.JobSummary {
+func (d dashboardFactory) fetchJobDependencies() (map[int][]string, error) {
+	rows, err := psql.Select("j.id", "dj.name").
+		From("job_dependencies d").
+		Join("jobs j ON j.id = d.job_id").
+		Join("jobs dj ON dj.id = d.depends_on_job_id").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Join("teams tm ON tm.id = p.team_id").
+		Where(d.pred).
+		Where(sq.Eq{
+			"j.active": true,
+		}).
+		OrderBy("j.id", "dj.name").
+		RunWith(d.tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	jobDependencies := make(map[int][]string)
+	for rows.Next() {
+		var jobID int
+		var dependency string
+		err = rows.Scan(&jobID, &dependency)
+		if err != nil {
+			return nil, err
+		}
+
+		jobDependencies[jobID] = append(jobDependencies[jobID], dependency)
+	}
+
+	return jobDependencies, nil
+}
+
+func (d dashboardFactory) combineJobInputsAndOutputsWithDashboardJobs(dashboard []atc.JobSummary, jobInputs map[int][]atc.JobInputSummary, jobOutputs map[int][]atc.JobOutputSummary, jobDependencies map[int][]string) []atc.JobSummary {
 	var finalDashboard []atc.JobSummary
 	for _, job := range dashboard {
 		for _, input := range jobInputs[job.ID] {
@@ -486,6 +544,8 @@ func (d dashboardFactory) combineJobInputsAndOutputsWithDashboardJobs(dashboard
 			return job.Outputs[p].Name < job.Outputs[q].Name
 		})
 
+		job.DependsOn = jobDependencies[job.ID]
+
 		finalDashboard = append(finalDashboard, job)
 	}
 
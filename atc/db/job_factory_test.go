@@ -346,6 +346,43 @@ var _ = Describe("JobFactory", func() {
 		})
 	})
 
+	Describe("JobsWithSchedules", func() {
+		BeforeEach(func() {
+			err := defaultPipeline.Destroy()
+			Expect(err).ToNot(HaveOccurred())
+
+			pipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:      "scheduled-job",
+						Schedules: []atc.JobSchedule{{Cron: "@hourly"}},
+					},
+					{
+						Name:      "paused-scheduled-job",
+						Schedules: []atc.JobSchedule{{Cron: "@daily"}},
+					},
+					{Name: "unscheduled-job"},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			pausedJob, found, err := pipeline.Job("paused-scheduled-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = pausedJob.Pause()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns only the unpaused jobs with a schedule", func() {
+			jobs, err := jobFactory.JobsWithSchedules()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobs).To(HaveLen(1))
+			Expect(jobs[0].Name()).To(Equal("scheduled-job"))
+			Expect(jobs[0].ScheduleEvaluatedTime().IsZero()).To(BeFalse())
+		})
+	})
+
 	Describe("JobsToSchedule", func() {
 		var (
 			job1 db.Job
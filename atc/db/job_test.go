@@ -156,6 +156,100 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateScheduledBuild", func() {
+		var scheduledJob db.Job
+
+		BeforeEach(func() {
+			scheduledPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:      "scheduled-job",
+						Schedules: []atc.JobSchedule{{Cron: "@hourly"}},
+					},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			scheduledJob, found, err = scheduledPipeline.Job("scheduled-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("starts evaluating the schedule from when it was configured", func() {
+			Expect(scheduledJob.ScheduleEvaluatedTime()).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("creates a pending build caused by the schedule", func() {
+			evaluatedAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+			build, created, err := scheduledJob.CreateScheduledBuild(evaluatedAt)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+			Expect(build.Cause()).To(Equal(atc.BuildCauseScheduled))
+			Expect(build.IsManuallyTriggered()).To(BeFalse())
+
+			found, err := scheduledJob.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(scheduledJob.ScheduleEvaluatedTime()).To(BeTemporally("==", evaluatedAt))
+		})
+
+		Context("when the schedule has been evaluated since the job was loaded", func() {
+			BeforeEach(func() {
+				scheduledPipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "scheduled-pipeline"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				sameJob, found, err := scheduledPipeline.Job("scheduled-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				_, created, err := sameJob.CreateScheduledBuild(time.Now())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("does not create another build", func() {
+				_, created, err := scheduledJob.CreateScheduledBuild(time.Now())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+
+		Context("when the schedule is removed and added back", func() {
+			It("starts evaluating from when it was added back", func() {
+				originalTime := scheduledJob.ScheduleEvaluatedTime()
+
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{{Name: "scheduled-job"}},
+				}, db.ConfigVersion(1), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := scheduledJob.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(scheduledJob.ScheduleEvaluatedTime().IsZero()).To(BeTrue())
+
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name:      "scheduled-job",
+							Schedules: []atc.JobSchedule{{Cron: "@hourly"}},
+						},
+					},
+				}, db.ConfigVersion(2), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err = scheduledJob.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(scheduledJob.ScheduleEvaluatedTime()).To(BeTemporally(">=", originalTime))
+			})
+		})
+	})
+
 	Describe("Pause and Unpause", func() {
 		var initialRequestedTime time.Time
 		It("starts out as unpaused", func() {
@@ -2210,6 +2304,127 @@ var _ = Describe("Job", func() {
 			})
 		})
 
+		Context("when the input's passed constraint filters builds", func() {
+			BeforeEach(func() {
+				scenario = dbtest.Setup(
+					builder.WithPipeline(atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "some-job",
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.GetStep{
+											Name:           "some-input",
+											Resource:       "some-resource",
+											Passed:         []string{"job-1"},
+											PassedStatuses: []atc.BuildStatus{atc.StatusSucceeded, atc.StatusFailed},
+											PassedMaxAge:   "24h",
+										},
+									},
+								},
+							},
+							{
+								Name: "job-1",
+							},
+						},
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: "some-type",
+							},
+						},
+					}),
+				)
+			})
+
+			It("returns the filter along with the input", func() {
+				Expect(inputs).To(Equal(db.InputConfigs{
+					{
+						Name:       "some-input",
+						JobID:      scenario.Job("some-job").ID(),
+						ResourceID: scenario.Resource("some-resource").ID(),
+						Passed: db.JobSet{
+							scenario.Job("job-1").ID(): true,
+						},
+						PassedFilter: db.BuildFilter{
+							Statuses: []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed},
+							MaxAge:   24 * time.Hour,
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the input has a version filter", func() {
+			BeforeEach(func() {
+				scenario = dbtest.Setup(
+					builder.WithPipeline(atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "some-job",
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.GetStep{
+											Name:     "some-input",
+											Resource: "some-resource",
+											Version:  &atc.VersionConfig{Every: true, Filter: `tag =~ "^2\."`},
+										},
+									},
+								},
+							},
+						},
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: "some-type",
+							},
+						},
+					}),
+				)
+			})
+
+			It("returns the parsed filter along with the input", func() {
+				Expect(inputs).To(HaveLen(1))
+				Expect(inputs[0].UseEveryVersion).To(BeTrue())
+				Expect(inputs[0].VersionFilter.String()).To(Equal(`tag =~ "^2\."`))
+				Expect(inputs[0].VersionFilter.Match(map[string]string{"tag": "2.0.1"}, nil)).To(BeTrue())
+			})
+		})
+
+		Context("when the input requires version labels", func() {
+			BeforeEach(func() {
+				scenario = dbtest.Setup(
+					builder.WithPipeline(atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "some-job",
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.GetStep{
+											Name:     "some-input",
+											Resource: "some-resource",
+											Version:  &atc.VersionConfig{Labels: []string{"qa-approved"}},
+										},
+									},
+								},
+							},
+						},
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: "some-type",
+							},
+						},
+					}),
+				)
+			})
+
+			It("returns the labels along with the input", func() {
+				Expect(inputs).To(HaveLen(1))
+				Expect(inputs[0].VersionLabels).To(Equal([]string{"qa-approved"}))
+			})
+		})
+
 		Context("when the input is pinned through the get step", func() {
 			BeforeEach(func() {
 				scenario = dbtest.Setup(
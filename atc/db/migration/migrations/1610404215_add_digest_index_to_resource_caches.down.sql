@@ -0,0 +1,3 @@
+BEGIN;
+  DROP INDEX IF EXISTS resource_caches_version_digest_idx;
+COMMIT;
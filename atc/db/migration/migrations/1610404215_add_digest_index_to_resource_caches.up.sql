@@ -0,0 +1,3 @@
+BEGIN;
+  CREATE INDEX resource_caches_version_digest_idx ON resource_caches ((version->>'digest'));
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers DROP COLUMN resource_pressure;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers DROP COLUMN stall_landed;
+
+  ALTER TABLE workers DROP COLUMN stalled_reason;
+COMMIT;
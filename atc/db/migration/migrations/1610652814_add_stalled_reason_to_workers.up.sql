@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN stalled_reason text;
+
+  ALTER TABLE workers ADD COLUMN stall_landed boolean NOT NULL DEFAULT false;
+COMMIT;
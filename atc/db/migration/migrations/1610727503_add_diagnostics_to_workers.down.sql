@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN diagnostics,
+    DROP COLUMN diagnostics_requested_at,
+    DROP COLUMN diagnostics_collected_at;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN diagnostics jsonb,
+    ADD COLUMN diagnostics_requested_at timestamp with time zone,
+    ADD COLUMN diagnostics_collected_at timestamp with time zone;
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN cause;
+
+  ALTER TABLE jobs
+    DROP COLUMN has_schedule,
+    DROP COLUMN schedule_evaluated_at;
+COMMIT;
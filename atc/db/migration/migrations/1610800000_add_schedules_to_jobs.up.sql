@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN has_schedule boolean NOT NULL DEFAULT false,
+    ADD COLUMN schedule_evaluated_at timestamp with time zone;
+
+  ALTER TABLE builds
+    ADD COLUMN cause text;
+COMMIT;
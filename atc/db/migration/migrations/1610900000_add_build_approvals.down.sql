@@ -0,0 +1,6 @@
+BEGIN;
+  DROP TABLE build_approvals;
+
+  ALTER TABLE builds
+    DROP COLUMN awaiting_approval;
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN awaiting_approval text;
+
+  CREATE TABLE build_approvals (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    plan_id text NOT NULL,
+    username text NOT NULL,
+    role text NOT NULL,
+    approved boolean NOT NULL,
+    comment text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (build_id, plan_id, username)
+  );
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE job_inputs
+    DROP COLUMN passed_statuses,
+    DROP COLUMN passed_max_age;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE job_inputs
+    ADD COLUMN passed_statuses text[],
+    ADD COLUMN passed_max_age interval;
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+  CREATE TABLE job_dependencies (
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    depends_on_job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    resolve_error text,
+    PRIMARY KEY (job_id, depends_on_job_id)
+  );
+
+  CREATE INDEX job_dependencies_depends_on_job_id_idx ON job_dependencies (depends_on_job_id);
+COMMIT;
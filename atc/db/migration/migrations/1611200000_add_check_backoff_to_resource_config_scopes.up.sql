@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE resource_config_scopes
+    ADD COLUMN consecutive_check_failures integer NOT NULL DEFAULT 0,
+    ADD COLUMN last_new_version_time timestamp with time zone;
+
+  UPDATE resource_config_scopes
+    SET last_new_version_time = now();
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP TABLE team_webhook_deliveries;
+
+  DROP TABLE team_webhooks;
+COMMIT;
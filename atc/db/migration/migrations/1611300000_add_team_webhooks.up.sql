@@ -0,0 +1,23 @@
+BEGIN;
+  CREATE TABLE team_webhooks (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    config text NOT NULL,
+    nonce text,
+    UNIQUE (team_id, name)
+  );
+
+  CREATE TABLE team_webhook_deliveries (
+    id serial PRIMARY KEY,
+    webhook_id integer NOT NULL REFERENCES team_webhooks (id) ON DELETE CASCADE,
+    received_at timestamp with time zone NOT NULL DEFAULT now(),
+    event text,
+    status_code integer NOT NULL,
+    error text,
+    "values" json,
+    checked_resources json
+  );
+
+  CREATE INDEX team_webhook_deliveries_webhook_id_idx ON team_webhook_deliveries (webhook_id);
+COMMIT;
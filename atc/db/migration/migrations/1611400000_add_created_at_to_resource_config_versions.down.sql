@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE resource_config_versions
+    DROP COLUMN created_at;
+COMMIT;
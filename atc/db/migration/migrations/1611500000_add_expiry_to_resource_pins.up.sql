@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE resource_pins
+    ADD COLUMN pinned_by text,
+    ADD COLUMN expires_at timestamp with time zone;
+
+  CREATE INDEX resource_pins_expires_at_idx ON resource_pins (expires_at) WHERE expires_at IS NOT NULL;
+COMMIT;
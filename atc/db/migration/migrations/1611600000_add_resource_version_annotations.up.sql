@@ -0,0 +1,11 @@
+BEGIN;
+  CREATE TABLE resource_version_annotations (
+    resource_config_version_id integer NOT NULL REFERENCES resource_config_versions (id) ON DELETE CASCADE,
+    label text NOT NULL,
+    author text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (resource_config_version_id, label)
+  );
+
+  CREATE INDEX resource_version_annotations_label_idx ON resource_version_annotations (label);
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+  CREATE TABLE pipeline_config_revisions (
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    version bigint NOT NULL,
+    config text NOT NULL,
+    nonce text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (pipeline_id, version)
+  );
+COMMIT;
@@ -48,6 +48,7 @@ type Pipeline interface {
 	Display() *atc.DisplayConfig
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigRevision(ConfigVersion) (atc.Config, bool, error)
 	Public() bool
 	Paused() bool
 	Archived() bool
@@ -281,6 +282,50 @@ func (p *pipeline) Config() (atc.Config, error) {
 	return config, nil
 }
 
+// ConfigRevision returns the config the pipeline had at the given version.
+// Only the most recent revisions are kept.
+func (p *pipeline) ConfigRevision(version ConfigVersion) (atc.Config, bool, error) {
+	var (
+		rawConfig string
+		nonce     sql.NullString
+	)
+
+	err := psql.Select("config", "nonce").
+		From("pipeline_config_revisions").
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+			"version":     version,
+		}).
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&rawConfig, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.Config{}, false, nil
+		}
+
+		return atc.Config{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := p.conn.EncryptionStrategy().Decrypt(rawConfig, noncense)
+	if err != nil {
+		return atc.Config{}, false, err
+	}
+
+	var config atc.Config
+	err = json.Unmarshal(decryptedConfig, &config)
+	if err != nil {
+		return atc.Config{}, false, err
+	}
+
+	return config, true, nil
+}
+
 func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
@@ -1135,6 +1135,26 @@ var _ = Describe("Pipeline", func() {
 	})
 
 	Describe("Dashboard", func() {
+		It("includes the jobs each job depends on", func() {
+			dependentPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "dependent-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "unit"},
+					{Name: "lint"},
+					{Name: "integration", DependsOn: []string{"unit", "lint"}},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			actualDashboard, err := dependentPipeline.Dashboard()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(actualDashboard).To(HaveLen(3))
+			Expect(actualDashboard[0].DependsOn).To(BeEmpty())
+			Expect(actualDashboard[1].DependsOn).To(BeEmpty())
+			Expect(actualDashboard[2].Name).To(Equal("integration"))
+			Expect(actualDashboard[2].DependsOn).To(Equal([]string{"lint", "unit"}))
+		})
+
 		It("returns a Dashboard object with a DashboardJob corresponding to each configured job", func() {
 			job, found, err := pipeline.Job("job-name")
 			Expect(err).ToNot(HaveOccurred())
@@ -36,12 +36,16 @@ type Resource interface {
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
+	CheckFailures() int
+	LastNewVersionTime() time.Time
 	Tags() atc.Tags
 	WebhookToken() string
 	Config() atc.ResourceConfig
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
+	PinnedBy() string
+	PinExpiresAt() time.Time
 	SetPinComment(string) error
 	ResourceConfigID() int
 	ResourceConfigScopeID() int
@@ -60,8 +64,12 @@ type Resource interface {
 	EnableVersion(rcvID int) error
 	DisableVersion(rcvID int) error
 
-	PinVersion(rcvID int) (bool, error)
+	AnnotateVersion(rcvID int, label string, author string) (bool, error)
+	RemoveVersionAnnotation(rcvID int, label string) (bool, error)
+
+	PinVersion(rcvID int, pinnedBy string, expiresAt time.Time) (bool, error)
 	UnpinVersion() error
+	UnpinExpiredVersion(now time.Time) (bool, error)
 
 	SetResourceConfigScope(ResourceConfigScope) error
 
@@ -81,6 +89,8 @@ var (
 		"r.config",
 		"rs.last_check_start_time",
 		"rs.last_check_end_time",
+		"rs.consecutive_check_failures",
+		"rs.last_new_version_time",
 		"r.pipeline_id",
 		"r.nonce",
 		"r.resource_config_id",
@@ -92,6 +102,8 @@ var (
 		"rp.version",
 		"rp.comment_text",
 		"rp.config",
+		"rp.pinned_by",
+		"rp.expires_at",
 		"b.id",
 		"b.name",
 		"b.status",
@@ -117,10 +129,14 @@ type resource struct {
 	type_                 string
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	checkFailures         int
+	lastNewVersionTime    time.Time
 	config                atc.ResourceConfig
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
+	pinnedBy              string
+	pinExpiresAt          time.Time
 	resourceConfigID      int
 	resourceConfigScopeID int
 	buildSummary          *atc.BuildSummary
@@ -168,6 +184,8 @@ func (r *resource) Source() atc.Source               { return r.config.Source }
 func (r *resource) CheckEvery() *atc.CheckEvery      { return r.config.CheckEvery }
 func (r *resource) CheckTimeout() string             { return r.config.CheckTimeout }
 func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
+func (r *resource) CheckFailures() int               { return r.checkFailures }
+func (r *resource) LastNewVersionTime() time.Time    { return r.lastNewVersionTime }
 func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
 func (r *resource) Tags() atc.Tags                   { return r.config.Tags }
 func (r *resource) WebhookToken() string             { return r.config.WebhookToken }
@@ -175,6 +193,8 @@ func (r *resource) Config() atc.ResourceConfig       { return r.config }
 func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
 func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
 func (r *resource) PinComment() string               { return r.pinComment }
+func (r *resource) PinnedBy() string                 { return r.pinnedBy }
+func (r *resource) PinExpiresAt() time.Time          { return r.pinExpiresAt }
 func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
 func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
 func (r *resource) Icon() string                     { return r.config.Icon }
@@ -592,6 +612,11 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 		return nil, Pagination{}, true, nil
 	}
 
+	err = r.loadVersionAnnotations(tx, rvs)
+	if err != nil {
+		return nil, Pagination{}, false, err
+	}
+
 	newestRCVCheckOrder := checkOrderRVs[0]
 	oldestRCVCheckOrder := checkOrderRVs[len(checkOrderRVs)-1]
 
@@ -639,6 +664,49 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 	return rvs, pagination, true, nil
 }
 
+func (r *resource) loadVersionAnnotations(tx Tx, rvs []atc.ResourceVersion) error {
+	ids := make([]int, len(rvs))
+	indexes := make(map[int]int, len(rvs))
+	for i, rv := range rvs {
+		ids[i] = rv.ID
+		indexes[rv.ID] = i
+	}
+
+	rows, err := psql.Select("resource_config_version_id", "label", "author", "created_at").
+		From("resource_version_annotations").
+		Where(sq.Expr("resource_config_version_id = ANY(?)", pq.Array(ids))).
+		OrderBy("created_at ASC", "label ASC").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var (
+			rcvID      int
+			annotation atc.VersionAnnotation
+			author     sql.NullString
+			createdAt  time.Time
+		)
+
+		err = rows.Scan(&rcvID, &annotation.Label, &author, &createdAt)
+		if err != nil {
+			return err
+		}
+
+		annotation.Author = author.String
+		annotation.CreatedAt = createdAt.Unix()
+
+		i := indexes[rcvID]
+		rvs[i].Annotations = append(rvs[i].Annotations, annotation)
+	}
+
+	return rows.Err()
+}
+
 func (r *resource) EnableVersion(rcvID int) error {
 	return r.toggleVersion(rcvID, true)
 }
@@ -647,7 +715,100 @@ func (r *resource) DisableVersion(rcvID int) error {
 	return r.toggleVersion(rcvID, false)
 }
 
-func (r *resource) PinVersion(rcvID int) (bool, error) {
+// AnnotateVersion labels one of the resource's versions, recording who did
+// so. Annotating a version with a label it already has updates the author
+// and time. It returns false if the version does not belong to the resource.
+func (r *resource) AnnotateVersion(rcvID int, label string, author string) (bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	results, err := tx.Exec(`
+		INSERT INTO resource_version_annotations (resource_config_version_id, label, author)
+		SELECT rcv.id, $3, $4
+		FROM resource_config_versions rcv, resources r
+		WHERE r.id = $1
+		AND rcv.id = $2
+		AND rcv.resource_config_scope_id = r.resource_config_scope_id
+		ON CONFLICT (resource_config_version_id, label) DO UPDATE SET author = EXCLUDED.author, created_at = now()
+		`, r.id, rcvID, label, sql.NullString{String: author, Valid: author != ""})
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	err = requestScheduleForJobsUsingResource(tx, r.id)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RemoveVersionAnnotation removes a label from one of the resource's
+// versions. It returns false if the version did not have the label.
+func (r *resource) RemoveVersionAnnotation(rcvID int, label string) (bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	results, err := tx.Exec(`
+		DELETE FROM resource_version_annotations a
+		USING resource_config_versions rcv, resources r
+		WHERE r.id = $1
+		AND rcv.id = $2
+		AND rcv.resource_config_scope_id = r.resource_config_scope_id
+		AND a.resource_config_version_id = rcv.id
+		AND a.label = $3
+		`, r.id, rcvID, label)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	err = requestScheduleForJobsUsingResource(tx, r.id)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PinVersion pins the resource to the version, recording who pinned it. The
+// pin is removed once expiresAt has passed, unless it is zero.
+func (r *resource) PinVersion(rcvID int, pinnedBy string, expiresAt time.Time) (bool, error) {
 	tx, err := r.conn.Begin()
 	if err != nil {
 		return false, err
@@ -670,13 +831,14 @@ func (r *resource) PinVersion(rcvID int) (bool, error) {
 	}
 
 	results, err := tx.Exec(`
-	    INSERT INTO resource_pins(resource_id, version, comment_text, config)
+	    INSERT INTO resource_pins(resource_id, version, comment_text, config, pinned_by, expires_at)
 			VALUES ($1,
 				( SELECT rcv.version
 				FROM resource_config_versions rcv
 				WHERE rcv.id = $2 ),
-				'', false)
-			ON CONFLICT (resource_id) DO UPDATE SET version=EXCLUDED.version`, r.id, rcvID)
+				'', false, $3, $4)
+			ON CONFLICT (resource_id) DO UPDATE SET version=EXCLUDED.version, pinned_by=EXCLUDED.pinned_by, expires_at=EXCLUDED.expires_at`,
+		r.id, rcvID, sql.NullString{String: pinnedBy, Valid: pinnedBy != ""}, pq.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return false, nil
@@ -744,6 +906,50 @@ func (r *resource) UnpinVersion() error {
 	return nil
 }
 
+// UnpinExpiredVersion removes the resource's pin if it expired by now. It
+// returns false if the pin was removed or extended in the meantime.
+func (r *resource) UnpinExpiredVersion(now time.Time) (bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	results, err := psql.Delete("resource_pins").
+		Where(sq.Eq{
+			"resource_id": r.id,
+			"config":      false,
+		}).
+		Where(sq.LtOrEq{"expires_at": now}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	err = requestScheduleForJobsUsingResource(tx, r.id)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (r *resource) toggleVersion(rcvID int, enable bool) error {
 	tx, err := r.conn.Begin()
 	if err != nil {
@@ -797,7 +1003,11 @@ func scanResource(r *resource, row scannable) error {
 		configBlob                                        sql.NullString
 		nonce, rcID, rcScopeID, pinnedVersion, pinComment sql.NullString
 		lastCheckStartTime, lastCheckEndTime              pq.NullTime
+		checkFailures                                     sql.NullInt64
+		lastNewVersionTime                                pq.NullTime
 		pinnedThroughConfig                               sql.NullBool
+		pinnedBy                                          sql.NullString
+		pinExpiresAt                                      pq.NullTime
 		pipelineInstanceVars                              sql.NullString
 	)
 
@@ -809,13 +1019,15 @@ func scanResource(r *resource, row scannable) error {
 		endTime   pq.NullTime
 	}
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &pinnedVersion, &pinComment, &pinnedThroughConfig, &build.id, &build.name, &build.status, &build.startTime, &build.endTime)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &lastCheckStartTime, &lastCheckEndTime, &checkFailures, &lastNewVersionTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &pinnedVersion, &pinComment, &pinnedThroughConfig, &pinnedBy, &pinExpiresAt, &build.id, &build.name, &build.status, &build.startTime, &build.endTime)
 	if err != nil {
 		return err
 	}
 
 	r.lastCheckStartTime = lastCheckStartTime.Time
 	r.lastCheckEndTime = lastCheckEndTime.Time
+	r.checkFailures = int(checkFailures.Int64)
+	r.lastNewVersionTime = lastNewVersionTime.Time
 
 	es := r.conn.EncryptionStrategy()
 
@@ -863,6 +1075,9 @@ func scanResource(r *resource, row scannable) error {
 		r.pinComment = ""
 	}
 
+	r.pinnedBy = pinnedBy.String
+	r.pinExpiresAt = pinExpiresAt.Time
+
 	if rcID.Valid {
 		r.resourceConfigID, err = strconv.Atoi(rcID.String)
 		if err != nil {
@@ -173,6 +173,11 @@ func paramsHash(p atc.Params) string {
 	return mapHash(atc.Params{})
 }
 
+// ContentDigestVersionField is the version field which resources such as
+// registry-image use to report the digest of the fetched content. Resource
+// caches with the same digest may share their volumes on a worker.
+const ContentDigestVersionField = "digest"
+
 // UsedResourceCache is created whenever a ResourceCache is Created and/or
 // Used.
 //
@@ -73,6 +73,20 @@ func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error
 		return err
 	}
 
+	// caches whose volumes are shared with other caches having the same
+	// content digest are kept around so that the shared volume stays
+	// addressable by digest rather than becoming orphaned
+	sharedVolumeCacheIds, _, err := sq.
+		Select("wrc.resource_cache_id").
+		From("volumes v").
+		Join("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Join("volumes cv ON cv.parent_id = v.id").
+		Where(sq.NotEq{"cv.worker_resource_cache_id": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
 	nextBuildInputsCacheIds, _, err := sq.
 		Select("r_cache.id").
 		From("next_build_inputs nbi").
@@ -92,6 +106,7 @@ func (f *resourceCacheLifecycle) CleanUpInvalidCaches(logger lager.Logger) error
 			stillInUseCacheIds,
 			resourceConfigCacheIds,
 			buildImageCacheIds,
+			sharedVolumeCacheIds,
 			nextBuildInputsCacheIds,
 		}, " UNION ") + ")").
 		Suffix("RETURNING id").
@@ -272,6 +272,51 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 			})
 		})
 
+		Context("the resource cache volume is shared with another resource cache", func() {
+			var sharedBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				sharedBuild, err = defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				sharedCache := createResourceCacheWithUser(db.ForBuild(sharedBuild.ID()))
+
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				cache := createResourceCacheWithUser(db.ForBuild(build.ID()))
+
+				container, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(sharedBuild.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{})
+				Expect(err).ToNot(HaveOccurred())
+
+				creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), container, "some-path")
+				Expect(err).ToNot(HaveOccurred())
+
+				sharedVolume, err := creatingVolume.Created()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = sharedVolume.InitializeResourceCache(sharedCache)
+				Expect(err).ToNot(HaveOccurred())
+
+				creatingChildVolume, err := sharedVolume.CreateChildForResourceCache(cache)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = creatingChildVolume.Created()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = sharedBuild.Delete()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = resourceCacheLifecycle.CleanUpInvalidCaches(logger.Session("resource-cache-lifecycle"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("doesn't delete the shared resource cache", func() {
+				Expect(countResourceCaches()).To(Equal(2))
+			})
+		})
+
 		Context("when the cache is for a custom resource type", func() {
 			It("does not remove the cache if the type is still configured", func() {
 				_, err := resourceConfigFactory.FindOrCreateResourceConfig(
@@ -36,7 +36,7 @@ type ResourceConfigScope interface {
 	UpdateLastCheckStartTime() (bool, error)
 
 	LastCheckEndTime() (time.Time, error)
-	UpdateLastCheckEndTime() (bool, error)
+	UpdateLastCheckEndTime(succeeded bool) (bool, error)
 }
 
 type resourceConfigScope struct {
@@ -111,6 +111,15 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 			}
 		}
 
+		_, err = psql.Update("resource_config_scopes").
+			Set("last_new_version_time", sq.Expr("now()")).
+			Where(sq.Eq{"id": rcsID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+
 		err = requestScheduleForJobsUsingResourceConfigScope(tx, rcsID)
 		if err != nil {
 			return err
@@ -215,7 +224,9 @@ func (r *resourceConfigScope) UpdateLastCheckStartTime() (bool, error) {
 	return true, nil
 }
 
-func (r *resourceConfigScope) UpdateLastCheckEndTime() (bool, error) {
+// UpdateLastCheckEndTime records the end of a check, counting how many checks
+// in a row have failed so that further checks can back off.
+func (r *resourceConfigScope) UpdateLastCheckEndTime(succeeded bool) (bool, error) {
 	tx, err := r.conn.Begin()
 	if err != nil {
 		return false, err
@@ -225,9 +236,10 @@ func (r *resourceConfigScope) UpdateLastCheckEndTime() (bool, error) {
 
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE resource_config_scopes
-		SET last_check_end_time = now()
+		SET last_check_end_time = now(),
+			consecutive_check_failures = CASE WHEN $2 THEN 0 ELSE consecutive_check_failures + 1 END
 		WHERE id = $1
-	`, r.id)
+	`, r.id, succeeded)
 	if err != nil {
 		return false, err
 	}
@@ -105,6 +105,21 @@ var _ = Describe("Resource Config Scope", func() {
 			Expect(latestVR.CheckOrder()).To(Equal(4))
 		})
 
+		It("records when a new version was last saved", func() {
+			lastTime := scenario.Resource("some-resource").LastNewVersionTime()
+
+			err := resourceScope.SaveVersions(nil, originalVersionSlice)
+			Expect(err).ToNot(HaveOccurred())
+
+			newVersionTime := scenario.Resource("some-resource").LastNewVersionTime()
+			Expect(newVersionTime).To(BeTemporally(">", lastTime))
+
+			err = resourceScope.SaveVersions(nil, originalVersionSlice)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scenario.Resource("some-resource").LastNewVersionTime()).To(Equal(newVersionTime))
+		})
+
 		Context("when the versions already exists", func() {
 			var newVersionSlice []atc.Version
 
@@ -312,12 +327,27 @@ var _ = Describe("Resource Config Scope", func() {
 		It("updates last check end time", func() {
 			lastTime := scenario.Resource("some-resource").LastCheckEndTime()
 
-			updated, err := resourceScope.UpdateLastCheckEndTime()
+			updated, err := resourceScope.UpdateLastCheckEndTime(true)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(updated).To(BeTrue())
 
 			Expect(scenario.Resource("some-resource").LastCheckEndTime()).To(BeTemporally(">", lastTime))
 		})
+
+		It("counts consecutive failures until a check succeeds", func() {
+			_, err := resourceScope.UpdateLastCheckEndTime(false)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = resourceScope.UpdateLastCheckEndTime(false)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scenario.Resource("some-resource").CheckFailures()).To(Equal(2))
+
+			_, err = resourceScope.UpdateLastCheckEndTime(true)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scenario.Resource("some-resource").CheckFailures()).To(BeZero())
+		})
 	})
 
 	Describe("AcquireResourceCheckingLock", func() {
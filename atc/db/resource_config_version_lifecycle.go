@@ -0,0 +1,112 @@
+package db
+
+import (
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . ResourceConfigVersionLifecycle
+
+type ResourceConfigVersionLifecycle interface {
+	PruneVersions(scopeID int, retention atc.VersionRetention, pinned []atc.Version) (int, error)
+}
+
+type resourceConfigVersionLifecycle struct {
+	conn Conn
+}
+
+func NewResourceConfigVersionLifecycle(conn Conn) ResourceConfigVersionLifecycle {
+	return resourceConfigVersionLifecycle{
+		conn: conn,
+	}
+}
+
+// PruneVersions deletes the versions of a resource config scope that fall
+// outside of the retention, returning how many were deleted. The latest
+// version is always kept, as are the given pinned versions, versions pinned
+// by get steps, labelled versions, and versions used by builds whose logs
+// have not been reaped or chosen as the next inputs of a job.
+func (lifecycle resourceConfigVersionLifecycle) PruneVersions(scopeID int, retention atc.VersionRetention, pinned []atc.Version) (int, error) {
+	keep := retention.Versions
+	if keep < 1 {
+		keep = 1
+	}
+
+	query := sq.Select("v.id").
+		From("resource_config_versions v").
+		Where(sq.Eq{"v.resource_config_scope_id": scopeID}).
+		Where(sq.Expr(`v.check_order < (
+			SELECT check_order FROM resource_config_versions
+			WHERE resource_config_scope_id = ?
+			ORDER BY check_order DESC
+			OFFSET ? LIMIT 1
+		)`, scopeID, keep-1)).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM build_resource_config_version_inputs i
+			JOIN resources r ON r.id = i.resource_id
+			JOIN builds b ON b.id = i.build_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND i.version_md5 = v.version_md5
+			AND b.reap_time IS NULL
+		)`)).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM build_resource_config_version_outputs o
+			JOIN resources r ON r.id = o.resource_id
+			JOIN builds b ON b.id = o.build_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND o.version_md5 = v.version_md5
+			AND b.reap_time IS NULL
+		)`)).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM next_build_inputs n
+			JOIN resources r ON r.id = n.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND n.version_md5 = v.version_md5
+		)`)).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM job_inputs ji
+			JOIN resources r ON r.id = ji.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND ji.version LIKE '{%'
+			AND v.version @> ji.version::jsonb
+		)`)).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM resource_version_annotations a
+			WHERE a.resource_config_version_id = v.id
+		)`))
+
+	if retention.Days > 0 {
+		query = query.Where(sq.Expr("v.created_at < now() - (? * interval '1 day')", retention.Days))
+	}
+
+	for _, version := range pinned {
+		versionJSON, err := json.Marshal(version)
+		if err != nil {
+			return 0, err
+		}
+
+		query = query.Where(sq.Expr("NOT (v.version @> ?::jsonb)", string(versionJSON)))
+	}
+
+	prunable, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := psql.Delete("resource_config_versions").
+		Where(sq.Expr("id IN ("+prunable+")", args...)).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(pruned), nil
+}
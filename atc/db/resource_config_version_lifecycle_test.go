@@ -0,0 +1,202 @@
+package db_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbtest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionLifecycle", func() {
+	var (
+		lifecycle db.ResourceConfigVersionLifecycle
+		scenario  *dbtest.Scenario
+		getStep   *atc.GetStep
+
+		v1, v2, v3, v4, v5 atc.Version
+	)
+
+	remainingVersions := func() []atc.Version {
+		rows, err := dbConn.Query(`
+			SELECT version FROM resource_config_versions
+			WHERE resource_config_scope_id = $1
+			ORDER BY check_order ASC
+		`, scenario.Resource("some-resource").ResourceConfigScopeID())
+		Expect(err).ToNot(HaveOccurred())
+
+		defer rows.Close()
+
+		versions := []atc.Version{}
+		for rows.Next() {
+			var versionJSON string
+			Expect(rows.Scan(&versionJSON)).To(Succeed())
+
+			var version atc.Version
+			Expect(json.Unmarshal([]byte(versionJSON), &version)).To(Succeed())
+
+			versions = append(versions, version)
+		}
+
+		return versions
+	}
+
+	BeforeEach(func() {
+		lifecycle = db.NewResourceConfigVersionLifecycle(dbConn)
+
+		v1 = atc.Version{"v": "1"}
+		v2 = atc.Version{"v": "2"}
+		v3 = atc.Version{"v": "3"}
+		v4 = atc.Version{"v": "4"}
+		v5 = atc.Version{"v": "5"}
+
+		getStep = &atc.GetStep{
+			Name: "some-resource",
+		}
+	})
+
+	JustBeforeEach(func() {
+		scenario = dbtest.Setup(
+			builder.WithPipeline(atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-resource",
+						Type:   "some-base-resource-type",
+						Source: atc.Source{"some": "source"},
+					},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{Config: getStep},
+						},
+					},
+				},
+			}),
+			builder.WithResourceVersions("some-resource", v1, v2, v3, v4, v5),
+		)
+	})
+
+	Describe("PruneVersions", func() {
+		It("keeps the latest versions", func() {
+			pruned, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 2}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal(3))
+			Expect(remainingVersions()).To(Equal([]atc.Version{v4, v5}))
+		})
+
+		It("keeps the given pinned versions", func() {
+			pruned, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 2}, []atc.Version{v2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal(2))
+			Expect(remainingVersions()).To(Equal([]atc.Version{v2, v4, v5}))
+		})
+
+		Context("when a get step pins a version", func() {
+			BeforeEach(func() {
+				getStep.Version = &atc.VersionConfig{Pinned: v1}
+			})
+
+			It("keeps it", func() {
+				_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 1}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingVersions()).To(Equal([]atc.Version{v1, v5}))
+			})
+		})
+
+		Context("when a version is labelled", func() {
+			JustBeforeEach(func() {
+				rcv := scenario.ResourceVersion("some-resource", v1)
+
+				annotated, err := scenario.Resource("some-resource").AnnotateVersion(rcv.ID(), "some-label", "some-author")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(annotated).To(BeTrue())
+			})
+
+			It("keeps it along with its labels", func() {
+				_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 1}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingVersions()).To(Equal([]atc.Version{v1, v5}))
+
+				var labels int
+				err = dbConn.QueryRow(`SELECT COUNT(*) FROM resource_version_annotations`).Scan(&labels)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labels).To(Equal(1))
+			})
+		})
+
+		Context("when a build used a version", func() {
+			var build db.Build
+
+			JustBeforeEach(func() {
+				scenario.Run(
+					builder.WithJobBuild(&build, "some-job", dbtest.JobInputs{
+						{Name: "some-resource", Version: v2},
+					}, dbtest.JobOutputs{}),
+				)
+			})
+
+			It("keeps it", func() {
+				_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 1}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remainingVersions()).To(Equal([]atc.Version{v2, v5}))
+			})
+
+			Context("once the build's logs have been reaped", func() {
+				JustBeforeEach(func() {
+					_, err := dbConn.Exec(`UPDATE builds SET reap_time = now() WHERE id = $1`, build.ID())
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = dbConn.Exec(`DELETE FROM next_build_inputs`)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("prunes it", func() {
+					_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 1}, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(remainingVersions()).To(Equal([]atc.Version{v5}))
+				})
+			})
+		})
+
+		Context("when retaining versions by age", func() {
+			It("keeps versions newer than the retention", func() {
+				pruned, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Days: 7}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pruned).To(BeZero())
+				Expect(remainingVersions()).To(HaveLen(5))
+			})
+
+			Context("when the versions are older than the retention", func() {
+				JustBeforeEach(func() {
+					_, err := dbConn.Exec(`UPDATE resource_config_versions SET created_at = now() - interval '10 days'`)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("keeps only the latest version", func() {
+					_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Days: 7}, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(remainingVersions()).To(Equal([]atc.Version{v5}))
+				})
+
+				It("keeps the latest versions when combined with a count", func() {
+					_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 3, Days: 7}, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(remainingVersions()).To(Equal([]atc.Version{v3, v4, v5}))
+				})
+			})
+
+			Context("when combined with a count", func() {
+				It("keeps versions retained by either", func() {
+					_, err := lifecycle.PruneVersions(scenario.Resource("some-resource").ResourceConfigScopeID(), atc.VersionRetention{Versions: 1, Days: 7}, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(remainingVersions()).To(HaveLen(5))
+				})
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -13,6 +14,7 @@ type ResourceFactory interface {
 	Resource(int) (Resource, bool, error)
 	VisibleResources([]string) ([]Resource, error)
 	AllResources() ([]Resource, error)
+	ResourcesWithExpiredPins(now time.Time) ([]Resource, error)
 }
 
 type resourceFactory struct {
@@ -76,6 +78,20 @@ func (r *resourceFactory) AllResources() ([]Resource, error) {
 	return scanResources(rows, r.conn, r.lockFactory)
 }
 
+func (r *resourceFactory) ResourcesWithExpiredPins(now time.Time) ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"rp.config": false}).
+		Where(sq.LtOrEq{"rp.expires_at": now}).
+		OrderBy("r.id ASC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanResources(rows, r.conn, r.lockFactory)
+}
+
 func scanResources(resourceRows *sql.Rows, conn Conn, lockFactory lock.LockFactory) ([]Resource, error) {
 	var resources []Resource
 
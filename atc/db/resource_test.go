@@ -1063,7 +1063,7 @@ var _ = Describe("Resource", func() {
 			)
 
 			BeforeEach(func() {
-				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID())
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID(), "", time.Time{})
 				Expect(found).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
 
@@ -1072,7 +1072,7 @@ var _ = Describe("Resource", func() {
 			})
 
 			It("returns not found and does not update anything", func() {
-				found, err := scenario.Resource("some-resource").PinVersion(-1)
+				found, err := scenario.Resource("some-resource").PinVersion(-1, "", time.Time{})
 				Expect(found).To(BeFalse())
 				Expect(err).To(HaveOccurred())
 
@@ -1084,7 +1084,7 @@ var _ = Describe("Resource", func() {
 			It("requests schedule on all jobs using the resource", func() {
 				requestedSchedule := scenario.Job("job-using-resource").ScheduleRequestedTime()
 
-				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID())
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID(), "", time.Time{})
 				Expect(found).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
 
@@ -1094,7 +1094,7 @@ var _ = Describe("Resource", func() {
 			It("does not request schedule on jobs that do not use the resource", func() {
 				requestedSchedule := scenario.Job("not-using-resource").ScheduleRequestedTime()
 
-				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID())
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID(), "", time.Time{})
 				Expect(found).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
 
@@ -1104,7 +1104,7 @@ var _ = Describe("Resource", func() {
 
 		Context("when we pin a resource to a version", func() {
 			BeforeEach(func() {
-				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID())
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID(), "", time.Time{})
 				Expect(found).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
 			})
@@ -1118,7 +1118,7 @@ var _ = Describe("Resource", func() {
 
 			Context("when the resource is pinned by another version already", func() {
 				BeforeEach(func() {
-					found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v3"}).ID())
+					found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v3"}).ID(), "", time.Time{})
 					Expect(found).To(BeTrue())
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -1177,6 +1177,66 @@ var _ = Describe("Resource", func() {
 			})
 		})
 
+		Context("when we pin a resource with an expiry", func() {
+			var expiresAt time.Time
+
+			BeforeEach(func() {
+				expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID(), "some-user", expiresAt)
+				Expect(found).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records who pinned it and when it expires", func() {
+				Expect(scenario.Resource("some-resource").PinnedBy()).To(Equal("some-user"))
+				Expect(scenario.Resource("some-resource").PinExpiresAt()).To(BeTemporally("==", expiresAt))
+			})
+
+			It("clears the expiry when pinned again without one", func() {
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v2"}).ID(), "other-user", time.Time{})
+				Expect(found).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(scenario.Resource("some-resource").PinnedBy()).To(Equal("other-user"))
+				Expect(scenario.Resource("some-resource").PinExpiresAt()).To(BeZero())
+			})
+
+			Describe("UnpinExpiredVersion", func() {
+				It("does not unpin before the pin expires", func() {
+					unpinned, err := scenario.Resource("some-resource").UnpinExpiredVersion(expiresAt.Add(-time.Minute))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(unpinned).To(BeFalse())
+					Expect(scenario.Resource("some-resource").APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
+				})
+
+				It("unpins once the pin has expired", func() {
+					requestedSchedule := scenario.Job("job-using-resource").ScheduleRequestedTime()
+
+					unpinned, err := scenario.Resource("some-resource").UnpinExpiredVersion(expiresAt)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(unpinned).To(BeTrue())
+					Expect(scenario.Resource("some-resource").APIPinnedVersion()).To(BeNil())
+					Expect(scenario.Job("job-using-resource").ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
+				})
+			})
+
+			Describe("ResourcesWithExpiredPins", func() {
+				It("only returns the resource once its pin has expired", func() {
+					resourceFactory := db.NewResourceFactory(dbConn, lockFactory)
+
+					resources, err := resourceFactory.ResourcesWithExpiredPins(expiresAt.Add(-time.Minute))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resources).To(BeEmpty())
+
+					resources, err = resourceFactory.ResourcesWithExpiredPins(expiresAt)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resources).To(HaveLen(1))
+					Expect(resources[0].Name()).To(Equal("some-resource"))
+				})
+			})
+		})
+
 		Context("when we pin a resource that is already pinned to a version (through the config)", func() {
 			BeforeEach(func() {
 				scenario.Run(
@@ -1199,13 +1259,135 @@ var _ = Describe("Resource", func() {
 			})
 
 			It("should fail to update the pinned version", func() {
-				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID())
+				found, err := scenario.Resource("some-resource").PinVersion(scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID(), "", time.Time{})
 				Expect(found).To(BeFalse())
 				Expect(err).To(Equal(db.ErrPinnedThroughConfig))
 			})
 		})
 	})
 
+	Describe("AnnotateVersion/RemoveVersionAnnotation", func() {
+		var (
+			scenario *dbtest.Scenario
+			rcvID    int
+		)
+
+		BeforeEach(func() {
+			scenario = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-resource",
+							Type:   "some-base-resource-type",
+							Source: atc.Source{"some": "repository"},
+						},
+						{
+							Name:   "some-other-resource",
+							Type:   "some-base-resource-type",
+							Source: atc.Source{"some": "other-repository"},
+						},
+					},
+					Jobs: atc.JobConfigs{
+						{
+							Name: "job-using-resource",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name: "some-resource",
+									},
+								},
+							},
+						},
+					},
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"version": "v1"},
+					atc.Version{"version": "v2"},
+				),
+			)
+
+			rcvID = scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"}).ID()
+		})
+
+		versionAnnotations := func() map[string][]atc.VersionAnnotation {
+			versions, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			annotations := map[string][]atc.VersionAnnotation{}
+			for _, v := range versions {
+				annotations[v.Version["version"]] = v.Annotations
+			}
+
+			return annotations
+		}
+
+		It("lists the labels with the version", func() {
+			found, err := scenario.Resource("some-resource").AnnotateVersion(rcvID, "qa-approved", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			found, err = scenario.Resource("some-resource").AnnotateVersion(rcvID, "canary-ok", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			annotations := versionAnnotations()
+			Expect(annotations["v2"]).To(BeEmpty())
+			Expect(annotations["v1"]).To(HaveLen(2))
+			Expect(annotations["v1"][0].Label).To(Equal("canary-ok"))
+			Expect(annotations["v1"][0].Author).To(BeEmpty())
+			Expect(annotations["v1"][1].Label).To(Equal("qa-approved"))
+			Expect(annotations["v1"][1].Author).To(Equal("some-user"))
+			Expect(annotations["v1"][1].CreatedAt).To(BeNumerically("~", time.Now().Unix(), 60))
+		})
+
+		It("records the latest author when annotating a version again", func() {
+			_, err := scenario.Resource("some-resource").AnnotateVersion(rcvID, "qa-approved", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := scenario.Resource("some-resource").AnnotateVersion(rcvID, "qa-approved", "some-other-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			annotations := versionAnnotations()
+			Expect(annotations["v1"]).To(HaveLen(1))
+			Expect(annotations["v1"][0].Author).To(Equal("some-other-user"))
+		})
+
+		It("requests scheduling for jobs using the resource", func() {
+			requestedSchedule := scenario.Job("job-using-resource").ScheduleRequestedTime()
+
+			_, err := scenario.Resource("some-resource").AnnotateVersion(rcvID, "qa-approved", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scenario.Job("job-using-resource").ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
+		})
+
+		It("does not annotate versions of other resources", func() {
+			found, err := scenario.Resource("some-other-resource").AnnotateVersion(rcvID, "qa-approved", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			Expect(versionAnnotations()["v1"]).To(BeEmpty())
+		})
+
+		It("removes labels from the version", func() {
+			_, err := scenario.Resource("some-resource").AnnotateVersion(rcvID, "qa-approved", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := scenario.Resource("some-resource").RemoveVersionAnnotation(rcvID, "qa-approved")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(versionAnnotations()["v1"]).To(BeEmpty())
+
+			found, err = scenario.Resource("some-resource").RemoveVersionAnnotation(rcvID, "qa-approved")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
 	Describe("Public", func() {
 		var (
 			resource db.Resource
@@ -41,6 +41,8 @@ type ResourceType interface {
 	CheckTimeout() string
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
+	CheckFailures() int
+	LastNewVersionTime() time.Time
 	CurrentPinnedVersion() atc.Version
 	ResourceConfigScopeID() int
 
@@ -151,6 +153,8 @@ var resourceTypesQuery = psql.Select(
 	"ro.id",
 	"ro.last_check_start_time",
 	"ro.last_check_end_time",
+	"ro.consecutive_check_failures",
+	"ro.last_new_version_time",
 ).
 	From("resource_types r").
 	Join("pipelines p ON p.id = r.pipeline_id").
@@ -184,6 +188,8 @@ type resourceType struct {
 	checkEvery            *atc.CheckEvery
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
+	checkFailures         int
+	lastNewVersionTime    time.Time
 }
 
 func (t *resourceType) ID() int                       { return t.id }
@@ -196,6 +202,8 @@ func (t *resourceType) CheckEvery() *atc.CheckEvery   { return t.checkEvery }
 func (t *resourceType) CheckTimeout() string          { return "" }
 func (r *resourceType) LastCheckStartTime() time.Time { return r.lastCheckStartTime }
 func (r *resourceType) LastCheckEndTime() time.Time   { return r.lastCheckEndTime }
+func (r *resourceType) CheckFailures() int            { return r.checkFailures }
+func (r *resourceType) LastNewVersionTime() time.Time { return r.lastNewVersionTime }
 func (t *resourceType) Source() atc.Source            { return t.source }
 func (t *resourceType) Defaults() atc.Source          { return t.defaults }
 func (t *resourceType) Params() atc.Params            { return t.params }
@@ -364,16 +372,20 @@ func scanResourceType(t *resourceType, row scannable) error {
 		configJSON                           sql.NullString
 		rcsID, version, nonce                sql.NullString
 		lastCheckStartTime, lastCheckEndTime pq.NullTime
+		checkFailures                        sql.NullInt64
+		lastNewVersionTime                   pq.NullTime
 		pipelineInstanceVars                 sql.NullString
 	)
 
-	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &rcsID, &lastCheckStartTime, &lastCheckEndTime)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &rcsID, &lastCheckStartTime, &lastCheckEndTime, &checkFailures, &lastNewVersionTime)
 	if err != nil {
 		return err
 	}
 
 	t.lastCheckStartTime = lastCheckStartTime.Time
 	t.lastCheckEndTime = lastCheckEndTime.Time
+	t.checkFailures = int(checkFailures.Int64)
+	t.lastNewVersionTime = lastNewVersionTime.Time
 
 	if version.Valid {
 		err = json.Unmarshal([]byte(version.String), &t.version)
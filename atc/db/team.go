@@ -77,6 +77,13 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	Resources() ([]Resource, error)
+
+	Webhooks() ([]TeamWebhook, error)
+	Webhook(name string) (TeamWebhook, bool, error)
+	SetWebhook(webhook atc.TeamWebhook) (TeamWebhook, bool, error)
+	DeleteWebhook(name string) (bool, error)
 }
 
 type team struct {
@@ -560,9 +567,64 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = saveConfigRevision(tx, config, pipelineID)
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
+// maxConfigRevisions is the number of past configs kept for each pipeline.
+const maxConfigRevisions = 100
+
+func saveConfigRevision(tx Tx, config atc.Config, pipelineID int) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	var version ConfigVersion
+	err = psql.Select("version").
+		From("pipelines").
+		Where(sq.Eq{"id": pipelineID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_config_revisions").
+		Columns("pipeline_id", "version", "config", "nonce").
+		Values(pipelineID, version, encryptedPayload, nonce).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("pipeline_config_revisions").
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		Where(sq.Expr(`version < (
+			SELECT min(version) FROM (
+				SELECT version FROM pipeline_config_revisions
+				WHERE pipeline_id = ?
+				ORDER BY version DESC
+				LIMIT ?
+			) AS kept
+		)`, pipelineID, maxConfigRevisions)).
+		RunWith(tx).
+		Exec()
+
+	return err
+}
+
 func (t *team) SavePipeline(
 	pipelineRef atc.PipelineRef,
 	config atc.Config,
@@ -878,6 +940,156 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) Resources() ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"t.id": t.id}).
+		OrderBy("r.id ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanResources(rows, t.conn, t.lockFactory)
+}
+
+func (t *team) Webhooks() ([]TeamWebhook, error) {
+	rows, err := teamWebhooksQuery.
+		Where(sq.Eq{"w.team_id": t.id}).
+		OrderBy("w.name ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	webhooks := []TeamWebhook{}
+	for rows.Next() {
+		webhook := &teamWebhook{conn: t.conn}
+		err = scanTeamWebhook(webhook, rows, t.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (t *team) Webhook(name string) (TeamWebhook, bool, error) {
+	webhook := &teamWebhook{conn: t.conn}
+
+	row := teamWebhooksQuery.
+		Where(sq.Eq{
+			"w.team_id": t.id,
+			"w.name":    name,
+		}).
+		RunWith(t.conn).
+		QueryRow()
+
+	err := scanTeamWebhook(webhook, row, t.conn.EncryptionStrategy())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return webhook, true, nil
+}
+
+// SetWebhook creates or replaces the team's webhook with the given name,
+// returning whether it was created.
+func (t *team) SetWebhook(config atc.TeamWebhook) (TeamWebhook, bool, error) {
+	configPayload, err := json.Marshal(config)
+	if err != nil {
+		return nil, false, err
+	}
+
+	encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(configPayload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	var id int
+	err = psql.Select("id").
+		From("team_webhooks").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    config.Name,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, false, err
+	}
+
+	created := err == sql.ErrNoRows
+	if created {
+		err = psql.Insert("team_webhooks").
+			Columns("team_id", "name", "config", "nonce").
+			Values(t.id, config.Name, encryptedPayload, nonce).
+			Suffix("RETURNING id").
+			RunWith(tx).
+			QueryRow().
+			Scan(&id)
+	} else {
+		_, err = psql.Update("team_webhooks").
+			Set("config", encryptedPayload).
+			Set("nonce", nonce).
+			Where(sq.Eq{"id": id}).
+			RunWith(tx).
+			Exec()
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &teamWebhook{
+		id:     id,
+		teamID: t.id,
+		name:   config.Name,
+		config: config,
+		conn:   t.conn,
+	}, created, nil
+}
+
+func (t *team) DeleteWebhook(name string) (bool, error) {
+	result, err := psql.Delete("team_webhooks").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
@@ -1151,11 +1363,16 @@ func saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) (int, er
 		return 0, err
 	}
 
+	hasSchedule := len(job.Schedules) > 0
+
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags", "has_schedule", "schedule_evaluated_at").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups), hasSchedule, sq.Expr("CASE WHEN ?::boolean THEN now() END", hasSchedule)).
+		// a schedule is only evaluated from the time it was configured, so
+		// that adding one does not immediately trigger a build for every
+		// tick that has ever passed
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, has_schedule = EXCLUDED.has_schedule, schedule_evaluated_at = CASE WHEN EXCLUDED.has_schedule THEN COALESCE(jobs.schedule_evaluated_at, EXCLUDED.schedule_evaluated_at) END").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1574,7 +1791,31 @@ func insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID map[strin
 		return err
 	}
 
+	_, err = psql.Delete("job_dependencies").
+		Where(sq.Expr(`job_id in (
+        SELECT j.id
+        FROM jobs j
+        WHERE j.pipeline_id = $1
+      )`, pipelineID)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
 	for _, jobConfig := range jobConfigs {
+		for _, dependency := range jobConfig.DependsOn {
+			_, err := psql.Insert("job_dependencies").
+				Columns("job_id", "depends_on_job_id").
+				Values(jobNameToID[jobConfig.Name], jobNameToID[dependency]).
+				Suffix("ON CONFLICT DO NOTHING").
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return err
+			}
+		}
+
 		err := jobConfig.StepConfig().Visit(atc.StepRecursor{
 			OnGet: func(step *atc.GetStep) error {
 				return insertJobInput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID)
@@ -1593,6 +1834,26 @@ func insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID map[strin
 
 func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
 	if len(step.Passed) != 0 {
+		var passedStatuses interface{}
+		if len(step.PassedStatuses) != 0 {
+			statuses := make([]string, len(step.PassedStatuses))
+			for i, status := range step.PassedStatuses {
+				statuses[i] = string(status)
+			}
+
+			passedStatuses = pq.Array(statuses)
+		}
+
+		var passedMaxAge sql.NullString
+		if step.PassedMaxAge != "" {
+			maxAge, err := time.ParseDuration(step.PassedMaxAge)
+			if err != nil {
+				return err
+			}
+
+			passedMaxAge = sql.NullString{Valid: true, String: fmt.Sprintf("%d microseconds", maxAge.Microseconds())}
+		}
+
 		for _, passedJob := range step.Passed {
 			var version sql.NullString
 			if step.Version != nil {
@@ -1605,8 +1866,8 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 			}
 
 			_, err := psql.Insert("job_inputs").
-				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version").
-				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], jobNameToID[passedJob], step.Trigger, version).
+				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version", "passed_statuses", "passed_max_age").
+				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], jobNameToID[passedJob], step.Trigger, version, passedStatuses, passedMaxAge).
 				RunWith(tx).
 				Exec()
 			if err != nil {
@@ -917,6 +917,181 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("Resources", func() {
+		BeforeEach(func() {
+			config := atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-resource", Type: "some-type"},
+				},
+			}
+
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns only the team's resources", func() {
+			resources, err := team.Resources()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].Name()).To(Equal("some-resource"))
+			Expect(resources[0].TeamID()).To(Equal(team.ID()))
+		})
+	})
+
+	Describe("Webhooks", func() {
+		var config atc.TeamWebhook
+
+		BeforeEach(func() {
+			config = atc.TeamWebhook{
+				Name:          "some-webhook",
+				Type:          atc.WebhookTypeGitHub,
+				Secret:        "some-secret",
+				ResourceTypes: []string{"git"},
+			}
+		})
+
+		It("returns nothing when no webhooks are set", func() {
+			webhooks, err := team.Webhooks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhooks).To(BeEmpty())
+
+			_, found, err := team.Webhook("some-webhook")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when a webhook is set", func() {
+			var (
+				webhook db.TeamWebhook
+				created bool
+			)
+
+			BeforeEach(func() {
+				var err error
+				webhook, created, err = team.SetWebhook(config)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("is created", func() {
+				Expect(created).To(BeTrue())
+				Expect(webhook.Name()).To(Equal("some-webhook"))
+				Expect(webhook.TeamID()).To(Equal(team.ID()))
+			})
+
+			It("can be found with its config", func() {
+				found, ok, err := team.Webhook("some-webhook")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(found.ID()).To(Equal(webhook.ID()))
+				Expect(found.Config()).To(Equal(config))
+
+				webhooks, err := team.Webhooks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(webhooks).To(HaveLen(1))
+				Expect(webhooks[0].Config()).To(Equal(config))
+			})
+
+			It("is not visible to other teams", func() {
+				_, found, err := otherTeam.Webhook("some-webhook")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("is replaced when set again", func() {
+				config.Secret = "other-secret"
+
+				updated, created, err := team.SetWebhook(config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(updated.ID()).To(Equal(webhook.ID()))
+
+				found, _, err := team.Webhook("some-webhook")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found.Config().Secret).To(Equal("other-secret"))
+			})
+
+			It("can be deleted", func() {
+				deleted, err := team.DeleteWebhook("some-webhook")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+
+				_, found, err := team.Webhook("some-webhook")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				deleted, err = team.DeleteWebhook("some-webhook")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+			})
+
+			Describe("deliveries", func() {
+				It("saves them most recent first", func() {
+					err := webhook.SaveDelivery(atc.WebhookDelivery{
+						Event:      "ping",
+						StatusCode: 200,
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					err = webhook.SaveDelivery(atc.WebhookDelivery{
+						Event:      "push",
+						StatusCode: 200,
+						Values:     map[string]string{"repository.full_name": "some-org/some-repo"},
+						CheckedResources: []atc.WebhookDeliveryResource{
+							{PipelineName: "some-pipeline", Resource: "some-resource", BuildID: 42},
+						},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					deliveries, err := webhook.Deliveries(10)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deliveries).To(HaveLen(2))
+
+					Expect(deliveries[0].Webhook).To(Equal("some-webhook"))
+					Expect(deliveries[0].Event).To(Equal("push"))
+					Expect(deliveries[0].ReceivedAt).ToNot(BeZero())
+					Expect(deliveries[0].Values).To(Equal(map[string]string{"repository.full_name": "some-org/some-repo"}))
+					Expect(deliveries[0].CheckedResources).To(Equal([]atc.WebhookDeliveryResource{
+						{PipelineName: "some-pipeline", Resource: "some-resource", BuildID: 42},
+					}))
+
+					Expect(deliveries[1].Event).To(Equal("ping"))
+					Expect(deliveries[1].CheckedResources).To(BeEmpty())
+				})
+
+				It("keeps only the most recent deliveries", func() {
+					for i := 0; i < db.MaxWebhookDeliveries+5; i++ {
+						err := webhook.SaveDelivery(atc.WebhookDelivery{
+							StatusCode: 401,
+							Error:      fmt.Sprintf("delivery %d", i),
+						})
+						Expect(err).ToNot(HaveOccurred())
+					}
+
+					deliveries, err := webhook.Deliveries(db.MaxWebhookDeliveries * 2)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deliveries).To(HaveLen(db.MaxWebhookDeliveries))
+					Expect(deliveries[0].Error).To(Equal(fmt.Sprintf("delivery %d", db.MaxWebhookDeliveries+4)))
+				})
+
+				It("deletes them with the webhook", func() {
+					err := webhook.SaveDelivery(atc.WebhookDelivery{StatusCode: 200})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = team.DeleteWebhook("some-webhook")
+					Expect(err).ToNot(HaveOccurred())
+
+					var count int
+					err = dbConn.QueryRow("SELECT COUNT(*) FROM team_webhook_deliveries").Scan(&count)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(count).To(BeZero())
+				})
+			})
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
@@ -2143,6 +2318,30 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeFalse())
 		})
 
+		It("keeps a revision of each config that is saved", func() {
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			firstVersion := pipeline.ConfigVersion()
+
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, firstVersion, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			revision, found, err := savedPipeline.ConfigRevision(firstVersion)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(revision).To(Equal(config))
+
+			revision, found, err = savedPipeline.ConfigRevision(savedPipeline.ConfigVersion())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(revision).To(Equal(otherConfig))
+
+			_, found, err = savedPipeline.ConfigRevision(savedPipeline.ConfigVersion() + 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
 		Context("update job names but keeps history", func() {
 			BeforeEach(func() {
 				newJobConfig := atc.JobConfig{
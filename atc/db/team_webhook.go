@@ -0,0 +1,171 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/lib/pq"
+)
+
+// MaxWebhookDeliveries is how many deliveries are kept for each webhook.
+const MaxWebhookDeliveries = 100
+
+//go:generate counterfeiter . TeamWebhook
+
+type TeamWebhook interface {
+	ID() int
+	TeamID() int
+	Name() string
+	Config() atc.TeamWebhook
+
+	SaveDelivery(atc.WebhookDelivery) error
+	Deliveries(limit int) ([]atc.WebhookDelivery, error)
+}
+
+var teamWebhooksQuery = psql.Select(
+	"w.id",
+	"w.team_id",
+	"w.name",
+	"w.config",
+	"w.nonce",
+).
+	From("team_webhooks w")
+
+type teamWebhook struct {
+	id     int
+	teamID int
+	name   string
+	config atc.TeamWebhook
+
+	conn Conn
+}
+
+func (w *teamWebhook) ID() int                 { return w.id }
+func (w *teamWebhook) TeamID() int             { return w.teamID }
+func (w *teamWebhook) Name() string            { return w.name }
+func (w *teamWebhook) Config() atc.TeamWebhook { return w.config }
+
+func (w *teamWebhook) SaveDelivery(delivery atc.WebhookDelivery) error {
+	tx, err := w.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	values, err := json.Marshal(delivery.Values)
+	if err != nil {
+		return err
+	}
+
+	checkedResources, err := json.Marshal(delivery.CheckedResources)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_webhook_deliveries").
+		Columns("webhook_id", "event", "status_code", "error", `"values"`, "checked_resources").
+		Values(w.id, sql.NullString{String: delivery.Event, Valid: delivery.Event != ""}, delivery.StatusCode, sql.NullString{String: delivery.Error, Valid: delivery.Error != ""}, values, checkedResources).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("team_webhook_deliveries").
+		Where(sq.Eq{"webhook_id": w.id}).
+		Where(sq.Expr(`id NOT IN (
+			SELECT id FROM team_webhook_deliveries
+			WHERE webhook_id = ?
+			ORDER BY id DESC
+			LIMIT ?
+		)`, w.id, MaxWebhookDeliveries)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (w *teamWebhook) Deliveries(limit int) ([]atc.WebhookDelivery, error) {
+	rows, err := psql.Select("id", "received_at", "event", "status_code", "error", `"values"`, "checked_resources").
+		From("team_webhook_deliveries").
+		Where(sq.Eq{"webhook_id": w.id}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		RunWith(w.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.WebhookDelivery{}
+	for rows.Next() {
+		var (
+			delivery         atc.WebhookDelivery
+			receivedAt       pq.NullTime
+			event, errStr    sql.NullString
+			values           sql.NullString
+			checkedResources sql.NullString
+		)
+
+		err = rows.Scan(&delivery.ID, &receivedAt, &event, &delivery.StatusCode, &errStr, &values, &checkedResources)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.Webhook = w.name
+		delivery.ReceivedAt = receivedAt.Time.Unix()
+		delivery.Event = event.String
+		delivery.Error = errStr.String
+
+		if values.Valid {
+			err = json.Unmarshal([]byte(values.String), &delivery.Values)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if checkedResources.Valid {
+			err = json.Unmarshal([]byte(checkedResources.String), &delivery.CheckedResources)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func scanTeamWebhook(w *teamWebhook, row scannable, es encryption.Strategy) error {
+	var (
+		config string
+		nonce  sql.NullString
+	)
+
+	err := row.Scan(&w.id, &w.teamID, &w.name, &config, &nonce)
+	if err != nil {
+		return err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := es.Decrypt(config, noncense)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decryptedConfig, &w.config)
+}
@@ -11,7 +11,9 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/versionfilter"
 	"github.com/concourse/concourse/tracing"
+	"github.com/lib/pq"
 	gocache "github.com/patrickmn/go-cache"
 	"go.opentelemetry.io/otel/api/trace"
 	"go.opentelemetry.io/otel/label"
@@ -54,6 +56,48 @@ func (versions VersionsDB) IsFirstOccurrence(ctx context.Context, jobID int, inp
 	return !exists, nil
 }
 
+// LatestSucceededBuild returns the most recent succeeded build of the job.
+func (versions VersionsDB) LatestSucceededBuild(ctx context.Context, jobID int) (int, bool, error) {
+	var buildID int
+	err := versions.conn.QueryRowContext(ctx, `
+		SELECT id
+		FROM builds
+		WHERE job_id = $1
+		AND status = 'succeeded'
+		ORDER BY id DESC
+		LIMIT 1`, jobID).Scan(&buildID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return buildID, true, nil
+}
+
+// IsFirstDependencyOccurrence returns true if no build of the job has
+// followed on from the given build of the dependency, or a later one.
+func (versions VersionsDB) IsFirstDependencyOccurrence(ctx context.Context, jobID int, dependencyJobID int, buildID int) (bool, error) {
+	var exists bool
+	err := versions.conn.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM build_pipes p
+			JOIN builds b ON b.id = p.to_build_id
+			JOIN builds f ON f.id = p.from_build_id
+			WHERE b.job_id = $1
+			AND f.job_id = $2
+			AND f.id >= $3
+		)`, jobID, dependencyJobID, buildID).
+		Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return !exists, nil
+}
+
 func (versions VersionsDB) VersionIsDisabled(ctx context.Context, resourceID int, versionMD5 ResourceVersion) (bool, error) {
 	var exists bool
 	err := versions.conn.QueryRow(`
@@ -71,7 +115,36 @@ func (versions VersionsDB) VersionIsDisabled(ctx context.Context, resourceID int
 	return exists, nil
 }
 
-func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int) (ResourceVersion, bool, error) {
+// VersionMatchesFilter returns true if the version of the resource passes
+// the filter and has been annotated with all of the labels.
+func (versions VersionsDB) VersionMatchesFilter(ctx context.Context, resourceID int, versionMD5 ResourceVersion, filter versionfilter.Filter, labels []string) (bool, error) {
+	if filter.IsZero() && len(labels) == 0 {
+		return true, nil
+	}
+
+	var matches bool
+	err := psql.Select("1").
+		From("resource_config_versions rcv").
+		Join("resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id").
+		Where(sq.Eq{
+			"r.id":            resourceID,
+			"rcv.version_md5": versionMD5,
+		}).
+		Where(filter.Sqlizer("rcv.version", "rcv.metadata")).
+		Where(versionLabelsSqlizer("rcv.id", labels)).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		RunWith(versions.conn).
+		QueryRowContext(ctx).
+		Scan(&matches)
+	if err != nil {
+		return false, err
+	}
+
+	return matches, nil
+}
+
+func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int, filter versionfilter.Filter, labels []string) (ResourceVersion, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
 		return "", false, err
@@ -79,7 +152,7 @@ func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resource
 
 	defer tx.Rollback()
 
-	version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID)
+	version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID, filter, labels)
 	if err != nil {
 		return "", false, err
 	}
@@ -266,7 +339,7 @@ func (versions VersionsDB) FindVersionOfResource(ctx context.Context, resourceID
 	return version, true, err
 }
 
-func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int) (ResourceVersion, bool, bool, error) {
+func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int, filter versionfilter.Filter, labels []string) (ResourceVersion, bool, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
 		return "", false, false, err
@@ -297,7 +370,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		LIMIT 1;`, jobID, resourceID).Scan(&checkOrder)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID)
+			version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID, filter, labels)
 			if err != nil {
 				return "", false, false, err
 			}
@@ -322,6 +395,8 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		From("resource_config_versions rcv").
 		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
 		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM resource_disabled_versions WHERE resource_id = ? AND version_md5 = rcv.version_md5)", resourceID)).
+		Where(filter.Sqlizer("rcv.version", "rcv.metadata")).
+		Where(versionLabelsSqlizer("rcv.id", labels)).
 		Where(sq.Gt{"rcv.check_order": checkOrder}).
 		OrderBy("rcv.check_order ASC").
 		Limit(2).
@@ -356,6 +431,8 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		From("resource_config_versions rcv").
 		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
 		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM resource_disabled_versions WHERE resource_id = ? AND version_md5 = rcv.version_md5)", resourceID)).
+		Where(filter.Sqlizer("rcv.version", "rcv.metadata")).
+		Where(versionLabelsSqlizer("rcv.id", labels)).
 		Where(sq.LtOrEq{"rcv.check_order": checkOrder}).
 		OrderBy("rcv.check_order DESC").
 		Limit(1).
@@ -440,7 +517,7 @@ func (versions VersionsDB) LatestBuildUsingLatestVersion(ctx context.Context, jo
 }
 
 func (versions VersionsDB) UnusedBuilds(ctx context.Context, jobID int, lastUsedBuild BuildCursor) (PaginatedBuilds, error) {
-	builds, err := versions.newerBuilds(ctx, jobID, lastUsedBuild)
+	builds, err := versions.newerBuilds(ctx, jobID, lastUsedBuild, BuildFilter{})
 	if err != nil {
 		return PaginatedBuilds{}, err
 	}
@@ -473,7 +550,7 @@ func (versions VersionsDB) UnusedBuilds(ctx context.Context, jobID int, lastUsed
 }
 
 func (versions VersionsDB) UnusedBuildsVersionConstrained(ctx context.Context, jobID int, lastUsedBuild BuildCursor, constrainingCandidates map[string][]string) (PaginatedBuilds, error) {
-	builds, err := versions.newerBuilds(ctx, jobID, lastUsedBuild)
+	builds, err := versions.newerBuilds(ctx, jobID, lastUsedBuild, BuildFilter{})
 	if err != nil {
 		return PaginatedBuilds{}, err
 	}
@@ -509,14 +586,158 @@ func (versions VersionsDB) UnusedBuildsVersionConstrained(ctx context.Context, j
 
 }
 
-func (versions VersionsDB) newerBuilds(ctx context.Context, jobID int, lastUsedBuild BuildCursor) ([]BuildCursor, error) {
+// FilteredBuilds returns the builds of the job which match the filter,
+// newest first. If any constraining candidates are given, only builds which
+// used or produced all of them are returned.
+//
+// Unlike SuccessfulBuilds, this is not limited to succeeded builds, and so
+// does not make use of successful_build_outputs.
+func (versions VersionsDB) FilteredBuilds(ctx context.Context, jobID int, filter BuildFilter, constrainingCandidates map[string][]string) PaginatedBuilds {
+	builder := psql.Select("id", "rerun_of").
+		From("builds").
+		Where(sq.Eq{
+			"job_id": jobID,
+		}).
+		Where(filter.condition()).
+		Where(versionsUsedOrProduced(constrainingCandidates)).
+		OrderBy("COALESCE(rerun_of, id) DESC, id DESC")
+
+	return PaginatedBuilds{
+		builder: builder,
+		column:  "id",
+		jobID:   jobID,
+
+		limitRows: versions.limitRows,
+		conn:      versions.conn,
+	}
+}
+
+// UnusedFilteredBuilds is the equivalent of UnusedBuilds and
+// UnusedBuildsVersionConstrained for builds matching the filter.
+func (versions VersionsDB) UnusedFilteredBuilds(ctx context.Context, jobID int, lastUsedBuild BuildCursor, filter BuildFilter, constrainingCandidates map[string][]string) (PaginatedBuilds, error) {
+	builds, err := versions.newerBuilds(ctx, jobID, lastUsedBuild, filter)
+	if err != nil {
+		return PaginatedBuilds{}, err
+	}
+
+	builder := psql.Select("id", "rerun_of").
+		From("builds").
+		Where(sq.Eq{
+			"job_id": jobID,
+		}).
+		Where(filter.condition()).
+		Where(versionsUsedOrProduced(constrainingCandidates)).
+		Where(sq.Or{
+			sq.Eq{"id": lastUsedBuild.ID},
+			lastUsedBuild.OlderBuilds("id"),
+		}).
+		OrderBy("COALESCE(rerun_of, id) DESC, id DESC")
+
+	return PaginatedBuilds{
+		builder:      builder,
+		builds:       builds,
+		unusedBuilds: true,
+
+		column: "id",
+		jobID:  jobID,
+
+		limitRows: versions.limitRows,
+		conn:      versions.conn,
+	}, nil
+}
+
+// BuildOutputs returns the versions used or produced by a finished build,
+// regardless of its status.
+func (versions VersionsDB) BuildOutputs(ctx context.Context, buildID int) ([]AlgorithmVersion, error) {
+	cacheKey := fmt.Sprintf("b%d", buildID)
+
+	c, found := versions.cache.Get(cacheKey)
+	if found {
+		return c.([]AlgorithmVersion), nil
+	}
+
+	rows, err := versions.conn.QueryContext(ctx, `
+		SELECT resource_id, version_md5
+		FROM build_resource_config_version_outputs
+		WHERE build_id = $1
+		UNION
+		SELECT resource_id, version_md5
+		FROM build_resource_config_version_inputs
+		WHERE build_id = $1
+		ORDER BY resource_id, version_md5
+	`, buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	algorithmOutputs := []AlgorithmVersion{}
+	for rows.Next() {
+		var output AlgorithmVersion
+		err = rows.Scan(&output.ResourceID, &output.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		algorithmOutputs = append(algorithmOutputs, output)
+	}
+
+	versions.cache.Set(cacheKey, algorithmOutputs, time.Hour)
+
+	return algorithmOutputs, nil
+}
+
+// BuildMatchesFilter returns true if the build matches the filter.
+func (versions VersionsDB) BuildMatchesFilter(ctx context.Context, buildID int, filter BuildFilter) (bool, error) {
+	var matches bool
+	err := psql.Select("1").
+		From("builds").
+		Where(sq.Eq{"id": buildID}).
+		Where(filter.condition()).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		RunWith(versions.conn).
+		QueryRowContext(ctx).
+		Scan(&matches)
+	if err != nil {
+		return false, err
+	}
+
+	return matches, nil
+}
+
+// versionsUsedOrProduced matches builds which used or produced every one of
+// the given versions, keyed by resource ID.
+func versionsUsedOrProduced(versionsByResource map[string][]string) sq.And {
+	resourceIDs := []string{}
+	for resourceID := range versionsByResource {
+		resourceIDs = append(resourceIDs, resourceID)
+	}
+
+	sort.Strings(resourceIDs)
+
+	conditions := sq.And{}
+	for _, resourceID := range resourceIDs {
+		for _, version := range versionsByResource[resourceID] {
+			conditions = append(conditions, sq.Expr(`(
+				EXISTS (SELECT 1 FROM build_resource_config_version_outputs o WHERE o.build_id = builds.id AND o.resource_id = ? AND o.version_md5 = ?)
+				OR EXISTS (SELECT 1 FROM build_resource_config_version_inputs i WHERE i.build_id = builds.id AND i.resource_id = ? AND i.version_md5 = ?)
+			)`, resourceID, version, resourceID, version))
+		}
+	}
+
+	return conditions
+}
+
+func (versions VersionsDB) newerBuilds(ctx context.Context, jobID int, lastUsedBuild BuildCursor, filter BuildFilter) ([]BuildCursor, error) {
 	rows, err := psql.Select("id", "rerun_of").
 		From("builds").
 		Where(sq.And{
 			sq.Eq{
 				"job_id": jobID,
-				"status": "succeeded",
 			},
+			filter.condition(),
 			lastUsedBuild.NewerBuilds("id"),
 		}).
 		OrderBy("COALESCE(rerun_of, id) ASC, id ASC").
@@ -540,7 +761,7 @@ func (versions VersionsDB) newerBuilds(ctx context.Context, jobID int, lastUsedB
 	return builds, nil
 }
 
-func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, resourceID int) (ResourceVersion, bool, error) {
+func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, resourceID int, filter versionfilter.Filter, labels []string) (ResourceVersion, bool, error) {
 	var scopeID sql.NullInt64
 	err := psql.Select("resource_config_scope_id").
 		From("resources").
@@ -564,6 +785,8 @@ func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, r
 		From("resource_config_versions").
 		Where(sq.Eq{"resource_config_scope_id": scopeID}).
 		Where(sq.Expr("version_md5 NOT IN (SELECT version_md5 FROM resource_disabled_versions WHERE resource_id = ?)", resourceID)).
+		Where(filter.Sqlizer("version", "metadata")).
+		Where(versionLabelsSqlizer("id", labels)).
 		OrderBy("check_order DESC").
 		Limit(1).
 		RunWith(tx).
@@ -579,6 +802,25 @@ func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, r
 	return version, true, nil
 }
 
+// versionLabelsSqlizer matches the versions which have been annotated with
+// all of the labels.
+func versionLabelsSqlizer(idColumn string, labels []string) sq.Sqlizer {
+	if len(labels) == 0 {
+		return sq.Expr("true")
+	}
+
+	distinct := map[string]bool{}
+	for _, label := range labels {
+		distinct[label] = true
+	}
+
+	return sq.Expr(
+		"(SELECT COUNT(*) FROM resource_version_annotations a WHERE a.resource_config_version_id = "+idColumn+" AND a.label = ANY(?)) = ?",
+		pq.Array(labels),
+		len(distinct),
+	)
+}
+
 func (versions VersionsDB) migrateSingle(ctx context.Context, buildID int) (string, error) {
 	ctx, span := tracing.StartSpan(ctx, "VersionsDB.migrateSingle", tracing.Attrs{})
 	defer span.End()
@@ -3,6 +3,7 @@ package db_test
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -440,6 +441,112 @@ var _ = Describe("VersionsDB", func() {
 		})
 	})
 
+	Describe("FilteredBuilds", func() {
+		var (
+			filter          db.BuildFilter
+			paginatedBuilds db.PaginatedBuilds
+
+			succeededBuild db.Build
+			failedBuild    db.Build
+			erroredBuild   db.Build
+		)
+
+		BeforeEach(func() {
+			filter = db.BuildFilter{}
+
+			var err error
+			succeededBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(succeededBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			failedBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failedBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			erroredBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(erroredBuild.Finish(db.BuildStatusErrored)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			paginatedBuilds = vdb.FilteredBuilds(ctx, defaultJob.ID(), filter, nil)
+		})
+
+		collect := func() []int {
+			var buildIDs []int
+			for {
+				buildID, ok, err := paginatedBuilds.Next(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				if !ok {
+					return buildIDs
+				}
+
+				buildIDs = append(buildIDs, buildID)
+			}
+		}
+
+		Context("with the default filter", func() {
+			It("returns only succeeded builds", func() {
+				Expect(collect()).To(Equal([]int{succeededBuild.ID()}))
+			})
+		})
+
+		Context("when filtering by status", func() {
+			BeforeEach(func() {
+				filter.Statuses = []db.BuildStatus{db.BuildStatusFailed, db.BuildStatusErrored}
+			})
+
+			It("returns the builds with any of the statuses, newest first", func() {
+				Expect(collect()).To(Equal([]int{erroredBuild.ID(), failedBuild.ID()}))
+			})
+		})
+
+		Context("when filtering by age", func() {
+			BeforeEach(func() {
+				filter.Statuses = []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed}
+				filter.MaxAge = time.Hour
+
+				_, err := dbConn.Exec("UPDATE builds SET end_time = now() - interval '2 hours' WHERE id = $1", failedBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("skips builds that finished too long ago", func() {
+				Expect(collect()).To(Equal([]int{succeededBuild.ID()}))
+			})
+		})
+	})
+
+	Describe("BuildMatchesFilter", func() {
+		var failedBuild db.Build
+
+		BeforeEach(func() {
+			var err error
+			failedBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failedBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+		})
+
+		It("returns whether the build matches", func() {
+			matches, err := vdb.BuildMatchesFilter(ctx, failedBuild.ID(), db.BuildFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(BeFalse())
+
+			matches, err = vdb.BuildMatchesFilter(ctx, failedBuild.ID(), db.BuildFilter{
+				Statuses: []db.BuildStatus{db.BuildStatusFailed},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(BeTrue())
+
+			matches, err = vdb.BuildMatchesFilter(ctx, failedBuild.ID(), db.BuildFilter{
+				Statuses: []db.BuildStatus{db.BuildStatusFailed},
+				MaxAge:   time.Hour,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(BeTrue())
+		})
+	})
+
 	Describe("UnusedBuilds", func() {
 		var lastUsedBuild db.BuildCursor
 		var paginatedBuilds db.PaginatedBuilds
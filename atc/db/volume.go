@@ -148,6 +148,8 @@ type CreatedVolume interface {
 	TeamID() int
 	WorkerArtifactID() int
 	CreateChildForContainer(CreatingContainer, string) (CreatingVolume, error)
+	CreateChildForResourceCache(UsedResourceCache) (CreatingVolume, error)
+	CreateChildForArtifact() (CreatingVolume, error)
 	Destroying() (DestroyingVolume, error)
 	WorkerName() string
 
@@ -584,6 +586,120 @@ func (volume *createdVolume) CreateChildForContainer(container CreatingContainer
 	}, nil
 }
 
+// CreateChildForResourceCache creates a copy-on-write child of the volume and
+// assigns it to the given resource cache on the same worker. This is used for
+// sharing the bits of resource caches which have the same content digest.
+func (volume *createdVolume) CreateChildForResourceCache(resourceCache UsedResourceCache) (CreatingVolume, error) {
+	tx, err := volume.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	workerResourceCache, err := WorkerResourceCache{
+		WorkerName:    volume.workerName,
+		ResourceCache: resourceCache,
+	}.FindOrCreate(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	var volumeID int
+	err = psql.Insert("volumes").
+		Columns(
+			"worker_name",
+			"parent_id",
+			"parent_state",
+			"handle",
+			"worker_resource_cache_id",
+		).
+		Values(
+			volume.workerName,
+			volume.id,
+			VolumeStateCreated,
+			handle.String(),
+			workerResourceCache.ID,
+		).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &creatingVolume{
+		id:              volumeID,
+		workerName:      volume.workerName,
+		handle:          handle.String(),
+		typ:             VolumeTypeResource,
+		resourceCacheID: resourceCache.ID(),
+		parentHandle:    volume.Handle(),
+		conn:            volume.conn,
+	}, nil
+}
+
+// CreateChildForArtifact creates a copy-on-write child of the volume for use
+// as an artifact, e.g. when patching an existing artifact. The parent is kept
+// around for as long as the child exists.
+func (volume *createdVolume) CreateChildForArtifact() (CreatingVolume, error) {
+	handle, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	columnNames := []string{
+		"worker_name",
+		"parent_id",
+		"parent_state",
+		"handle",
+	}
+	columnValues := []interface{}{
+		volume.workerName,
+		volume.id,
+		VolumeStateCreated,
+		handle.String(),
+	}
+
+	if volume.teamID != 0 {
+		columnNames = append(columnNames, "team_id")
+		columnValues = append(columnValues, volume.teamID)
+	}
+
+	var volumeID int
+	err = psql.Insert("volumes").
+		Columns(columnNames...).
+		Values(columnValues...).
+		Suffix("RETURNING id").
+		RunWith(volume.conn).
+		QueryRow().
+		Scan(&volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &creatingVolume{
+		id:           volumeID,
+		workerName:   volume.workerName,
+		handle:       handle.String(),
+		teamID:       volume.teamID,
+		typ:          VolumeTypeArtifact,
+		parentHandle: volume.Handle(),
+		conn:         volume.conn,
+	}, nil
+}
+
 func (volume *createdVolume) Destroying() (DestroyingVolume, error) {
 	err := volumeStateTransition(
 		volume.id,
@@ -21,6 +21,8 @@ type VolumeRepository interface {
 	CreateBaseResourceTypeVolume(*UsedWorkerBaseResourceType) (CreatingVolume, error)
 
 	FindResourceCacheVolume(workerName string, resourceCache UsedResourceCache) (CreatedVolume, bool, error)
+	FindResourceCacheVolumeByDigest(workerName string, resourceCache UsedResourceCache) (CreatedVolume, bool, error)
+	FindCreatingOrCreatedResourceCacheVolume(workerName string, resourceCache UsedResourceCache) (CreatingVolume, CreatedVolume, error)
 
 	FindTaskCacheVolume(teamID int, workerName string, taskCache UsedTaskCache) (CreatedVolume, bool, error)
 	CreateTaskCacheVolume(teamID int, uwtc *UsedWorkerTaskCache) (CreatingVolume, error)
@@ -452,6 +454,86 @@ func (repository *volumeRepository) FindResourceCacheVolume(workerName string, r
 	return createdVolume, true, nil
 }
 
+// FindCreatingOrCreatedResourceCacheVolume is like FindResourceCacheVolume,
+// but also returns the volume while it is still being created.
+func (repository *volumeRepository) FindCreatingOrCreatedResourceCacheVolume(workerName string, resourceCache UsedResourceCache) (CreatingVolume, CreatedVolume, error) {
+	workerResourceCache, found, err := WorkerResourceCache{
+		WorkerName:    workerName,
+		ResourceCache: resourceCache,
+	}.Find(repository.conn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !found {
+		return nil, nil, nil
+	}
+
+	return repository.findVolume(0, workerName, map[string]interface{}{
+		"v.worker_resource_cache_id": workerResourceCache.ID,
+	})
+}
+
+// FindResourceCacheVolumeByDigest finds a volume on the worker belonging to a
+// different resource cache whose version has the same content digest (e.g.
+// the same image pulled through resource configs with different credentials).
+//
+// The bits are only shared if the resource config of the given cache has
+// itself discovered the version through a check, so that access to the content
+// is still governed by the credentials of each resource config.
+func (repository *volumeRepository) FindResourceCacheVolumeByDigest(workerName string, resourceCache UsedResourceCache) (CreatedVolume, bool, error) {
+	digest, found := resourceCache.Version()[ContentDigestVersionField]
+	if !found || digest == "" {
+		return nil, false, nil
+	}
+
+	row := psql.Select(volumeColumns...).
+		From("volumes v").
+		LeftJoin("workers w ON v.worker_name = w.name").
+		LeftJoin("containers c ON v.container_id = c.id").
+		LeftJoin("volumes pv ON v.parent_id = pv.id").
+		Join("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Join("resource_caches rc ON rc.id = wrc.resource_cache_id").
+		Join("resource_configs rcfg ON rcfg.id = rc.resource_config_id").
+		Join("resource_caches want ON want.id = ?", resourceCache.ID()).
+		Join("resource_configs wantcfg ON wantcfg.id = want.resource_config_id").
+		Where(sq.Eq{
+			"v.worker_name": workerName,
+			"v.state":       VolumeStateCreated,
+		}).
+		Where(sq.NotEq{
+			"rc.id": resourceCache.ID(),
+		}).
+		Where(sq.Expr("rc.version->>'"+ContentDigestVersionField+"' = ?", digest)).
+		Where(sq.Expr("rc.params_hash = want.params_hash")).
+		Where(sq.Expr("rcfg.base_resource_type_id = wantcfg.base_resource_type_id")).
+		Where(sq.Expr(`EXISTS (
+			SELECT 1
+			FROM resource_config_versions rcv
+			JOIN resource_config_scopes rcs ON rcs.id = rcv.resource_config_scope_id
+			WHERE rcs.resource_config_id = want.resource_config_id
+			AND rcv.version_md5 = want.version_md5
+		)`)).
+		OrderBy("v.id ASC").
+		Limit(1).
+		RunWith(repository.conn).
+		QueryRow()
+
+	_, createdVolume, _, _, err := scanVolume(row, repository.conn)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if createdVolume == nil {
+		return nil, false, nil
+	}
+
+	return createdVolume, true, nil
+}
+
 func (repository *volumeRepository) FindCreatedVolume(handle string) (CreatedVolume, bool, error) {
 	_, createdVolume, err := getVolume(repository.conn, map[string]interface{}{
 		"v.handle": handle,
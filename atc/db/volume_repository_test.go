@@ -540,6 +540,191 @@ var _ = Describe("VolumeRepository", func() {
 		})
 	})
 
+	Describe("FindCreatingOrCreatedResourceCacheVolume", func() {
+		var (
+			usedResourceCache db.UsedResourceCache
+			parentVolume      db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			build, err := defaultPipeline.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			usedResourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				atc.Version{"some": "version"},
+				atc.Source{"some": "source"},
+				atc.Params{},
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{
+				Type:     "get",
+				StepName: "some-resource",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingParent, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-path")
+			Expect(err).NotTo(HaveOccurred())
+
+			parentVolume, err = creatingParent.Created()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns nothing when there is no volume for the resource cache", func() {
+			creatingVolume, createdVolume, err := volumeRepository.FindCreatingOrCreatedResourceCacheVolume(defaultWorker.Name(), usedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(creatingVolume).To(BeNil())
+			Expect(createdVolume).To(BeNil())
+		})
+
+		Context("when a child volume is being created for the resource cache", func() {
+			var childVolume db.CreatingVolume
+
+			BeforeEach(func() {
+				var err error
+				childVolume, err = parentVolume.CreateChildForResourceCache(usedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the creating volume", func() {
+				creatingVolume, createdVolume, err := volumeRepository.FindCreatingOrCreatedResourceCacheVolume(defaultWorker.Name(), usedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(creatingVolume).NotTo(BeNil())
+				Expect(creatingVolume.Handle()).To(Equal(childVolume.Handle()))
+				Expect(createdVolume).To(BeNil())
+			})
+
+			It("returns the created volume once it is created", func() {
+				_, err := childVolume.Created()
+				Expect(err).NotTo(HaveOccurred())
+
+				creatingVolume, createdVolume, err := volumeRepository.FindCreatingOrCreatedResourceCacheVolume(defaultWorker.Name(), usedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(creatingVolume).To(BeNil())
+				Expect(createdVolume).NotTo(BeNil())
+				Expect(createdVolume.Handle()).To(Equal(childVolume.Handle()))
+			})
+		})
+	})
+
+	Describe("FindResourceCacheVolumeByDigest", func() {
+		var (
+			sharedResourceCache db.UsedResourceCache
+			wantedResourceCache db.UsedResourceCache
+			existingVolume      db.CreatedVolume
+			wantedVersion       atc.Version
+		)
+
+		BeforeEach(func() {
+			build, err := defaultPipeline.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			sharedResourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				atc.Version{"digest": "sha256:some-digest", "tag": "latest"},
+				atc.Source{"repository": "some-image", "username": "some-user"},
+				atc.Params{},
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			wantedVersion = atc.Version{"digest": "sha256:some-digest"}
+
+			wantedResourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				wantedVersion,
+				atc.Source{"repository": "some-image", "username": "other-user"},
+				atc.Params{},
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{
+				Type:     "get",
+				StepName: "some-resource",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			resourceCacheVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-path")
+			Expect(err).NotTo(HaveOccurred())
+
+			existingVolume, err = resourceCacheVolume.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = existingVolume.InitializeResourceCache(sharedResourceCache)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the wanted resource config has checked the version", func() {
+			BeforeEach(func() {
+				scope, err := wantedResourceCache.ResourceConfig().FindOrCreateScope(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = scope.SaveVersions(nil, []atc.Version{wantedVersion})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the volume of the cache with the same digest", func() {
+				createdVolume, found, err := volumeRepository.FindResourceCacheVolumeByDigest(defaultWorker.Name(), wantedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(createdVolume.Handle()).To(Equal(existingVolume.Handle()))
+			})
+
+			It("does not return a volume on a different worker", func() {
+				_, found, err := volumeRepository.FindResourceCacheVolumeByDigest(otherWorker.Name(), wantedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when a child volume is created for the wanted cache", func() {
+				var childVolume db.CreatedVolume
+
+				BeforeEach(func() {
+					creatingVolume, err := existingVolume.CreateChildForResourceCache(wantedResourceCache)
+					Expect(err).NotTo(HaveOccurred())
+
+					childVolume, err = creatingVolume.Created()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("is found as the volume for the wanted cache", func() {
+					createdVolume, found, err := volumeRepository.FindResourceCacheVolume(defaultWorker.Name(), wantedResourceCache)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(createdVolume.Handle()).To(Equal(childVolume.Handle()))
+					Expect(createdVolume.ParentHandle()).To(Equal(existingVolume.Handle()))
+				})
+
+				It("prevents the shared volume from being destroyed", func() {
+					_, err := existingVolume.Destroying()
+					Expect(err).To(Equal(db.ErrVolumeCannotBeDestroyedWithChildrenPresent))
+				})
+			})
+		})
+
+		Context("when the wanted resource config has not checked the version", func() {
+			It("does not share the volume", func() {
+				_, found, err := volumeRepository.FindResourceCacheVolumeByDigest(defaultWorker.Name(), wantedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the version has no digest", func() {
+			It("does not share the volume", func() {
+				_, found, err := volumeRepository.FindResourceCacheVolumeByDigest(defaultWorker.Name(), usedResourceCache)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("RemoveDestroyingVolumes", func() {
 		var failedErr error
 		var numDeleted int
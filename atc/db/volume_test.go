@@ -408,6 +408,43 @@ var _ = Describe("Volume", func() {
 			_, err = createdParentVolume.Destroying()
 			Expect(err).To(Equal(db.ErrVolumeCannotBeDestroyedWithChildrenPresent))
 		})
+
+		Context("when the child is an artifact", func() {
+			var createdParentVolume db.CreatedVolume
+			var childVolume db.CreatedVolume
+
+			BeforeEach(func() {
+				creatingParentVolume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+				Expect(err).ToNot(HaveOccurred())
+				createdParentVolume, err = creatingParentVolume.Created()
+				Expect(err).ToNot(HaveOccurred())
+
+				childCreatingVolume, err := createdParentVolume.CreateChildForArtifact()
+				Expect(err).ToNot(HaveOccurred())
+				childVolume, err = childCreatingVolume.Created()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns parent handle and team", func() {
+				Expect(childVolume.ParentHandle()).To(Equal(createdParentVolume.Handle()))
+				Expect(childVolume.TeamID()).To(Equal(defaultTeam.ID()))
+				Expect(childVolume.WorkerName()).To(Equal(defaultWorker.Name()))
+			})
+
+			It("prevents the parent from being collected", func() {
+				orphanedVolumes, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).ToNot(HaveOccurred())
+
+				var orphanedHandles []string
+				for _, v := range orphanedVolumes {
+					orphanedHandles = append(orphanedHandles, v.Handle())
+				}
+				Expect(orphanedHandles).To(ContainElement(createdParentVolume.Handle()))
+
+				_, err = createdParentVolume.Destroying()
+				Expect(err).To(Equal(db.ErrVolumeCannotBeDestroyedWithChildrenPresent))
+			})
+		})
 	})
 
 	Describe("Resource cache volumes", func() {
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -61,6 +62,8 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	ResourcePressure() *atc.WorkerResourcePressure
+	StalledReason() string
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -71,12 +74,18 @@ type Worker interface {
 	Ephemeral() bool
 
 	Reload() (bool, error)
+	CurrentState() (WorkerState, bool, error)
 
 	Land() error
 	Retire() error
 	Prune() error
 	Delete() error
 
+	DiagnosticsRequested() bool
+	RequestDiagnostics() error
+	SaveDiagnostics(atc.WorkerDiagnostics) error
+	Diagnostics() (atc.WorkerDiagnostics, bool, error)
+
 	ActiveTasks() (int, error)
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
@@ -99,6 +108,8 @@ type worker struct {
 	activeContainers int
 	activeVolumes    int
 	activeTasks      int
+	resourcePressure *atc.WorkerResourcePressure
+	stalledReason    string
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
@@ -108,6 +119,8 @@ type worker struct {
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+
+	diagnosticsRequested bool
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -129,6 +142,18 @@ func (worker *worker) TeamID() int                             { return worker.t
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
 
+func (worker *worker) ResourcePressure() *atc.WorkerResourcePressure {
+	return worker.resourcePressure
+}
+
+// StalledReason is the health check failure reported by a worker which landed
+// itself, or empty if the worker is healthy.
+func (worker *worker) StalledReason() string { return worker.stalledReason }
+
+// DiagnosticsRequested is true if diagnostics have been requested since the
+// worker last reported them.
+func (worker *worker) DiagnosticsRequested() bool { return worker.diagnosticsRequested }
+
 func (worker *worker) StartTime() time.Time { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
 
@@ -148,6 +173,26 @@ func (worker *worker) Reload() (bool, error) {
 	return true, nil
 }
 
+// CurrentState looks up the worker's state without reloading the rest of the
+// worker, returning false if the worker has gone away.
+func (worker *worker) CurrentState() (WorkerState, bool, error) {
+	var state WorkerState
+	err := psql.Select("state").
+		From("workers").
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&state)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	return state, true, nil
+}
+
 func (worker *worker) Land() error {
 	cSQL, _, err := sq.Case("state").
 		When("'landed'::worker_state", "'landed'::worker_state").
@@ -157,8 +202,11 @@ func (worker *worker) Land() error {
 		return err
 	}
 
+	// the landing is no longer caused by a stall, so that the worker is not
+	// switched back to running once it becomes healthy again
 	result, err := psql.Update("workers").
 		Set("state", sq.Expr("("+cSQL+")")).
+		Set("stall_landed", false).
 		Where(sq.Eq{"name": worker.name}).
 		RunWith(worker.conn).
 		Exec()
@@ -263,6 +311,84 @@ func (worker *worker) Delete() error {
 	return err
 }
 
+func (worker *worker) RequestDiagnostics() error {
+	result, err := psql.Update("workers").
+		Set("diagnostics_requested_at", sq.Expr("now()")).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	return nil
+}
+
+func (worker *worker) SaveDiagnostics(diagnostics atc.WorkerDiagnostics) error {
+	payload, err := json.Marshal(diagnostics)
+	if err != nil {
+		return err
+	}
+
+	result, err := psql.Update("workers").
+		Set("diagnostics", payload).
+		Set("diagnostics_collected_at", sq.Expr("now()")).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	return nil
+}
+
+func (worker *worker) Diagnostics() (atc.WorkerDiagnostics, bool, error) {
+	var payload []byte
+	err := psql.Select("diagnostics").
+		From("workers").
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.WorkerDiagnostics{}, false, nil
+		}
+		return atc.WorkerDiagnostics{}, false, err
+	}
+
+	if payload == nil {
+		return atc.WorkerDiagnostics{}, false, nil
+	}
+
+	var diagnostics atc.WorkerDiagnostics
+	err = json.Unmarshal(payload, &diagnostics)
+	if err != nil {
+		return atc.WorkerDiagnostics{}, false, err
+	}
+
+	return diagnostics, true, nil
+}
+
 func (worker *worker) ResourceCerts() (*UsedWorkerResourceCerts, bool, error) {
 	if worker.certsPath != nil {
 		wrc := &WorkerResourceCerts{
@@ -47,6 +47,8 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.resource_pressure,
+		w.stalled_reason,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -54,7 +56,8 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.diagnostics_requested_at IS NOT NULL AND (w.diagnostics_collected_at IS NULL OR w.diagnostics_collected_at < w.diagnostics_requested_at)
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -132,6 +135,8 @@ func scanWorker(worker *worker, row scannable) error {
 		httpProxyURL  sql.NullString
 		httpsProxyURL sql.NullString
 		noProxy       sql.NullString
+		pressure      []byte
+		stalledReason sql.NullString
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
@@ -154,6 +159,8 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&pressure,
+		&stalledReason,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -162,6 +169,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&worker.diagnosticsRequested,
 	)
 	if err != nil {
 		return err
@@ -215,6 +223,17 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	if stalledReason.Valid {
+		worker.stalledReason = stalledReason.String
+	}
+
+	if pressure != nil {
+		err = json.Unmarshal(pressure, &worker.resourcePressure)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -241,13 +260,37 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
 	}
 
-	cSQL, _, err := sq.Case("state").
-		When("'landing'::worker_state", "'landing'::worker_state").
-		When("'landed'::worker_state", "'landed'::worker_state").
-		When("'retiring'::worker_state", "'retiring'::worker_state").
-		Else("'running'::worker_state").
-		ToSql()
+	var stateCase sq.CaseBuilder
+	var stallLanded interface{} = false
+	if atcWorker.StalledReason != "" {
+		// the worker is failing its own health checks; land it so that no new
+		// work is scheduled on it, but keep it around so it can recover. a
+		// landing started by an operator is not marked as caused by the stall,
+		// so that it isn't cancelled once the worker recovers.
+		stateCase = sq.Case("state").
+			When("'landed'::worker_state", "'landed'::worker_state").
+			When("'retiring'::worker_state", "'retiring'::worker_state").
+			Else("'landing'::worker_state")
 
+		stallLanded = sq.Expr("(state IN ('running'::worker_state, 'stalled'::worker_state) OR stall_landed)")
+	} else {
+		// a worker which was landed because it was unhealthy goes back to
+		// running once it recovers; one which was landed by an operator stays
+		// landing
+		stateCase = sq.Case().
+			When("state = 'landing'::worker_state AND stall_landed", "'running'::worker_state").
+			When("state = 'landing'::worker_state", "'landing'::worker_state").
+			When("state = 'landed'::worker_state", "'landed'::worker_state").
+			When("state = 'retiring'::worker_state", "'retiring'::worker_state").
+			Else("'running'::worker_state")
+	}
+
+	cSQL, _, err := stateCase.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	pressure, err := marshalResourcePressure(atcWorker.ResourcePressure)
 	if err != nil {
 		return nil, err
 	}
@@ -256,7 +299,10 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("resource_pressure", pressure).
 		Set("state", sq.Expr("("+cSQL+")")).
+		Set("stalled_reason", stalledReason(atcWorker.StalledReason)).
+		Set("stall_landed", stallLanded).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
 		Exec()
@@ -375,6 +421,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	pressure, err := marshalResourcePressure(atcWorker.ResourcePressure)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -383,8 +434,12 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 	startTime := fmt.Sprintf(`to_timestamp(%d)`, atcWorker.StartTime)
 
 	var workerState WorkerState
+	var stallLanded bool
 	if atcWorker.State != "" {
 		workerState = WorkerState(atcWorker.State)
+	} else if atcWorker.StalledReason != "" {
+		workerState = WorkerStateLanding
+		stallLanded = true
 	} else {
 		workerState = WorkerStateRunning
 	}
@@ -398,6 +453,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		pressure,
+		stalledReason(atcWorker.StalledReason),
+		stallLanded,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
@@ -429,6 +487,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"resource_pressure",
+			"stalled_reason",
+			"stall_landed",
 			"resource_types",
 			"tags",
 			"platform",
@@ -454,6 +515,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				resource_pressure = ?,
+				stalled_reason = ?,
+				stall_landed = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
@@ -502,6 +566,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		noProxy:          atcWorker.NoProxy,
 		activeContainers: atcWorker.ActiveContainers,
 		activeVolumes:    atcWorker.ActiveVolumes,
+		resourcePressure: atcWorker.ResourcePressure,
+		stalledReason:    atcWorker.StalledReason,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
@@ -557,3 +623,23 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 
 	return savedWorker, nil
 }
+
+// marshalResourcePressure returns nil rather than a JSON null so that workers
+// which do not report resource pressure end up with a NULL column.
+func marshalResourcePressure(pressure *atc.WorkerResourcePressure) (interface{}, error) {
+	if pressure == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(pressure)
+}
+
+// stalledReason returns nil for an empty reason so that healthy workers end up
+// with a NULL column.
+func stalledReason(reason string) interface{} {
+	if reason == "" {
+		return nil
+	}
+
+	return reason
+}
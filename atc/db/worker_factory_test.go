@@ -436,6 +436,26 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
 
+			It("updates the resource pressure reported by the worker", func() {
+				atcWorker.ResourcePressure = &atc.WorkerResourcePressure{
+					CPULoad:        0.5,
+					MemoryPressure: 0.25,
+					FreeDiskBytes:  1024,
+					OpenFiles:      42,
+				}
+
+				foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(foundWorker.ResourcePressure()).To(Equal(atcWorker.ResourcePressure))
+
+				By("clearing it when the worker stops reporting it")
+				atcWorker.ResourcePressure = nil
+
+				foundWorker, err = workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(foundWorker.ResourcePressure()).To(BeNil())
+			})
+
 			Context("when the current state is landing", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(db.WorkerStateLanding)
@@ -447,6 +467,110 @@ var _ = Describe("WorkerFactory", func() {
 
 					Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
 				})
+
+				Context("when the worker recovers after landing itself", func() {
+					JustBeforeEach(func() {
+						atcWorker.StalledReason = "garden: connection refused"
+
+						_, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						atcWorker.StalledReason = ""
+					})
+
+					It("sets the state as running", func() {
+						foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
+						Expect(foundWorker.StalledReason()).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("when the worker reports a stalled reason", func() {
+				BeforeEach(func() {
+					atcWorker.State = string(db.WorkerStateRunning)
+					atcWorker.StalledReason = "garden: connection refused"
+				})
+
+				It("lands the worker and records the reason", func() {
+					foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
+					Expect(foundWorker.StalledReason()).To(Equal("garden: connection refused"))
+				})
+
+				Context("when the worker recovers", func() {
+					It("sets the state back to running", func() {
+						_, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						atcWorker.StalledReason = ""
+
+						foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
+						Expect(foundWorker.StalledReason()).To(BeEmpty())
+					})
+				})
+
+				Context("when the worker is landed by an operator while unhealthy", func() {
+					BeforeEach(func() {
+						foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						err = foundWorker.Land()
+						Expect(err).NotTo(HaveOccurred())
+
+						foundWorker, err = workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
+						Expect(foundWorker.StalledReason()).To(Equal("garden: connection refused"))
+					})
+
+					It("keeps the state as landing once it recovers", func() {
+						atcWorker.StalledReason = ""
+
+						foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
+					})
+
+					It("lands the worker once it has finished its work", func() {
+						landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(landedWorkers).To(ConsistOf(atcWorker.Name))
+					})
+				})
+
+				Context("when an operator lands the worker before it stalls", func() {
+					It("keeps the state as landing once it recovers", func() {
+						atcWorker.StalledReason = ""
+
+						foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						err = foundWorker.Land()
+						Expect(err).NotTo(HaveOccurred())
+
+						atcWorker.StalledReason = "garden: connection refused"
+
+						_, err = workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						atcWorker.StalledReason = ""
+
+						foundWorker, err = workerFactory.HeartbeatWorker(atcWorker, ttl)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
+					})
+				})
 			})
 
 			Context("when the current state is retiring", func() {
@@ -148,7 +148,8 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 		Set("addr", nil).
 		Set("baggageclaim_url", nil).
 		Where(sq.Eq{
-			"state": string(WorkerStateLanding),
+			"state":        string(WorkerStateLanding),
+			"stall_landed": false,
 		}).
 		Where("name NOT IN ("+subQ+")", subQArgs...).
 		PlaceholderFormat(sq.Dollar).
@@ -404,6 +404,24 @@ var _ = Describe("Worker Lifecycle", func() {
 				})
 			})
 
+			Context("when the worker landed itself because it is unhealthy", func() {
+				BeforeEach(func() {
+					atcWorker.StalledReason = "garden: connection refused"
+				})
+
+				It("does not land the worker so that it can recover", func() {
+					landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(landedWorkers).To(BeEmpty())
+
+					foundWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(foundWorker.State()).To(Equal(db.WorkerStateLanding))
+					Expect(foundWorker.StalledReason()).To(Equal("garden: connection refused"))
+				})
+			})
+
 			DescribeTable("land workers with builds that are",
 				func(s db.BuildStatus, expectedState db.WorkerState) {
 					dbBuild, err := defaultTeam.CreateOneOffBuild()
@@ -131,6 +131,120 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("CurrentState", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the latest state without reloading the worker", func() {
+			err := worker.Land()
+			Expect(err).NotTo(HaveOccurred())
+
+			state, found, err := worker.CurrentState()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(state).To(Equal(WorkerStateLanding))
+
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns false", func() {
+				_, found, err := worker.CurrentState()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Diagnostics", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no diagnostics by default", func() {
+			Expect(worker.DiagnosticsRequested()).To(BeFalse())
+
+			_, found, err := worker.Diagnostics()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when diagnostics are requested", func() {
+			BeforeEach(func() {
+				err := worker.RequestDiagnostics()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("marks the diagnostics as requested", func() {
+				Expect(worker.DiagnosticsRequested()).To(BeTrue())
+			})
+
+			Context("when the worker reports its diagnostics", func() {
+				var diagnostics atc.WorkerDiagnostics
+
+				BeforeEach(func() {
+					diagnostics = atc.WorkerDiagnostics{
+						CollectedAt: 42,
+						Version:     "1.2.3",
+						Runtime:     "guardian",
+						Containers: []atc.WorkerDiagnosticsContainer{
+							{Handle: "some-handle", State: "active"},
+						},
+						Volumes: []atc.WorkerDiagnosticsVolume{},
+						Logs:    []string{"some-log"},
+					}
+
+					err := worker.SaveDiagnostics(diagnostics)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = worker.Reload()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("is no longer marked as requested", func() {
+					Expect(worker.DiagnosticsRequested()).To(BeFalse())
+				})
+
+				It("returns the reported diagnostics", func() {
+					reported, found, err := worker.Diagnostics()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(reported).To(Equal(diagnostics))
+				})
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails to request diagnostics", func() {
+				err := worker.RequestDiagnostics()
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+
+			It("fails to save diagnostics", func() {
+				err := worker.SaveDiagnostics(atc.WorkerDiagnostics{})
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Delete", func() {
 		BeforeEach(func() {
 			var err error
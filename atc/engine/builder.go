@@ -26,6 +26,7 @@ type CoreStepFactory interface {
 	CheckStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, DelegateFactory) exec.Step
 	SetPipelineStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
 	LoadVarStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
+	ApprovalStep(atc.Plan, db.Build, DelegateFactory) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build) exec.Step
 }
@@ -142,6 +143,10 @@ func (factory *stepperFactory) buildStep(build db.Build, plan atc.Plan) exec.Ste
 		return factory.buildLoadVarStep(build, plan)
 	}
 
+	if plan.Approval != nil {
+		return factory.buildApprovalStep(build, plan)
+	}
+
 	if plan.Check != nil {
 		return factory.buildCheckStep(build, plan)
 	}
@@ -400,6 +405,14 @@ func (factory *stepperFactory) buildLoadVarStep(build db.Build, plan atc.Plan) e
 	)
 }
 
+func (factory *stepperFactory) buildApprovalStep(build db.Build, plan atc.Plan) exec.Step {
+	return factory.coreFactory.ApprovalStep(
+		plan,
+		build,
+		factory.buildDelegateFactory(build, plan),
+	)
+}
+
 func (factory *stepperFactory) buildArtifactInputStep(build db.Build, plan atc.Plan) exec.Step {
 	return factory.coreFactory.ArtifactInputStep(
 		plan,
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
@@ -25,6 +26,7 @@ type coreStepFactory struct {
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
 	defaultCheckTimeout   time.Duration
+	clock                 clock.Clock
 }
 
 func NewCoreStepFactory(
@@ -39,6 +41,7 @@ func NewCoreStepFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	defaultCheckTimeout time.Duration,
+	clock clock.Clock,
 ) CoreStepFactory {
 	return &coreStepFactory{
 		pool:                  pool,
@@ -52,6 +55,7 @@ func NewCoreStepFactory(
 		defaultLimits:         defaultLimits,
 		strategy:              strategy,
 		defaultCheckTimeout:   defaultCheckTimeout,
+		clock:                 clock,
 	}
 }
 
@@ -75,6 +79,10 @@ func (factory *coreStepFactory) GetStep(
 		factory.pool,
 	)
 
+	if atc.EnableStepRescheduling {
+		getStep = exec.Reschedule(getStep, delegateFactory)
+	}
+
 	getStep = exec.LogError(getStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		getStep = exec.RetryError(getStep, delegateFactory)
@@ -103,6 +111,10 @@ func (factory *coreStepFactory) PutStep(
 		delegateFactory,
 	)
 
+	if atc.EnableStepRescheduling {
+		putStep = exec.Reschedule(putStep, delegateFactory)
+	}
+
 	putStep = exec.LogError(putStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		putStep = exec.RetryError(putStep, delegateFactory)
@@ -131,6 +143,10 @@ func (factory *coreStepFactory) CheckStep(
 		factory.defaultCheckTimeout,
 	)
 
+	if atc.EnableStepRescheduling {
+		checkStep = exec.Reschedule(checkStep, delegateFactory)
+	}
+
 	checkStep = exec.LogError(checkStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		checkStep = exec.RetryError(checkStep, delegateFactory)
@@ -160,6 +176,10 @@ func (factory *coreStepFactory) TaskStep(
 		delegateFactory,
 	)
 
+	if plan.Task.Restartable {
+		taskStep = exec.Reschedule(taskStep, delegateFactory)
+	}
+
 	taskStep = exec.LogError(taskStep, delegateFactory)
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		taskStep = exec.RetryError(taskStep, delegateFactory)
@@ -210,6 +230,22 @@ func (factory *coreStepFactory) LoadVarStep(
 	return loadVarStep
 }
 
+func (factory *coreStepFactory) ApprovalStep(
+	plan atc.Plan,
+	build db.Build,
+	delegateFactory DelegateFactory,
+) exec.Step {
+	approvalStep := exec.NewApprovalStep(
+		plan.ID,
+		*plan.Approval,
+		build,
+		delegateFactory,
+		factory.clock,
+	)
+
+	return exec.LogError(approvalStep, delegateFactory)
+}
+
 func (factory *coreStepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
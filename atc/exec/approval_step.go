@@ -0,0 +1,148 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// ApprovalPollInterval is how often a build paused on an approval step checks
+// for new approvals.
+const ApprovalPollInterval = 5 * time.Second
+
+// ApprovalStep pauses the build until enough users have approved it, failing
+// as soon as any of them rejects it.
+//
+// Nothing runs on a worker while waiting; approvals are recorded against the
+// build through the API.
+type ApprovalStep struct {
+	planID          atc.PlanID
+	plan            atc.ApprovalPlan
+	build           db.Build
+	delegateFactory BuildStepDelegateFactory
+	clock           clock.Clock
+}
+
+func NewApprovalStep(
+	planID atc.PlanID,
+	plan atc.ApprovalPlan,
+	build db.Build,
+	delegateFactory BuildStepDelegateFactory,
+	clock clock.Clock,
+) Step {
+	return &ApprovalStep{
+		planID:          planID,
+		plan:            plan,
+		build:           build,
+		delegateFactory: delegateFactory,
+		clock:           clock,
+	}
+}
+
+func (step *ApprovalStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "approval", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	ok, err := step.run(ctx, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+func (step *ApprovalStep) run(ctx context.Context, delegate BuildStepDelegate) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("approval-step", lager.Data{
+		"step-name": step.plan.Name,
+	})
+
+	delegate.Initializing(logger)
+	stdout := delegate.Stdout()
+
+	delegate.Starting(logger)
+
+	err := step.build.SetAwaitingApproval(step.planID)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		err := step.build.ClearAwaitingApproval(step.planID)
+		if err != nil {
+			logger.Error("failed-to-clear-awaiting-approval", err)
+		}
+	}()
+
+	fmt.Fprintf(stdout, "waiting for %d approval(s) from users with the %s role...\n", step.plan.Approvers, step.plan.Role)
+
+	ticker := step.clock.NewTicker(ApprovalPollInterval)
+	defer ticker.Stop()
+
+	reported := map[string]time.Time{}
+
+	for {
+		approvals, err := step.build.Approvals(step.planID)
+		if err != nil {
+			return false, err
+		}
+
+		approved := 0
+		rejected := false
+		for _, approval := range approvals {
+			if reportedAt, ok := reported[approval.Username]; !ok || !reportedAt.Equal(approval.CreatedAt) {
+				reported[approval.Username] = approval.CreatedAt
+				fmt.Fprintln(stdout, describeApproval(approval))
+			}
+
+			if approval.Approved {
+				approved++
+			} else {
+				rejected = true
+			}
+		}
+
+		if rejected {
+			delegate.Finished(logger, false)
+			return false, nil
+		}
+
+		if approved >= step.plan.Approvers {
+			delegate.Finished(logger, true)
+			return true, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				fmt.Fprintln(stdout, "timed out waiting for approval")
+			}
+
+			return false, ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+func describeApproval(approval db.BuildApproval) string {
+	verb := "approved"
+	if !approval.Approved {
+		verb = "rejected"
+	}
+
+	description := fmt.Sprintf("%s by %s (%s)", verb, approval.Username, approval.Role)
+	if approval.Comment != "" {
+		description += ": " + approval.Comment
+	}
+
+	return description
+}
@@ -0,0 +1,217 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"go.opentelemetry.io/otel/api/trace"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/tracing"
+)
+
+var _ = Describe("ApprovalStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+		fakeBuild           *dbfakes.FakeBuild
+		state               *execfakes.FakeRunState
+
+		stdout *gbytes.Buffer
+
+		approvalPlan atc.ApprovalPlan
+		planID       = atc.PlanID("56")
+
+		fakeClock *fakeclock.FakeClock
+
+		stepOk  bool
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("approval-step-test"))
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		stdout = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StartSpanStub = func(ctx context.Context, _ string, _ tracing.Attrs) (context.Context, trace.Span) {
+			return ctx, trace.NoopSpan{}
+		}
+
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		state = new(execfakes.FakeRunState)
+
+		approvalPlan = atc.ApprovalPlan{
+			Name:      "deploy",
+			Approvers: 2,
+			Role:      "member",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step := exec.NewApprovalStep(planID, approvalPlan, fakeBuild, fakeDelegateFactory, fakeClock)
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	approval := func(username string, approved bool) db.BuildApproval {
+		return db.BuildApproval{
+			PlanID:    planID,
+			Username:  username,
+			Role:      "member",
+			Approved:  approved,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	Context("when enough users approve", func() {
+		BeforeEach(func() {
+			first := approval("alice", true)
+			first.Comment = "lgtm"
+
+			polls := [][]db.BuildApproval{
+				nil,
+				{first},
+				{first, approval("bob", true)},
+			}
+
+			fakeBuild.ApprovalsStub = func(atc.PlanID) ([]db.BuildApproval, error) {
+				approvals := polls[fakeBuild.ApprovalsCallCount()-1]
+				fakeClock.Increment(exec.ApprovalPollInterval)
+				return approvals, nil
+			}
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeTrue())
+		})
+
+		It("waits on the approval step and stops once done", func() {
+			Expect(fakeBuild.SetAwaitingApprovalCallCount()).To(Equal(1))
+			Expect(fakeBuild.SetAwaitingApprovalArgsForCall(0)).To(Equal(planID))
+
+			Expect(fakeBuild.ClearAwaitingApprovalCallCount()).To(Equal(1))
+			Expect(fakeBuild.ClearAwaitingApprovalArgsForCall(0)).To(Equal(planID))
+		})
+
+		It("looks up approvals for its own plan", func() {
+			Expect(fakeBuild.ApprovalsCallCount()).To(Equal(3))
+			Expect(fakeBuild.ApprovalsArgsForCall(0)).To(Equal(planID))
+		})
+
+		It("prints each approval once", func() {
+			Expect(stdout).To(gbytes.Say("waiting for 2 approval\\(s\\) from users with the member role"))
+			Expect(stdout).To(gbytes.Say("approved by alice \\(member\\): lgtm"))
+			Expect(stdout).To(gbytes.Say("approved by bob \\(member\\)"))
+			Expect(stdout).ToNot(gbytes.Say("alice"))
+		})
+
+		It("finishes successfully", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+	})
+
+	Context("when a user rejects", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalsReturns([]db.BuildApproval{
+				approval("alice", true),
+				approval("bob", false),
+			}, nil)
+		})
+
+		It("fails without an error", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+		})
+
+		It("finishes unsuccessfully", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+
+		It("prints the rejection", func() {
+			Expect(stdout).To(gbytes.Say("rejected by bob"))
+		})
+	})
+
+	Context("when the step times out", func() {
+		BeforeEach(func() {
+			ctx, cancel = context.WithTimeout(ctx, 10*time.Millisecond)
+		})
+
+		It("returns the deadline error", func() {
+			Expect(stepErr).To(Equal(context.DeadlineExceeded))
+			Expect(stepOk).To(BeFalse())
+		})
+
+		It("says so", func() {
+			Expect(stdout).To(gbytes.Say("timed out waiting for approval"))
+		})
+
+		It("stops waiting on the approval step", func() {
+			Expect(fakeBuild.ClearAwaitingApprovalCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the build is aborted", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalsStub = func(atc.PlanID) ([]db.BuildApproval, error) {
+				cancel()
+				return nil, nil
+			}
+		})
+
+		It("returns the cancellation error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("when marking the build as awaiting approval fails", func() {
+		BeforeEach(func() {
+			fakeBuild.SetAwaitingApprovalReturns(errors.New("nope"))
+		})
+
+		It("errors without waiting", func() {
+			Expect(stepErr).To(MatchError("nope"))
+			Expect(fakeBuild.ApprovalsCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when looking up approvals fails", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalsReturns(nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError("nope"))
+		})
+	})
+})
@@ -685,6 +685,10 @@ var _ = Describe("CheckStep", func() {
 						Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeCallCount()).To(Equal(1))
 					})
 
+					It("records the check as succeeded", func() {
+						Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeArgsForCall(0)).To(BeTrue())
+					})
+
 					It("points the resource or resource type to the scope", func() {
 						Expect(fakeResourceConfigScope.SaveVersionsCallCount()).To(Equal(1))
 						Expect(fakeDelegate.PointToCheckedConfigCallCount()).To(Equal(1))
@@ -735,6 +739,10 @@ var _ = Describe("CheckStep", func() {
 					Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeCallCount()).To(Equal(1))
 				})
 
+				It("records the check as failed", func() {
+					Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeArgsForCall(0)).To(BeFalse())
+				})
+
 				// Finished is for script success/failure, whereas this is an error
 				It("does not emit a Finished event", func() {
 					Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
@@ -0,0 +1,62 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// MaxRescheduleAttempts limits how many times a single step will be moved off
+// of a landing worker before giving up.
+const MaxRescheduleAttempts = 5
+
+// RescheduleStep runs a step such that it is interrupted if the worker it is
+// running on starts landing, and then runs it again on another worker rather
+// than failing the build.
+type RescheduleStep struct {
+	Step
+
+	delegateFactory BuildStepDelegateFactory
+}
+
+func Reschedule(step Step, delegateFactory BuildStepDelegateFactory) Step {
+	return RescheduleStep{
+		Step: step,
+
+		delegateFactory: delegateFactory,
+	}
+}
+
+func (step RescheduleStep) Run(ctx context.Context, state RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		runOk, runErr := step.Step.Run(worker.RescheduleOnLanding(ctx), state)
+
+		var landingErr worker.WorkerLandingError
+		if runErr == nil || !errors.As(runErr, &landingErr) {
+			return runOk, runErr
+		}
+
+		// If the build has been aborted, then no need to reschedule.
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		if attempt >= MaxRescheduleAttempts {
+			return runOk, runErr
+		}
+
+		logger.Info("rescheduling", lager.Data{
+			"worker":  landingErr.WorkerName,
+			"attempt": attempt,
+		})
+
+		delegate := step.delegateFactory.BuildStepDelegate(state)
+		delegate.Errored(logger, fmt.Sprintf("%s, rescheduling ...", runErr.Error()))
+	}
+}
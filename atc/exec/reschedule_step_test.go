@@ -0,0 +1,136 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RescheduleStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep *execfakes.FakeStep
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+		repo  *build.Repository
+		state *execfakes.FakeRunState
+
+		step Step
+
+		landingErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		repo = build.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactRepositoryReturns(repo)
+
+		step = Reschedule(fakeStep, fakeDelegateFactory)
+
+		landingErr = fmt.Errorf("run check: %w", worker.WorkerLandingError{WorkerName: "some-worker"})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("Run", func() {
+		var runOk bool
+		var runErr error
+
+		JustBeforeEach(func() {
+			runOk, runErr = step.Run(ctx, state)
+		})
+
+		Context("when the inner step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.RunReturns(true, nil)
+			})
+
+			It("runs the step once", func() {
+				Expect(runOk).To(BeTrue())
+				Expect(runErr).To(BeNil())
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			})
+
+			It("does not log", func() {
+				Expect(fakeDelegate.ErroredCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the inner step fails for some other reason", func() {
+			disaster := errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(false, disaster)
+			})
+
+			It("does not run the step again", func() {
+				Expect(runErr).To(Equal(disaster))
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the step's worker starts landing", func() {
+			BeforeEach(func() {
+				fakeStep.RunReturnsOnCall(0, false, landingErr)
+				fakeStep.RunReturnsOnCall(1, true, nil)
+			})
+
+			It("runs the step again", func() {
+				Expect(fakeStep.RunCallCount()).To(Equal(2))
+				Expect(runOk).To(BeTrue())
+				Expect(runErr).To(BeNil())
+			})
+
+			It("logs that the step is being rescheduled", func() {
+				Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+				_, message := fakeDelegate.ErroredArgsForCall(0)
+				Expect(message).To(Equal("run check: worker some-worker is landing, rescheduling ..."))
+			})
+
+			Context("when the build has been aborted", func() {
+				BeforeEach(func() {
+					fakeStep.RunStub = func(context.Context, RunState) (bool, error) {
+						cancel()
+						return false, landingErr
+					}
+				})
+
+				It("does not run the step again", func() {
+					Expect(fakeStep.RunCallCount()).To(Equal(1))
+					Expect(runErr).To(Equal(context.Canceled))
+				})
+			})
+		})
+
+		Context("when every worker the step runs on starts landing", func() {
+			BeforeEach(func() {
+				fakeStep.RunReturns(false, landingErr)
+			})
+
+			It("gives up after the maximum number of attempts", func() {
+				Expect(fakeStep.RunCallCount()).To(Equal(MaxRescheduleAttempts))
+				Expect(runErr).To(Equal(landingErr))
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ var (
 	EnableGlobalResources                bool
 	EnableRedactSecrets                  bool
 	EnableBuildRerunWhenWorkerDisappears bool
+	EnableStepRescheduling               bool
 	EnableAcrossStep                     bool
 	EnablePipelineInstances              bool
 )
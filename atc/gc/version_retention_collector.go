@@ -0,0 +1,129 @@
+package gc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+type versionRetentionCollector struct {
+	pipelineFactory  db.PipelineFactory
+	versionLifecycle db.ResourceConfigVersionLifecycle
+}
+
+func NewVersionRetentionCollector(
+	pipelineFactory db.PipelineFactory,
+	versionLifecycle db.ResourceConfigVersionLifecycle,
+) *versionRetentionCollector {
+	return &versionRetentionCollector{
+		pipelineFactory:  pipelineFactory,
+		versionLifecycle: versionLifecycle,
+	}
+}
+
+// scopeRetention is the combined retention of every resource sharing a
+// resource config scope. Each resource's retention only ever keeps more
+// versions, so a scope used by any resource without one is left alone.
+type scopeRetention struct {
+	retainAll bool
+	retention atc.VersionRetention
+	pinned    []atc.Version
+}
+
+func (vrc *versionRetentionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("version-retention-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	start := time.Now()
+	defer func() {
+		metric.VersionRetentionCollectorDuration{
+			Duration: time.Since(start),
+		}.Emit(logger)
+	}()
+
+	pipelines, err := vrc.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	scopes := map[int]*scopeRetention{}
+	for _, pipeline := range pipelines {
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			return err
+		}
+
+		for _, resource := range resources {
+			if resource.ResourceConfigScopeID() == 0 {
+				continue
+			}
+
+			scope, found := scopes[resource.ResourceConfigScopeID()]
+			if !found {
+				scope = &scopeRetention{}
+				scopes[resource.ResourceConfigScopeID()] = scope
+			}
+
+			retention := resource.Config().VersionRetention
+			if retention == nil || (retention.Versions == 0 && retention.Days == 0) {
+				scope.retainAll = true
+				continue
+			}
+
+			if retention.Versions > scope.retention.Versions {
+				scope.retention.Versions = retention.Versions
+			}
+
+			if retention.Days > scope.retention.Days {
+				scope.retention.Days = retention.Days
+			}
+
+			if pinned := resource.CurrentPinnedVersion(); pinned != nil {
+				scope.pinned = append(scope.pinned, pinned)
+			}
+		}
+	}
+
+	scopeIDs := []int{}
+	for scopeID, scope := range scopes {
+		if !scope.retainAll {
+			scopeIDs = append(scopeIDs, scopeID)
+		}
+	}
+
+	sort.Ints(scopeIDs)
+
+	var errs error
+	for _, scopeID := range scopeIDs {
+		scope := scopes[scopeID]
+
+		pruned, err := vrc.versionLifecycle.PruneVersions(scopeID, scope.retention, scope.pinned)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			logger.Error("failed-to-prune-versions", err, lager.Data{"scope": scopeID})
+			continue
+		}
+
+		if pruned > 0 {
+			logger.Debug("pruned-versions", lager.Data{
+				"scope":  scopeID,
+				"pruned": pruned,
+			})
+		}
+
+		metric.Metrics.VersionsPruned.IncDelta(pruned)
+	}
+
+	return errs
+}
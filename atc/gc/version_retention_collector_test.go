@@ -0,0 +1,154 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VersionRetentionCollector", func() {
+	var (
+		collector GcCollector
+
+		fakePipelineFactory  *dbfakes.FakePipelineFactory
+		fakeVersionLifecycle *dbfakes.FakeResourceConfigVersionLifecycle
+
+		fakePipeline      *dbfakes.FakePipeline
+		fakeOtherPipeline *dbfakes.FakePipeline
+
+		runErr error
+	)
+
+	newResource := func(scopeID int, retention *atc.VersionRetention, pinned atc.Version) *dbfakes.FakeResource {
+		fakeResource := new(dbfakes.FakeResource)
+		fakeResource.ResourceConfigScopeIDReturns(scopeID)
+		fakeResource.ConfigReturns(atc.ResourceConfig{VersionRetention: retention})
+		fakeResource.CurrentPinnedVersionReturns(pinned)
+		return fakeResource
+	}
+
+	BeforeEach(func() {
+		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
+		fakeVersionLifecycle = new(dbfakes.FakeResourceConfigVersionLifecycle)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakeOtherPipeline = new(dbfakes.FakePipeline)
+		fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline, fakeOtherPipeline}, nil)
+
+		collector = gc.NewVersionRetentionCollector(fakePipelineFactory, fakeVersionLifecycle)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	Context("when resources have a version retention", func() {
+		BeforeEach(func() {
+			fakePipeline.ResourcesReturns(db.Resources{
+				newResource(2, &atc.VersionRetention{Versions: 10}, atc.Version{"ref": "pinned"}),
+				newResource(1, &atc.VersionRetention{Days: 7}, nil),
+				newResource(0, &atc.VersionRetention{Versions: 5}, nil),
+			}, nil)
+		})
+
+		It("prunes the versions of each of their scopes", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeVersionLifecycle.PruneVersionsCallCount()).To(Equal(2))
+
+			scopeID, retention, pinned := fakeVersionLifecycle.PruneVersionsArgsForCall(0)
+			Expect(scopeID).To(Equal(1))
+			Expect(retention).To(Equal(atc.VersionRetention{Days: 7}))
+			Expect(pinned).To(BeEmpty())
+
+			scopeID, retention, pinned = fakeVersionLifecycle.PruneVersionsArgsForCall(1)
+			Expect(scopeID).To(Equal(2))
+			Expect(retention).To(Equal(atc.VersionRetention{Versions: 10}))
+			Expect(pinned).To(Equal([]atc.Version{{"ref": "pinned"}}))
+		})
+
+		Context("when a scope is shared with a resource in another pipeline", func() {
+			Context("which has its own retention", func() {
+				BeforeEach(func() {
+					fakeOtherPipeline.ResourcesReturns(db.Resources{
+						newResource(2, &atc.VersionRetention{Versions: 5, Days: 3}, atc.Version{"ref": "other-pinned"}),
+					}, nil)
+				})
+
+				It("keeps the versions retained by either of them", func() {
+					Expect(fakeVersionLifecycle.PruneVersionsCallCount()).To(Equal(2))
+
+					scopeID, retention, pinned := fakeVersionLifecycle.PruneVersionsArgsForCall(1)
+					Expect(scopeID).To(Equal(2))
+					Expect(retention).To(Equal(atc.VersionRetention{Versions: 10, Days: 3}))
+					Expect(pinned).To(ConsistOf(atc.Version{"ref": "pinned"}, atc.Version{"ref": "other-pinned"}))
+				})
+			})
+
+			Context("which has no retention", func() {
+				BeforeEach(func() {
+					fakeOtherPipeline.ResourcesReturns(db.Resources{
+						newResource(2, nil, nil),
+					}, nil)
+				})
+
+				It("does not prune the scope", func() {
+					Expect(fakeVersionLifecycle.PruneVersionsCallCount()).To(Equal(1))
+
+					scopeID, _, _ := fakeVersionLifecycle.PruneVersionsArgsForCall(0)
+					Expect(scopeID).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when pruning a scope fails", func() {
+			BeforeEach(func() {
+				fakeVersionLifecycle.PruneVersionsReturnsOnCall(0, 0, errors.New("disaster"))
+			})
+
+			It("still prunes the other scopes", func() {
+				Expect(runErr).To(MatchError(ContainSubstring("disaster")))
+				Expect(fakeVersionLifecycle.PruneVersionsCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when no resources have a version retention", func() {
+		BeforeEach(func() {
+			fakePipeline.ResourcesReturns(db.Resources{
+				newResource(1, nil, nil),
+			}, nil)
+		})
+
+		It("does not prune anything", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeVersionLifecycle.PruneVersionsCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the pipelines fails", func() {
+		BeforeEach(func() {
+			fakePipelineFactory.AllPipelinesReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+
+	Context("when getting the resources fails", func() {
+		BeforeEach(func() {
+			fakePipeline.ResourcesReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+})
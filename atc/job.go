@@ -22,8 +22,9 @@ type Job struct {
 	FinishedBuild   *Build `json:"finished_build"`
 	TransitionBuild *Build `json:"transition_build,omitempty"`
 
-	Inputs  []JobInput  `json:"inputs,omitempty"`
-	Outputs []JobOutput `json:"outputs,omitempty"`
+	Inputs    []JobInput  `json:"inputs,omitempty"`
+	Outputs   []JobOutput `json:"outputs,omitempty"`
+	DependsOn []string    `json:"depends_on,omitempty"`
 }
 
 type JobInput struct {
@@ -1,5 +1,13 @@
 package atc
 
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -14,6 +22,12 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Schedules []JobSchedule `json:"schedule,omitempty"`
+
+	// DependsOn lists jobs whose successful builds trigger this job, without
+	// them having to share a resource.
+	DependsOn []string `json:"depends_on,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -29,6 +43,90 @@ type BuildLogRetention struct {
 	Days                   int `json:"days,omitempty"`
 }
 
+// JobSchedule triggers builds of a job on a timetable, evaluated by the ATC
+// rather than by checking a time resource.
+type JobSchedule struct {
+	// A standard five-field cron expression, e.g. "0 9 * * 1-5", or a
+	// descriptor such as "@daily".
+	Cron string `json:"cron"`
+
+	// The IANA time zone to evaluate the expression in. Defaults to UTC.
+	Location string `json:"location,omitempty"`
+
+	// Delay each build by up to this duration, so that jobs sharing a
+	// schedule don't all start at once.
+	Jitter string `json:"jitter,omitempty"`
+}
+
+// Parse returns the cron schedule evaluated in the configured location.
+func (schedule JobSchedule) Parse() (cron.Schedule, error) {
+	location := time.UTC
+	if schedule.Location != "" {
+		var err error
+		location, err = time.LoadLocation(schedule.Location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location: %w", err)
+		}
+	}
+
+	spec, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	if specSchedule, ok := spec.(*cron.SpecSchedule); ok {
+		specSchedule.Location = location
+	}
+
+	return spec, nil
+}
+
+// MaxJitter parses the configured jitter.
+func (schedule JobSchedule) MaxJitter() (time.Duration, error) {
+	if schedule.Jitter == "" {
+		return 0, nil
+	}
+
+	jitter, err := time.ParseDuration(schedule.Jitter)
+	if err != nil {
+		return 0, fmt.Errorf("invalid jitter: %w", err)
+	}
+
+	if jitter < 0 {
+		return 0, fmt.Errorf("invalid jitter: must not be negative")
+	}
+
+	return jitter, nil
+}
+
+// Next returns when the job should next be triggered after the given time,
+// including jitter.
+//
+// The jitter is derived from the job and the scheduled time rather than
+// chosen at random, so that it is the same no matter which ATC evaluates the
+// schedule or how often.
+func (schedule JobSchedule) Next(jobID int, after time.Time) (time.Time, error) {
+	spec, err := schedule.Parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	maxJitter, err := schedule.MaxJitter()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := spec.Next(after)
+	if next.IsZero() || maxJitter == 0 {
+		return next, nil
+	}
+
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%d/%d", jobID, next.Unix())
+
+	return next.Add(time.Duration(hash.Sum64() % uint64(maxJitter))), nil
+}
+
 func (config JobConfig) Step() Step {
 	return Step{Config: config.StepConfig()}
 }
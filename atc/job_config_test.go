@@ -1,6 +1,8 @@
 package atc_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 
 	. "github.com/onsi/ginkgo"
@@ -671,4 +673,63 @@ var _ = Describe("JobConfig", func() {
 			})
 		})
 	})
+
+	Describe("JobSchedule", func() {
+		var after time.Time
+
+		BeforeEach(func() {
+			after = time.Date(2021, time.January, 4, 8, 30, 0, 0, time.UTC)
+		})
+
+		Describe("Next", func() {
+			It("returns the next time the cron expression matches", func() {
+				next, err := atc.JobSchedule{Cron: "0 9 * * *"}.Next(1, after)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(BeTemporally("==", time.Date(2021, time.January, 4, 9, 0, 0, 0, time.UTC)))
+			})
+
+			It("evaluates the expression in the configured location", func() {
+				next, err := atc.JobSchedule{Cron: "0 9 * * *", Location: "America/New_York"}.Next(1, after)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(BeTemporally("==", time.Date(2021, time.January, 4, 14, 0, 0, 0, time.UTC)))
+			})
+
+			Context("with jitter", func() {
+				var schedule atc.JobSchedule
+
+				BeforeEach(func() {
+					schedule = atc.JobSchedule{Cron: "0 9 * * *", Jitter: "10m"}
+				})
+
+				It("delays the time by up to the jitter", func() {
+					tick := time.Date(2021, time.January, 4, 9, 0, 0, 0, time.UTC)
+
+					next, err := schedule.Next(1, after)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(next).To(BeTemporally(">=", tick))
+					Expect(next).To(BeTemporally("<", tick.Add(10*time.Minute)))
+				})
+
+				It("returns the same time for the same job", func() {
+					next1, err := schedule.Next(1, after)
+					Expect(err).ToNot(HaveOccurred())
+
+					next2, err := schedule.Next(1, after)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(next1).To(Equal(next2))
+				})
+			})
+
+			It("errors for an invalid expression", func() {
+				_, err := atc.JobSchedule{Cron: "nope"}.Next(1, after)
+				Expect(err).To(MatchError(ContainSubstring("invalid cron expression")))
+			})
+
+			It("errors for an invalid location", func() {
+				_, err := atc.JobSchedule{Cron: "@daily", Location: "Nowhere/Special"}.Next(1, after)
+				Expect(err).To(MatchError(ContainSubstring("invalid location")))
+			})
+		})
+	})
 })
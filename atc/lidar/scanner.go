@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"sync"
 
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
@@ -12,14 +14,16 @@ import (
 	"github.com/concourse/concourse/tracing"
 )
 
-func NewScanner(checkFactory db.CheckFactory) *scanner {
+func NewScanner(checkFactory db.CheckFactory, clock clock.Clock) *scanner {
 	return &scanner{
 		checkFactory: checkFactory,
+		clock:        clock,
 	}
 }
 
 type scanner struct {
 	checkFactory db.CheckFactory
+	clock        clock.Clock
 }
 
 func (s *scanner) Run(ctx context.Context) error {
@@ -91,6 +95,13 @@ func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTyp
 		}
 	}
 
+	if next := s.checkFactory.NextCheckTime(checkable); s.clock.Now().Before(next) {
+		// the interval may have been stretched by failures or idleness, so
+		// don't bother trying to create a check until it's due
+		logger.Debug("check-not-due", lager.Data{"next-check": next})
+		return
+	}
+
 	version := checkable.CurrentPinnedVersion()
 
 	_, created, err := s.checkFactory.TryCreateCheck(lagerctx.NewContext(spanCtx, logger), checkable, resourceTypes, version, false)
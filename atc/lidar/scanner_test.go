@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -22,14 +23,16 @@ var _ = Describe("Scanner", func() {
 		err error
 
 		fakeCheckFactory *dbfakes.FakeCheckFactory
+		fakeClock        *fakeclock.FakeClock
 
 		scanner Scanner
 	)
 
 	BeforeEach(func() {
 		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
 
-		scanner = lidar.NewScanner(fakeCheckFactory)
+		scanner = lidar.NewScanner(fakeCheckFactory, fakeClock)
 	})
 
 	JustBeforeEach(func() {
@@ -116,6 +119,38 @@ var _ = Describe("Scanner", func() {
 						})
 					})
 
+					Context("when the next check is not due yet", func() {
+						BeforeEach(func() {
+							fakeCheckFactory.NextCheckTimeReturns(fakeClock.Now().Add(time.Second))
+						})
+
+						It("does not try to create a check", func() {
+							Expect(fakeCheckFactory.NextCheckTimeCallCount()).To(Equal(1))
+							Expect(fakeCheckFactory.NextCheckTimeArgsForCall(0)).To(Equal(fakeResource))
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the next check is due", func() {
+						BeforeEach(func() {
+							fakeCheckFactory.NextCheckTimeReturns(fakeClock.Now().Add(-time.Minute))
+						})
+
+						It("creates a check", func() {
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the next check is due right now", func() {
+						BeforeEach(func() {
+							fakeCheckFactory.NextCheckTimeReturns(fakeClock.Now())
+						})
+
+						It("creates a check", func() {
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+						})
+					})
+
 					Context("when the checkable has a pinned version", func() {
 						BeforeEach(func() {
 							fakeResource.CurrentPinnedVersionReturns(atc.Version{"some": "version"})
@@ -149,6 +184,25 @@ var _ = Describe("Scanner", func() {
 						fakeResourceType.PipelineIDReturns(1)
 					})
 
+					Context("when only the parent type is due for a check", func() {
+						BeforeEach(func() {
+							fakeCheckFactory.NextCheckTimeStub = func(checkable db.Checkable) time.Time {
+								if checkable == fakeResource {
+									return fakeClock.Now().Add(time.Hour)
+								}
+
+								return time.Time{}
+							}
+						})
+
+						It("still creates a check for the parent type", func() {
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+
+							_, checkable, _, _, _ := fakeCheckFactory.TryCreateCheckArgsForCall(0)
+							Expect(checkable).To(Equal(fakeResourceType))
+						})
+					})
+
 					It("creates a check for both the parent and the resource", func() {
 						Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(2))
 
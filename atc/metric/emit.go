@@ -50,6 +50,7 @@ type Monitor struct {
 	ContainersDeleted Counter
 	VolumesDeleted    Counter
 	ChecksDeleted     Counter
+	VersionsPruned    Counter
 
 	JobsScheduled  Counter
 	JobsScheduling Gauge
@@ -67,7 +68,8 @@ type Monitor struct {
 	ConcurrentRequests         map[string]*Gauge
 	ConcurrentRequestsLimitHit map[string]*Counter
 
-	VolumesStreamed Counter
+	VolumesStreamed       Counter
+	VolumesSharedByDigest Counter
 }
 
 var Metrics = NewMonitor()
@@ -29,6 +29,16 @@ type FakePrometheusGarbageCollectable struct {
 	workerContainersLabelsReturnsOnCall map[int]struct {
 		result1 map[string]map[string]prometheus.Labels
 	}
+	WorkerResourcePressureStub        func() []*prometheus.GaugeVec
+	workerResourcePressureMutex       sync.RWMutex
+	workerResourcePressureArgsForCall []struct {
+	}
+	workerResourcePressureReturns struct {
+		result1 []*prometheus.GaugeVec
+	}
+	workerResourcePressureReturnsOnCall map[int]struct {
+		result1 []*prometheus.GaugeVec
+	}
 	WorkerTasksStub        func() *prometheus.GaugeVec
 	workerTasksMutex       sync.RWMutex
 	workerTasksArgsForCall []struct {
@@ -177,6 +187,58 @@ func (fake *FakePrometheusGarbageCollectable) WorkerContainersLabelsReturnsOnCal
 	}{result1}
 }
 
+func (fake *FakePrometheusGarbageCollectable) WorkerResourcePressure() []*prometheus.GaugeVec {
+	fake.workerResourcePressureMutex.Lock()
+	ret, specificReturn := fake.workerResourcePressureReturnsOnCall[len(fake.workerResourcePressureArgsForCall)]
+	fake.workerResourcePressureArgsForCall = append(fake.workerResourcePressureArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerResourcePressure", []interface{}{})
+	fake.workerResourcePressureMutex.Unlock()
+	if fake.WorkerResourcePressureStub != nil {
+		return fake.WorkerResourcePressureStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerResourcePressureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerResourcePressureCallCount() int {
+	fake.workerResourcePressureMutex.RLock()
+	defer fake.workerResourcePressureMutex.RUnlock()
+	return len(fake.workerResourcePressureArgsForCall)
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerResourcePressureCalls(stub func() []*prometheus.GaugeVec) {
+	fake.workerResourcePressureMutex.Lock()
+	defer fake.workerResourcePressureMutex.Unlock()
+	fake.WorkerResourcePressureStub = stub
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerResourcePressureReturns(result1 []*prometheus.GaugeVec) {
+	fake.workerResourcePressureMutex.Lock()
+	defer fake.workerResourcePressureMutex.Unlock()
+	fake.WorkerResourcePressureStub = nil
+	fake.workerResourcePressureReturns = struct {
+		result1 []*prometheus.GaugeVec
+	}{result1}
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerResourcePressureReturnsOnCall(i int, result1 []*prometheus.GaugeVec) {
+	fake.workerResourcePressureMutex.Lock()
+	defer fake.workerResourcePressureMutex.Unlock()
+	fake.WorkerResourcePressureStub = nil
+	if fake.workerResourcePressureReturnsOnCall == nil {
+		fake.workerResourcePressureReturnsOnCall = make(map[int]struct {
+			result1 []*prometheus.GaugeVec
+		})
+	}
+	fake.workerResourcePressureReturnsOnCall[i] = struct {
+		result1 []*prometheus.GaugeVec
+	}{result1}
+}
+
 func (fake *FakePrometheusGarbageCollectable) WorkerTasks() *prometheus.GaugeVec {
 	fake.workerTasksMutex.Lock()
 	ret, specificReturn := fake.workerTasksReturnsOnCall[len(fake.workerTasksArgsForCall)]
@@ -392,6 +454,8 @@ func (fake *FakePrometheusGarbageCollectable) Invocations() map[string][][]inter
 	defer fake.workerContainersMutex.RUnlock()
 	fake.workerContainersLabelsMutex.RLock()
 	defer fake.workerContainersLabelsMutex.RUnlock()
+	fake.workerResourcePressureMutex.RLock()
+	defer fake.workerResourcePressureMutex.RUnlock()
 	fake.workerTasksMutex.RLock()
 	defer fake.workerTasksMutex.RUnlock()
 	fake.workerTasksLabelsMutex.RLock()
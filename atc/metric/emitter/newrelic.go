@@ -102,7 +102,13 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 		"database connections",
 		"worker unknown containers",
 		"worker unknown volumes",
-		"volumes streamed":
+		"worker cpu load",
+		"worker memory pressure",
+		"worker free disk",
+		"worker open files",
+		"volumes streamed",
+		"volumes shared by digest",
+		"versions pruned":
 		emitter.NewRelicBatch = append(emitter.NewRelicBatch, emitter.transformToNewRelicEvent(event, ""))
 
 	// These are periodic metrics that are consolidated and only emitted once
@@ -52,7 +52,10 @@ type PrometheusEmitter struct {
 	checksStarted   prometheus.Counter
 	checksEnqueued  prometheus.Counter
 
-	volumesStreamed prometheus.Counter
+	volumesStreamed       prometheus.Counter
+	volumesSharedByDigest prometheus.Counter
+
+	versionsPruned prometheus.Counter
 
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
@@ -61,6 +64,11 @@ type PrometheusEmitter struct {
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
+	workerCPULoad        *prometheus.GaugeVec
+	workerMemoryPressure *prometheus.GaugeVec
+	workerFreeDisk       *prometheus.GaugeVec
+	workerOpenFiles      *prometheus.GaugeVec
+
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
 	workerTasksLabels      map[string]map[string]prometheus.Labels
@@ -310,6 +318,50 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workerTasks)
 
+	workerCPULoad := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "cpu_load",
+			Help:      "Load average per CPU as reported by the worker",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerCPULoad)
+
+	workerMemoryPressure := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "memory_pressure",
+			Help:      "Fraction of memory in use as reported by the worker",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerMemoryPressure)
+
+	workerFreeDisk := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "free_disk_bytes",
+			Help:      "Free disk space in the work dir as reported by the worker",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerFreeDisk)
+
+	workerOpenFiles := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "open_files",
+			Help:      "Number of open file descriptors as reported by the worker",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerOpenFiles)
+
 	workersRegistered := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -403,6 +455,26 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(volumesStreamed)
 
+	volumesSharedByDigest := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "volumes_shared_by_digest",
+			Help:      "Total number of resource cache volumes created by sharing the content of another resource cache with the same digest",
+		},
+	)
+	prometheus.MustRegister(volumesSharedByDigest)
+
+	versionsPruned := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "gc",
+			Name:      "versions_pruned_total",
+			Help:      "Total number of resource versions pruned by their resource's version retention",
+		},
+	)
+	prometheus.MustRegister(versionsPruned)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -456,7 +528,15 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
 
-		volumesStreamed: volumesStreamed,
+		workerCPULoad:        workerCPULoad,
+		workerMemoryPressure: workerMemoryPressure,
+		workerFreeDisk:       workerFreeDisk,
+		workerOpenFiles:      workerOpenFiles,
+
+		volumesStreamed:       volumesStreamed,
+		volumesSharedByDigest: volumesSharedByDigest,
+
+		versionsPruned: versionsPruned,
 	}
 	go emitter.periodicMetricGC()
 
@@ -518,6 +598,14 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerTasksMetric(logger, event)
 	case "worker state":
 		emitter.workersRegisteredMetric(logger, event)
+	case "worker cpu load":
+		emitter.workerResourcePressureMetric(logger, emitter.workerCPULoad, event)
+	case "worker memory pressure":
+		emitter.workerResourcePressureMetric(logger, emitter.workerMemoryPressure, event)
+	case "worker free disk":
+		emitter.workerResourcePressureMetric(logger, emitter.workerFreeDisk, event)
+	case "worker open files":
+		emitter.workerResourcePressureMetric(logger, emitter.workerOpenFiles, event)
 	case "http response time":
 		emitter.httpResponseTimeMetrics(logger, event)
 	case "database queries":
@@ -534,6 +622,10 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksQueueSize.Set(event.Value)
 	case "volumes streamed":
 		emitter.volumesStreamed.Add(event.Value)
+	case "volumes shared by digest":
+		emitter.volumesSharedByDigest.Add(event.Value)
+	case "versions pruned":
+		emitter.versionsPruned.Add(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -751,6 +843,16 @@ func (emitter *PrometheusEmitter) workerTasksMetric(logger lager.Logger, event m
 	emitter.workerTasks.With(emitter.workerTasksLabels[worker][key]).Set(event.Value)
 }
 
+func (emitter *PrometheusEmitter) workerResourcePressureMetric(logger lager.Logger, gauge *prometheus.GaugeVec, event metric.Event) {
+	worker, exists := event.Attributes["worker"]
+	if !exists {
+		logger.Error("failed-to-find-worker-in-event", fmt.Errorf("expected worker to exist in event.Attributes"))
+		return
+	}
+
+	gauge.WithLabelValues(worker).Set(event.Value)
+}
+
 func (emitter *PrometheusEmitter) httpResponseTimeMetrics(logger lager.Logger, event metric.Event) {
 	route, exists := event.Attributes["route"]
 	if !exists {
@@ -828,6 +930,10 @@ func DoGarbageCollection(emitter PrometheusGarbageCollectable, worker string) {
 		emitter.WorkerTasks().Delete(labels)
 	}
 
+	for _, gauge := range emitter.WorkerResourcePressure() {
+		gauge.Delete(prometheus.Labels{"worker": worker})
+	}
+
 	delete(emitter.WorkerContainersLabels(), worker)
 	delete(emitter.WorkerVolumesLabels(), worker)
 	delete(emitter.WorkerTasksLabels(), worker)
@@ -838,6 +944,7 @@ type PrometheusGarbageCollectable interface {
 	WorkerContainers() *prometheus.GaugeVec
 	WorkerVolumes() *prometheus.GaugeVec
 	WorkerTasks() *prometheus.GaugeVec
+	WorkerResourcePressure() []*prometheus.GaugeVec
 
 	WorkerContainersLabels() map[string]map[string]prometheus.Labels
 	WorkerVolumesLabels() map[string]map[string]prometheus.Labels
@@ -856,6 +963,15 @@ func (emitter *PrometheusEmitter) WorkerTasks() *prometheus.GaugeVec {
 	return emitter.workerTasks
 }
 
+func (emitter *PrometheusEmitter) WorkerResourcePressure() []*prometheus.GaugeVec {
+	return []*prometheus.GaugeVec{
+		emitter.workerCPULoad,
+		emitter.workerMemoryPressure,
+		emitter.workerFreeDisk,
+		emitter.workerOpenFiles,
+	}
+}
+
 func (emitter *PrometheusEmitter) WorkerContainersLabels() map[string]map[string]prometheus.Labels {
 	return emitter.workerContainersLabels
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -121,6 +122,20 @@ func (event ResourceConfigCheckSessionCollectorDuration) Emit(logger lager.Logge
 	)
 }
 
+type VersionRetentionCollectorDuration struct {
+	Duration time.Duration
+}
+
+func (event VersionRetentionCollectorDuration) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("gc-version-retention-collector-duration"),
+		Event{
+			Name:  "gc: version retention collector duration (ms)",
+			Value: ms(event.Duration),
+		},
+	)
+}
+
 type ArtifactCollectorDuration struct {
 	Duration time.Duration
 }
@@ -289,6 +304,45 @@ func (event WorkerTasks) Emit(logger lager.Logger) {
 	)
 }
 
+type WorkerResourcePressure struct {
+	WorkerName string
+	Platform   string
+	Pressure   atc.WorkerResourcePressure
+}
+
+func (event WorkerResourcePressure) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"worker":   event.WorkerName,
+		"platform": event.Platform,
+	}
+
+	logger = logger.Session("worker-resource-pressure")
+
+	Metrics.emit(logger, Event{
+		Name:       "worker cpu load",
+		Value:      event.Pressure.CPULoad,
+		Attributes: attributes,
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "worker memory pressure",
+		Value:      event.Pressure.MemoryPressure,
+		Attributes: attributes,
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "worker free disk",
+		Value:      float64(event.Pressure.FreeDiskBytes),
+		Attributes: attributes,
+	})
+
+	Metrics.emit(logger, Event{
+		Name:       "worker open files",
+		Value:      float64(event.Pressure.OpenFiles),
+		Attributes: attributes,
+	})
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
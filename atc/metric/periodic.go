@@ -75,6 +75,14 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("versions-pruned"),
+		Event{
+			Name:  "versions pruned",
+			Value: m.VersionsPruned.Delta(),
+		},
+	)
+
 	m.emit(
 		logger.Session("volumes-streamed"),
 		Event{
@@ -83,6 +91,14 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("volumes-shared-by-digest"),
+		Event{
+			Name:  "volumes shared by digest",
+			Value: m.VolumesSharedByDigest.Delta(),
+		},
+	)
+
 	m.emit(
 		logger.Session("containers-created"),
 		Event{
@@ -10,6 +10,7 @@ type Plan struct {
 	Task        *TaskPlan        `json:"task,omitempty"`
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Approval    *ApprovalPlan    `json:"approval,omitempty"`
 
 	Do         *DoPlan         `json:"do,omitempty"`
 	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
@@ -291,6 +292,10 @@ type TaskPlan struct {
 	// image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Run the task again on another worker if its worker starts landing while
+	// the task is running, rather than failing the build.
+	Restartable bool `json:"restartable,omitempty"`
+
 	// Resource types to have available for use when fetching the task's image.
 	//
 	// XXX(check-refactor): Eliminating this would be great - if we can replace
@@ -318,6 +323,12 @@ type LoadVarPlan struct {
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+type ApprovalPlan struct {
+	Name      string `json:"name"`
+	Approvers int    `json:"approvers"`
+	Role      string `json:"role"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
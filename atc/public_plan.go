@@ -15,6 +15,7 @@ func (plan Plan) Public() *json.RawMessage {
 		Task           *json.RawMessage `json:"task,omitempty"`
 		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Approval       *json.RawMessage `json:"approval,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
@@ -66,6 +67,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.LoadVar = plan.LoadVar.Public()
 	}
 
+	if plan.Approval != nil {
+		public.Approval = plan.Approval.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -294,6 +299,18 @@ func (plan LoadVarPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan ApprovalPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name      string `json:"name"`
+		Approvers int    `json:"approvers"`
+		Role      string `json:"role"`
+	}{
+		Name:      plan.Name,
+		Approvers: plan.Approvers,
+		Role:      plan.Role,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
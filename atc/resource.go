@@ -8,11 +8,15 @@ type Resource struct {
 	TeamName             string       `json:"team_name"`
 	Type                 string       `json:"type"`
 	LastChecked          int64        `json:"last_checked,omitempty"`
+	NextCheck            int64        `json:"next_check,omitempty"`
+	CheckFailures        int          `json:"check_failures,omitempty"`
 	Icon                 string       `json:"icon,omitempty"`
 
 	PinnedVersion  Version `json:"pinned_version,omitempty"`
 	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
 	PinComment     string  `json:"pin_comment,omitempty"`
+	PinnedBy       string  `json:"pinned_by,omitempty"`
+	PinExpiresAt   int64   `json:"pin_expires_at,omitempty"`
 
 	Build *BuildSummary `json:"build,omitempty"`
 }
@@ -3,3 +3,10 @@ package atc
 type SetPinCommentRequestBody struct {
 	PinComment string `json:"pin_comment"`
 }
+
+// PinVersionRequestBody is the optional body of a request to pin a version.
+type PinVersionRequestBody struct {
+	// The time at which the version is automatically unpinned, in seconds
+	// since the epoch. The pin never expires if this is omitted.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
@@ -13,11 +13,13 @@ const (
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
+	ApproveBuild        = "ApproveBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
 	GetJob         = "GetJob"
 	CreateJobBuild = "CreateJobBuild"
 	RerunJobBuild  = "RerunJobBuild"
+	RunJob         = "RunJob"
 	ListAllJobs    = "ListAllJobs"
 	ListJobs       = "ListJobs"
 	ListJobBuilds  = "ListJobBuilds"
@@ -45,6 +47,8 @@ const (
 	EnableResourceVersion         = "EnableResourceVersion"
 	DisableResourceVersion        = "DisableResourceVersion"
 	PinResourceVersion            = "PinResourceVersion"
+	AnnotateResourceVersion       = "AnnotateResourceVersion"
+	RemoveVersionAnnotation       = "RemoveVersionAnnotation"
 	UnpinResource                 = "UnpinResource"
 	SetPinCommentOnResource       = "SetPinCommentOnResource"
 	ListBuildsWithVersionAsInput  = "ListBuildsWithVersionAsInput"
@@ -76,6 +80,10 @@ const (
 	ListWorkers     = "ListWorkers"
 	DeleteWorker    = "DeleteWorker"
 
+	RequestWorkerDiagnostics = "RequestWorkerDiagnostics"
+	GetWorkerDiagnostics     = "GetWorkerDiagnostics"
+	ReportWorkerDiagnostics  = "ReportWorkerDiagnostics"
+
 	SetLogLevel = "SetLogLevel"
 	GetLogLevel = "GetLogLevel"
 
@@ -100,8 +108,15 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	ListTeamWebhooks          = "ListTeamWebhooks"
+	SetTeamWebhook            = "SetTeamWebhook"
+	DestroyTeamWebhook        = "DestroyTeamWebhook"
+	ReceiveTeamWebhook        = "ReceiveTeamWebhook"
+	ListTeamWebhookDeliveries = "ListTeamWebhookDeliveries"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
+	PatchArtifact      = "PatchArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
 
 	GetUser              = "GetUser"
@@ -115,6 +130,7 @@ const (
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	GetConfigVersion        = "version"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -129,6 +145,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
+	{Path: "/api/v1/builds/:build_id/approval", Method: "PUT", Name: ApproveBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
 
@@ -139,6 +156,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/run", Method: "POST", Name: RunJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -177,6 +195,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/enable", Method: "PUT", Name: EnableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/disable", Method: "PUT", Name: DisableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/pin", Method: "PUT", Name: PinResourceVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/annotations/:label", Method: "PUT", Name: AnnotateResourceVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/annotations/:label", Method: "DELETE", Name: RemoveVersionAnnotation},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/unpin", Method: "PUT", Name: UnpinResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin_comment", Method: "PUT", Name: SetPinCommentOnResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/input_to", Method: "GET", Name: ListBuildsWithVersionAsInput},
@@ -192,6 +212,9 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers/:worker_name/prune", Method: "PUT", Name: PruneWorker},
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
+	{Path: "/api/v1/workers/:worker_name/diagnostics", Method: "PUT", Name: RequestWorkerDiagnostics},
+	{Path: "/api/v1/workers/:worker_name/diagnostics", Method: "GET", Name: GetWorkerDiagnostics},
+	{Path: "/api/v1/workers/:worker_name/diagnostics/report", Method: "PUT", Name: ReportWorkerDiagnostics},
 
 	{Path: "/api/v1/log-level", Method: "GET", Name: GetLogLevel},
 	{Path: "/api/v1/log-level", Method: "PUT", Name: SetLogLevel},
@@ -220,8 +243,15 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
 
+	{Path: "/api/v1/teams/:team_name/webhooks", Method: "GET", Name: ListTeamWebhooks},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name", Method: "PUT", Name: SetTeamWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name", Method: "DELETE", Name: DestroyTeamWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name", Method: "POST", Name: ReceiveTeamWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name/deliveries", Method: "GET", Name: ListTeamWebhookDeliveries},
+
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
+	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id/patch", Method: "POST", Name: PatchArtifact},
 
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
@@ -0,0 +1,12 @@
+package atc
+
+// RunJobRequest is the body of a request to run a job's plan as a one-off
+// build.
+type RunJobRequest struct {
+	// Artifacts maps the names of get steps to the IDs of artifacts to use in
+	// place of fetching the resource.
+	Artifacts map[string]int `json:"artifacts,omitempty"`
+
+	// Vars are interpolated into the job's config before it is planned.
+	Vars map[string]interface{} `json:"vars,omitempty"`
+}
@@ -3241,4 +3241,323 @@ var _ = DescribeTable("Input resolving",
 			},
 		},
 	}),
+
+	Entry("finds versions from builds with any of the allowed statuses", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2, BuildStatus: "failed"},
+				{Job: "simple-a", BuildID: 3, Resource: "resource-x", Version: "rxv3", CheckOrder: 3, BuildStatus: "aborted"},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:           "resource-x",
+				Resource:       "resource-x",
+				Passed:         []string{"simple-a"},
+				PassedStatuses: []string{"succeeded", "failed"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv2",
+			},
+			PassedBuildIDs: map[string][]int{
+				"resource-x": []int{2},
+			},
+		},
+	}),
+
+	Entry("only finds versions from failed builds when only failed builds are allowed", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1, BuildStatus: "failed"},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:           "resource-x",
+				Resource:       "resource-x",
+				Passed:         []string{"simple-a"},
+				PassedStatuses: []string{"failed"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("does not let a build vouch for inputs whose status filter it does not match", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 1, Resource: "resource-y", Version: "ryv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2, BuildStatus: "failed"},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-y", Version: "ryv2", CheckOrder: 2, BuildStatus: "failed"},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:           "resource-x",
+				Resource:       "resource-x",
+				Passed:         []string{"simple-a"},
+				PassedStatuses: []string{"succeeded", "failed"},
+			},
+			{
+				Name:     "resource-y",
+				Resource: "resource-y",
+				Passed:   []string{"simple-a"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+				"resource-y": "ryv1",
+			},
+		},
+	}),
+
+	Entry("returns a missing input reason naming the statuses when no build matches the filter", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:           "resource-x",
+				Resource:       "resource-x",
+				Passed:         []string{"simple-a"},
+				PassedStatuses: []string{"failed", "errored"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "no satisfiable builds from passed jobs with status failed or errored found for set of inputs",
+			},
+		},
+	}),
+
+	Entry("finds the latest version matching the version filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3-rc", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Filter: `ver !~ "-rc$"`},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv2",
+			},
+		},
+	}),
+
+	Entry("finds the next version matching the version filter for inputs that use every version", Example{
+		DB: DB{
+			BuildInputs: []DBRow{
+				{Job: CurrentJobName, BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2-rc", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+				{Resource: "resource-x", Version: "rxv4", CheckOrder: 4},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Every: true, Filter: `ver !~ "-rc$"`},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv3",
+			},
+			HasNext: true,
+		},
+	}),
+
+	Entry("only lets versions matching the version filter through passed constraints", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2-rc", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Version:  Version{Filter: `ver !~ "-rc$"`},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("returns a missing input reason when no version matches the version filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Filter: `ver == "rxv2"`},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "no version of resource matches the version filter",
+			},
+		},
+	}),
+
+	Entry("finds the latest version with all of the required labels", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"qa-approved", "canary-ok"}},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Labels: []string{"qa-approved", "canary-ok"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("finds the next labelled version for inputs that use every version", Example{
+		DB: DB{
+			BuildInputs: []DBRow{
+				{Job: CurrentJobName, BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv4", CheckOrder: 4},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Every: true, Labels: []string{"qa-approved"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv3",
+			},
+			NoNext: true,
+		},
+	}),
+
+	Entry("only lets labelled versions through passed constraints", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"qa-approved"}},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Version:  Version{Labels: []string{"qa-approved"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("returns a missing input reason when no version has the required labels", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"canary-ok"}},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Labels: []string{"qa-approved"}},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "no version of resource has the required labels",
+			},
+		},
+	}),
 )
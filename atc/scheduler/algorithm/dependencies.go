@@ -0,0 +1,54 @@
+package algorithm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// ComputeDependencies resolves each of the job's depends_on jobs to its
+// latest succeeded build. It returns false if any of them has yet to succeed.
+func (a *Algorithm) ComputeDependencies(
+	ctx context.Context,
+	job db.Job,
+	dependencies db.DependencyConfigs,
+) (db.DependencyMapping, bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "Algorithm.ComputeDependencies", tracing.Attrs{
+		"pipeline": job.PipelineName(),
+		"job":      job.Name(),
+	})
+	defer span.End()
+
+	resolved := true
+	mapping := db.DependencyMapping{}
+
+	for _, dependency := range dependencies {
+		buildID, found, err := a.versionsDB.LatestSucceededBuild(ctx, dependency.JobID)
+		if err != nil {
+			return nil, false, fmt.Errorf("latest succeeded build of '%s': %w", dependency.JobName, err)
+		}
+
+		if !found {
+			resolved = false
+			mapping[dependency.JobName] = db.DependencyResult{
+				ResolveError: db.NoSucceededDependencyBuild,
+			}
+
+			continue
+		}
+
+		firstOcc, err := a.versionsDB.IsFirstDependencyOccurrence(ctx, dependency.DependentJobID, dependency.JobID, buildID)
+		if err != nil {
+			return nil, false, fmt.Errorf("first occurrence of '%s': %w", dependency.JobName, err)
+		}
+
+		mapping[dependency.JobName] = db.DependencyResult{
+			BuildID:         buildID,
+			FirstOccurrence: firstOcc,
+		}
+	}
+
+	return mapping, resolved, nil
+}
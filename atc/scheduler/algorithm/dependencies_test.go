@@ -0,0 +1,147 @@
+package algorithm_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gocache "github.com/patrickmn/go-cache"
+)
+
+var _ = Describe("ComputeDependencies", func() {
+	var (
+		unitJob        db.Job
+		integrationJob db.Job
+		dependencies   db.DependencyConfigs
+
+		alg *algorithm.Algorithm
+
+		dependencyMapping db.DependencyMapping
+		resolved          bool
+	)
+
+	BeforeEach(func() {
+		team, err := teamFactory.CreateTeam(atc.Team{Name: "algorithm"})
+		Expect(err).NotTo(HaveOccurred())
+
+		pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "algorithm"}, atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "unit"},
+				{Name: "integration", DependsOn: []string{"unit"}},
+			},
+		}, db.ConfigVersion(0), false)
+		Expect(err).NotTo(HaveOccurred())
+
+		var found bool
+		unitJob, found, err = pipeline.Job("unit")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		integrationJob, found, err = pipeline.Job("integration")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		dependencies, err = integrationJob.AlgorithmDependencies()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dependencies).To(Equal(db.DependencyConfigs{
+			{JobName: "unit", JobID: unitJob.ID(), DependentJobID: integrationJob.ID()},
+		}))
+
+		alg = algorithm.New(db.NewVersionsDB(dbConn, 2, gocache.New(10*time.Second, 10*time.Second)))
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		dependencyMapping, resolved, err = alg.ComputeDependencies(context.Background(), integrationJob, dependencies)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when the dependency has no builds", func() {
+		It("fails to resolve", func() {
+			Expect(resolved).To(BeFalse())
+			Expect(dependencyMapping).To(Equal(db.DependencyMapping{
+				"unit": {ResolveError: db.NoSucceededDependencyBuild},
+			}))
+		})
+	})
+
+	Context("when the dependency has only failed builds", func() {
+		BeforeEach(func() {
+			build, err := unitJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Finish(db.BuildStatusFailed)).To(Succeed())
+		})
+
+		It("fails to resolve", func() {
+			Expect(resolved).To(BeFalse())
+			Expect(dependencyMapping["unit"].ResolveError).To(Equal(db.NoSucceededDependencyBuild))
+		})
+	})
+
+	Context("when the dependency has succeeded", func() {
+		var unitBuild db.Build
+
+		BeforeEach(func() {
+			var err error
+			unitBuild, err = unitJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unitBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			failedBuild, err := unitJob.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(failedBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+		})
+
+		It("resolves to the latest succeeded build as a first occurrence", func() {
+			Expect(resolved).To(BeTrue())
+			Expect(dependencyMapping).To(Equal(db.DependencyMapping{
+				"unit": {BuildID: unitBuild.ID(), FirstOccurrence: true},
+			}))
+		})
+
+		Context("when a build of the job has followed on from it", func() {
+			BeforeEach(func() {
+				mapping, _, err := alg.ComputeDependencies(context.Background(), integrationJob, dependencies)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(integrationJob.SaveNextInputMapping(db.InputMapping{}, true)).To(Succeed())
+				Expect(integrationJob.SaveNextDependencyMapping(mapping)).To(Succeed())
+
+				build, err := integrationJob.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, adopted, err := build.AdoptInputsAndPipes()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(adopted).To(BeTrue())
+			})
+
+			It("is no longer a first occurrence", func() {
+				Expect(resolved).To(BeTrue())
+				Expect(dependencyMapping).To(Equal(db.DependencyMapping{
+					"unit": {BuildID: unitBuild.ID(), FirstOccurrence: false},
+				}))
+			})
+
+			Context("when the dependency succeeds again", func() {
+				var newerBuild db.Build
+
+				BeforeEach(func() {
+					var err error
+					newerBuild, err = unitJob.CreateBuild()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(newerBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+				})
+
+				It("resolves to the newer build as a first occurrence", func() {
+					Expect(dependencyMapping).To(Equal(db.DependencyMapping{
+						"unit": {BuildID: newerBuild.ID(), FirstOccurrence: true},
+					}))
+				})
+			})
+		})
+	})
+})
@@ -173,6 +173,12 @@ func (r *groupResolver) trySatisfyPassedConstraintsForInput(ctx context.Context,
 			break
 		} else {
 			span.SetStatus(codes.NotFound, "")
+
+			if !inputConfig.PassedFilter.IsDefault() {
+				failure := db.NoSatisfiableBuildsMatchingFilter{Filter: inputConfig.PassedFilter}
+				return false, failure.String(), nil
+			}
+
 			return false, db.NoSatisfiableBuilds, nil
 		}
 	}
@@ -220,7 +226,15 @@ func (r *groupResolver) tryBuildOutputs(ctx context.Context, resolvingIdx, jobID
 
 	span.SetAttributes(label.Int("buildID", buildID))
 
-	outputs, err := r.vdb.SuccessfulBuildOutputs(ctx, buildID)
+	filter := r.inputConfigs[resolvingIdx].PassedFilter
+
+	var outputs []db.AlgorithmVersion
+	var err error
+	if filter.IsDefault() {
+		outputs, err = r.vdb.SuccessfulBuildOutputs(ctx, buildID)
+	} else {
+		outputs, err = r.vdb.BuildOutputs(ctx, buildID)
+	}
 	if err != nil {
 		tracing.End(span, err)
 		return false, err
@@ -241,7 +255,7 @@ outputs:
 			}
 
 			var related bool
-			related, mismatch, err = r.outputIsRelatedAndMatches(ctx, span, output, c, jobID)
+			related, mismatch, err = r.outputIsRelatedAndMatches(ctx, span, output, c, jobID, buildID, filter)
 			if err != nil {
 				tracing.End(span, err)
 				return false, err
@@ -381,7 +395,14 @@ func (r *groupResolver) paginatedBuilds(ctx context.Context, currentInputConfig
 			var paginatedBuilds db.PaginatedBuilds
 			var err error
 
-			if currentCandidate != nil {
+			if !currentInputConfig.PassedFilter.IsDefault() {
+				var filterConstraints map[string][]string
+				if currentCandidate != nil {
+					filterConstraints = constraints
+				}
+
+				paginatedBuilds, err = r.vdb.UnusedFilteredBuilds(ctx, passedJobID, lastUsedBuild, currentInputConfig.PassedFilter, filterConstraints)
+			} else if currentCandidate != nil {
 				paginatedBuilds, err = r.vdb.UnusedBuildsVersionConstrained(ctx, passedJobID, lastUsedBuild, constraints)
 			} else {
 				paginatedBuilds, err = r.vdb.UnusedBuilds(ctx, passedJobID, lastUsedBuild)
@@ -400,6 +421,15 @@ func (r *groupResolver) paginatedBuilds(ctx context.Context, currentInputConfig
 		}
 	}
 
+	if !currentInputConfig.PassedFilter.IsDefault() {
+		var filterConstraints map[string][]string
+		if currentCandidate != nil {
+			filterConstraints = constraints
+		}
+
+		return r.vdb.FilteredBuilds(ctx, passedJobID, currentInputConfig.PassedFilter, filterConstraints), false, nil
+	}
+
 	var paginatedBuilds db.PaginatedBuilds
 	var err error
 	if currentCandidate != nil {
@@ -423,7 +453,7 @@ func (r *groupResolver) constrainingCandidates(passedJobID int) map[string][]str
 	return constrainingCandidates
 }
 
-func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trace.Span, output db.AlgorithmVersion, candidateIdx int, passedJobID int) (bool, bool, error) {
+func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trace.Span, output db.AlgorithmVersion, candidateIdx int, passedJobID int, passedBuildID int, passedFilter db.BuildFilter) (bool, bool, error) {
 	inputConfig := r.inputConfigs[candidateIdx]
 	candidate := r.candidates[candidateIdx]
 
@@ -459,6 +489,42 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		return false, false, nil
 	}
 
+	matches, err := r.vdb.VersionMatchesFilter(ctx, output.ResourceID, output.Version, inputConfig.VersionFilter, inputConfig.VersionLabels)
+	if err != nil {
+		return false, false, err
+	}
+
+	if !matches {
+		// this version does not pass the input's version filter or lacks
+		// one of its labels
+		span.AddEvent(
+			ctx,
+			"version filtered out",
+			label.Int("resourceID", output.ResourceID),
+			label.String("version", string(output.Version)),
+		)
+		return false, false, nil
+	}
+
+	if !inputConfig.PassedFilter.Equal(passedFilter) {
+		// the build was found using another input's filter, so make sure it
+		// also satisfies this one's
+		matches, err := r.vdb.BuildMatchesFilter(ctx, passedBuildID, inputConfig.PassedFilter)
+		if err != nil {
+			return false, false, err
+		}
+
+		if !matches {
+			span.AddEvent(
+				ctx,
+				"build does not match filter",
+				label.Int("resourceID", output.ResourceID),
+				label.Int("buildID", passedBuildID),
+			)
+			return false, false, nil
+		}
+	}
+
 	if inputConfig.PinnedVersion != nil && r.pins[candidateIdx] != output.Version {
 		// input is both pinned and assigned a 'passed' constraint, but the pinned
 		// version doesn't match the job's output version
@@ -38,7 +38,7 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 	if r.inputConfig.UseEveryVersion {
 		var found bool
 		var err error
-		version, hasNext, found, err = r.vdb.NextEveryVersion(ctx, r.inputConfig.JobID, r.inputConfig.ResourceID)
+		version, hasNext, found, err = r.vdb.NextEveryVersion(ctx, r.inputConfig.JobID, r.inputConfig.ResourceID, r.inputConfig.VersionFilter, r.inputConfig.VersionLabels)
 		if err != nil {
 			tracing.End(span, err)
 			return nil, "", err
@@ -47,7 +47,7 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 		if !found {
 			span.AddEvent(ctx, "next every version not found")
 			span.SetStatus(codes.NotFound, "next every version not found")
-			return nil, db.VersionNotFound, nil
+			return nil, r.notFound(db.VersionNotFound), nil
 		}
 
 		span.AddEvent(ctx, "found via every", label.String("version", string(version)))
@@ -55,7 +55,7 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 		// there are no passed constraints, so just take the latest version
 		var err error
 		var found bool
-		version, found, err = r.vdb.LatestVersionOfResource(ctx, r.inputConfig.ResourceID)
+		version, found, err = r.vdb.LatestVersionOfResource(ctx, r.inputConfig.ResourceID, r.inputConfig.VersionFilter, r.inputConfig.VersionLabels)
 		if err != nil {
 			tracing.End(span, err)
 			return nil, "", err
@@ -64,7 +64,7 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 		if !found {
 			span.AddEvent(ctx, "latest version not found")
 			span.SetStatus(codes.NotFound, "latest version not found")
-			return nil, db.LatestVersionNotFound, nil
+			return nil, r.notFound(db.LatestVersionNotFound), nil
 		}
 
 		span.AddEvent(ctx, "found via latest", label.String("version", string(version)))
@@ -80,3 +80,15 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 	span.SetStatus(codes.OK, "")
 	return versionCandidates, "", nil
 }
+
+func (r *individualResolver) notFound(failure db.ResolutionFailure) db.ResolutionFailure {
+	if !r.inputConfig.VersionFilter.IsZero() {
+		return db.NoVersionMatchesFilter
+	}
+
+	if len(r.inputConfig.VersionLabels) != 0 {
+		return db.NoVersionHasLabels
+	}
+
+	return failure
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
+	"github.com/concourse/concourse/atc/versionfilter"
 	"github.com/concourse/concourse/tracing"
 	"github.com/lib/pq"
 	"github.com/onsi/ginkgo"
@@ -39,6 +40,7 @@ type DBRow struct {
 	CheckOrder            int
 	VersionID             int
 	Disabled              bool
+	Labels                []string
 	FromBuildID           int
 	ToBuildID             int
 	RerunOfBuildID        int
@@ -62,6 +64,7 @@ type Input struct {
 	Name                  string
 	Resource              string
 	Passed                []string
+	PassedStatuses        []string
 	Version               Version
 	NoResourceConfigScope bool
 }
@@ -70,6 +73,8 @@ type Version struct {
 	Every  bool
 	Latest bool
 	Pinned string
+	Filter string
+	Labels []string
 }
 
 type Result struct {
@@ -166,9 +171,19 @@ func (example Example) Run() {
 			Passed:          passed,
 			ResourceID:      setup.resourceIDs.ID(input.Resource),
 			UseEveryVersion: input.Version.Every,
+			VersionLabels:   input.Version.Labels,
 			JobID:           setup.jobIDs.ID(CurrentJobName),
 		}
 
+		if input.Version.Filter != "" {
+			inputConfigs[i].VersionFilter, err = versionfilter.Parse(input.Version.Filter)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		for _, status := range input.PassedStatuses {
+			inputConfigs[i].PassedFilter.Statuses = append(inputConfigs[i].PassedFilter.Statuses, db.BuildStatus(status))
+		}
+
 		if len(input.Version.Pinned) != 0 {
 			inputConfigs[i].PinnedVersion = atc.Version{"ver": input.Version.Pinned}
 
@@ -804,6 +819,15 @@ func (s setupDB) insertRowVersion(resources map[string]atc.ResourceConfig, row D
 			Exec()
 		Expect(err).ToNot(HaveOccurred())
 	}
+
+	for _, label := range row.Labels {
+		_, err = s.psql.Insert("resource_version_annotations").
+			Columns("resource_config_version_id", "label").
+			Values(versionID, label).
+			Suffix("ON CONFLICT DO NOTHING").
+			Exec()
+		Expect(err).ToNot(HaveOccurred())
+	}
 }
 
 func (s setupDB) insertRowBuild(row DBRow, needsV6Migration bool) {
@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// CronTrigger creates builds for jobs whose schedule has come due since it
+// was last evaluated.
+//
+// Any number of ticks missed in the meantime, e.g. while the job was paused,
+// result in a single build.
+type CronTrigger struct {
+	jobFactory db.JobFactory
+	clock      clock.Clock
+}
+
+func NewCronTrigger(jobFactory db.JobFactory, clock clock.Clock) *CronTrigger {
+	return &CronTrigger{
+		jobFactory: jobFactory,
+		clock:      clock,
+	}
+}
+
+func (t *CronTrigger) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("cron-trigger")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	jobs, err := t.jobFactory.JobsWithSchedules()
+	if err != nil {
+		return fmt.Errorf("find jobs with schedules: %w", err)
+	}
+
+	now := t.clock.Now()
+
+	for _, job := range jobs {
+		jLog := logger.WithData(lager.Data{
+			"pipeline": job.PipelineName(),
+			"job":      job.Name(),
+		})
+
+		due, err := t.isDue(job, now)
+		if err != nil {
+			jLog.Error("failed-to-evaluate-schedule", err)
+			continue
+		}
+
+		if !due {
+			continue
+		}
+
+		build, created, err := job.CreateScheduledBuild(now)
+		if err != nil {
+			jLog.Error("failed-to-create-scheduled-build", err)
+			continue
+		}
+
+		if !created {
+			jLog.Debug("schedule-already-evaluated")
+			continue
+		}
+
+		jLog.Info("created-scheduled-build", lager.Data{"build": build.Name()})
+	}
+
+	return nil
+}
+
+func (t *CronTrigger) isDue(job db.Job, now time.Time) (bool, error) {
+	config, err := job.Config()
+	if err != nil {
+		return false, err
+	}
+
+	for _, schedule := range config.Schedules {
+		next, err := schedule.Next(job.ID(), job.ScheduleEvaluatedTime())
+		if err != nil {
+			return false, err
+		}
+
+		if !next.IsZero() && !next.After(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,179 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/scheduler"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CronTrigger", func() {
+	var (
+		fakeJobFactory *dbfakes.FakeJobFactory
+		fakeJob        *dbfakes.FakeJob
+		fakeClock      *fakeclock.FakeClock
+
+		lastEvaluated time.Time
+		now           time.Time
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeJobFactory = new(dbfakes.FakeJobFactory)
+
+		lastEvaluated = time.Date(2021, time.January, 4, 8, 30, 0, 0, time.UTC)
+		now = time.Date(2021, time.January, 4, 9, 0, 0, 0, time.UTC)
+
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		fakeJob = new(dbfakes.FakeJob)
+		fakeJob.IDReturns(1)
+		fakeJob.NameReturns("some-job")
+		fakeJob.ScheduleEvaluatedTimeReturns(lastEvaluated)
+		fakeJob.ConfigReturns(atc.JobConfig{
+			Name:      "some-job",
+			Schedules: []atc.JobSchedule{{Cron: "0 9 * * *"}},
+		}, nil)
+
+		fakeBuild := new(dbfakes.FakeBuild)
+		fakeBuild.NameReturns("42")
+		fakeJob.CreateScheduledBuildReturns(fakeBuild, true, nil)
+
+		fakeJobFactory.JobsWithSchedulesReturns(db.Jobs{fakeJob}, nil)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = NewCronTrigger(fakeJobFactory, fakeClock).Run(ctx)
+	})
+
+	It("succeeds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+	})
+
+	Context("when the schedule has come due", func() {
+		It("creates a scheduled build evaluated up to now", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+			Expect(fakeJob.CreateScheduledBuildArgsForCall(0)).To(Equal(now))
+		})
+	})
+
+	Context("when several ticks have been missed", func() {
+		BeforeEach(func() {
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name:      "some-job",
+				Schedules: []atc.JobSchedule{{Cron: "*/5 * * * *"}},
+			}, nil)
+		})
+
+		It("creates only one build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the schedule has not come due", func() {
+		BeforeEach(func() {
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name:      "some-job",
+				Schedules: []atc.JobSchedule{{Cron: "0 10 * * *"}},
+			}, nil)
+		})
+
+		It("does not create a build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+		})
+
+		Context("but another of the job's schedules has", func() {
+			BeforeEach(func() {
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Name: "some-job",
+					Schedules: []atc.JobSchedule{
+						{Cron: "0 10 * * *"},
+						{Cron: "45 8 * * *"},
+					},
+				}, nil)
+			})
+
+			It("creates a build", func() {
+				Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the schedule's location puts the tick in the future", func() {
+		BeforeEach(func() {
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name:      "some-job",
+				Schedules: []atc.JobSchedule{{Cron: "0 9 * * *", Location: "America/New_York"}},
+			}, nil)
+		})
+
+		It("does not create a build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the schedule has already been evaluated elsewhere", func() {
+		BeforeEach(func() {
+			fakeJob.CreateScheduledBuildReturns(nil, false, nil)
+		})
+
+		It("succeeds", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when the job's config is invalid", func() {
+		var otherJob *dbfakes.FakeJob
+
+		BeforeEach(func() {
+			fakeJob.ConfigReturns(atc.JobConfig{}, errors.New("nope"))
+
+			otherJob = new(dbfakes.FakeJob)
+			otherJob.ScheduleEvaluatedTimeReturns(lastEvaluated)
+			otherJob.ConfigReturns(atc.JobConfig{
+				Name:      "other-job",
+				Schedules: []atc.JobSchedule{{Cron: "0 9 * * *"}},
+			}, nil)
+			otherJob.CreateScheduledBuildReturns(new(dbfakes.FakeBuild), true, nil)
+
+			fakeJobFactory.JobsWithSchedulesReturns(db.Jobs{fakeJob, otherJob}, nil)
+		})
+
+		It("continues on to the other jobs", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+			Expect(otherJob.CreateScheduledBuildCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when creating the build fails", func() {
+		BeforeEach(func() {
+			fakeJob.CreateScheduledBuildReturns(nil, false, errors.New("disaster"))
+		})
+
+		It("does not fail the run", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when finding the jobs fails", func() {
+		BeforeEach(func() {
+			fakeJobFactory.JobsWithSchedulesReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError(ContainSubstring("disaster")))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// PinExpirer unpins resource versions whose pin has expired, recording each
+// unpin in the audit log on behalf of the system.
+type PinExpirer struct {
+	resourceFactory db.ResourceFactory
+	auditor         auditor.Auditor
+	clock           clock.Clock
+}
+
+func NewPinExpirer(resourceFactory db.ResourceFactory, auditor auditor.Auditor, clock clock.Clock) *PinExpirer {
+	return &PinExpirer{
+		resourceFactory: resourceFactory,
+		auditor:         auditor,
+		clock:           clock,
+	}
+}
+
+func (e *PinExpirer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("pin-expirer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	now := e.clock.Now()
+
+	resources, err := e.resourceFactory.ResourcesWithExpiredPins(now)
+	if err != nil {
+		return fmt.Errorf("find resources with expired pins: %w", err)
+	}
+
+	for _, resource := range resources {
+		data := lager.Data{
+			"team":       resource.TeamName(),
+			"pipeline":   resource.PipelineName(),
+			"resource":   resource.Name(),
+			"version":    resource.APIPinnedVersion(),
+			"pinned_by":  resource.PinnedBy(),
+			"expired_at": resource.PinExpiresAt(),
+		}
+
+		unpinned, err := resource.UnpinExpiredVersion(now)
+		if err != nil {
+			logger.Error("failed-to-unpin-expired-version", err, data)
+			continue
+		}
+
+		if !unpinned {
+			continue
+		}
+
+		logger.Info("unpinned-expired-version", data)
+
+		e.auditor.AuditEvent(atc.UnpinResource, "system", data)
+	}
+
+	return nil
+}
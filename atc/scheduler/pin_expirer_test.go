@@ -0,0 +1,123 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/auditor/auditorfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/scheduler"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PinExpirer", func() {
+	var (
+		fakeResourceFactory *dbfakes.FakeResourceFactory
+		fakeResource        *dbfakes.FakeResource
+		fakeAuditor         *auditorfakes.FakeAuditor
+		fakeClock           *fakeclock.FakeClock
+
+		now       time.Time
+		expiresAt time.Time
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeResourceFactory = new(dbfakes.FakeResourceFactory)
+		fakeAuditor = new(auditorfakes.FakeAuditor)
+
+		now = time.Date(2021, time.January, 4, 9, 0, 0, 0, time.UTC)
+		expiresAt = now.Add(-time.Minute)
+
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.TeamNameReturns("some-team")
+		fakeResource.PipelineNameReturns("some-pipeline")
+		fakeResource.NameReturns("some-resource")
+		fakeResource.APIPinnedVersionReturns(atc.Version{"ref": "abc"})
+		fakeResource.PinnedByReturns("some-user")
+		fakeResource.PinExpiresAtReturns(expiresAt)
+		fakeResource.UnpinExpiredVersionReturns(true, nil)
+
+		fakeResourceFactory.ResourcesWithExpiredPinsReturns([]db.Resource{fakeResource}, nil)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = NewPinExpirer(fakeResourceFactory, fakeAuditor, fakeClock).Run(ctx)
+	})
+
+	It("succeeds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+	})
+
+	It("looks for pins that expired by now", func() {
+		Expect(fakeResourceFactory.ResourcesWithExpiredPinsCallCount()).To(Equal(1))
+		Expect(fakeResourceFactory.ResourcesWithExpiredPinsArgsForCall(0)).To(Equal(now))
+	})
+
+	It("unpins the expired version", func() {
+		Expect(fakeResource.UnpinExpiredVersionCallCount()).To(Equal(1))
+		Expect(fakeResource.UnpinExpiredVersionArgsForCall(0)).To(Equal(now))
+	})
+
+	It("records the unpin in the audit log", func() {
+		Expect(fakeAuditor.AuditEventCallCount()).To(Equal(1))
+
+		action, userName, data := fakeAuditor.AuditEventArgsForCall(0)
+		Expect(action).To(Equal(atc.UnpinResource))
+		Expect(userName).To(Equal("system"))
+		Expect(data).To(Equal(lager.Data{
+			"team":       "some-team",
+			"pipeline":   "some-pipeline",
+			"resource":   "some-resource",
+			"version":    atc.Version{"ref": "abc"},
+			"pinned_by":  "some-user",
+			"expired_at": expiresAt,
+		}))
+	})
+
+	Context("when the pin was changed in the meantime", func() {
+		BeforeEach(func() {
+			fakeResource.UnpinExpiredVersionReturns(false, nil)
+		})
+
+		It("does not record an unpin", func() {
+			Expect(fakeAuditor.AuditEventCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when unpinning fails", func() {
+		BeforeEach(func() {
+			fakeResource.UnpinExpiredVersionReturns(false, errors.New("disaster"))
+		})
+
+		It("does not fail", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+
+		It("does not record an unpin", func() {
+			Expect(fakeAuditor.AuditEventCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when finding resources fails", func() {
+		BeforeEach(func() {
+			fakeResourceFactory.ResourcesWithExpiredPinsReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError(ContainSubstring("disaster")))
+		})
+	})
+})
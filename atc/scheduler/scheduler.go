@@ -18,6 +18,12 @@ type Algorithm interface {
 		db.Job,
 		db.InputConfigs,
 	) (db.InputMapping, bool, bool, error)
+
+	ComputeDependencies(
+		context.Context,
+		db.Job,
+		db.DependencyConfigs,
+	) (db.DependencyMapping, bool, error)
 }
 
 type Scheduler struct {
@@ -47,12 +53,39 @@ func (s *Scheduler) Schedule(
 		}
 	}
 
+	jobDependencies, err := job.AlgorithmDependencies()
+	if err != nil {
+		return false, fmt.Errorf("dependencies: %w", err)
+	}
+
+	var dependencyMapping db.DependencyMapping
+	if len(jobDependencies) != 0 {
+		var dependenciesResolved bool
+		dependencyMapping, dependenciesResolved, err = s.Algorithm.ComputeDependencies(ctx, job, jobDependencies)
+		if err != nil {
+			return false, fmt.Errorf("compute dependencies: %w", err)
+		}
+
+		if !dependenciesResolved {
+			logger.Debug("dependencies-not-satisfied")
+		}
+
+		resolved = resolved && dependenciesResolved
+	}
+
 	err = job.SaveNextInputMapping(inputMapping, resolved)
 	if err != nil {
 		return false, fmt.Errorf("save next input mapping: %w", err)
 	}
 
-	err = s.ensurePendingBuildExists(ctx, logger, job, jobInputs)
+	if len(dependencyMapping) != 0 {
+		err = job.SaveNextDependencyMapping(dependencyMapping)
+		if err != nil {
+			return false, fmt.Errorf("save next dependency mapping: %w", err)
+		}
+	}
+
+	err = s.ensurePendingBuildExists(ctx, logger, job, jobInputs, dependencyMapping)
 	if err != nil {
 		return false, err
 	}
@@ -65,6 +98,7 @@ func (s *Scheduler) ensurePendingBuildExists(
 	logger lager.Logger,
 	job db.SchedulerJob,
 	jobInputs db.InputConfigs,
+	dependencyMapping db.DependencyMapping,
 ) error {
 	buildInputs, satisfiableInputs, err := job.GetFullNextBuildInputs()
 	if err != nil {
@@ -82,33 +116,60 @@ func (s *Scheduler) ensurePendingBuildExists(
 	}
 
 	var hasNewInputs bool
+	var triggeringInput *db.BuildInput
 	for _, inputConfig := range jobInputs {
 		inputSource, ok := inputMapping[inputConfig.Name]
+		if !ok || !inputSource.FirstOccurrence {
+			continue
+		}
+
+		hasNewInputs = true
 
 		//trigger: true, and the version has not been used
-		if ok && inputSource.FirstOccurrence {
+		if inputConfig.Trigger && triggeringInput == nil {
+			triggeringInput = &inputSource
+		}
+	}
+
+	var succeededDependency string
+	var succeededBuildID int
+	for jobName, dependency := range dependencyMapping {
+		if dependency.FirstOccurrence {
 			hasNewInputs = true
-			if inputConfig.Trigger {
-				version, _ := json.Marshal(inputSource.Version)
-				spanCtx, _ := tracing.StartSpanLinkedToFollowing(
-					ctx,
-					inputSource,
-					"job.EnsurePendingBuildExists",
-					tracing.Attrs{
-						"team":     job.TeamName(),
-						"pipeline": job.PipelineName(),
-						"job":      job.Name(),
-						"input":    inputSource.Name,
-						"version":  string(version),
-					},
-				)
-				err := job.EnsurePendingBuildExists(spanCtx)
-				if err != nil {
-					return fmt.Errorf("ensure pending build exists: %w", err)
-				}
-
-				break
-			}
+			succeededDependency = jobName
+			succeededBuildID = dependency.BuildID
+			break
+		}
+	}
+
+	switch {
+	case triggeringInput != nil:
+		version, _ := json.Marshal(triggeringInput.Version)
+		spanCtx, _ := tracing.StartSpanLinkedToFollowing(
+			ctx,
+			*triggeringInput,
+			"job.EnsurePendingBuildExists",
+			tracing.Attrs{
+				"team":     job.TeamName(),
+				"pipeline": job.PipelineName(),
+				"job":      job.Name(),
+				"input":    triggeringInput.Name,
+				"version":  string(version),
+			},
+		)
+
+		err := job.EnsurePendingBuildExists(spanCtx)
+		if err != nil {
+			return fmt.Errorf("ensure pending build exists: %w", err)
+		}
+
+	case succeededDependency != "":
+		// dependencies always trigger, as they have no other purpose
+		logger.Debug("dependency-succeeded", lager.Data{"job": succeededDependency, "build": succeededBuildID})
+
+		err := job.EnsurePendingBuildExists(ctx)
+		if err != nil {
+			return fmt.Errorf("ensure pending build exists: %w", err)
 		}
 	}
 
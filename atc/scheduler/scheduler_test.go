@@ -464,6 +464,151 @@ var _ = Describe("Scheduler", func() {
 			})
 		})
 
+		Context("when the job depends on other jobs", func() {
+			var dependencies db.DependencyConfigs
+
+			BeforeEach(func() {
+				fakeJob.NameReturns("some-job")
+				fakeJob.AlgorithmInputsReturns(nil, nil)
+				fakeAlgorithm.ComputeReturns(db.InputMapping{}, true, false, nil)
+				fakeJob.GetFullNextBuildInputsReturns([]db.BuildInput{}, true, nil)
+				fakeBuildStarter.TryStartPendingBuildsForJobReturns(false, nil)
+
+				dependencies = db.DependencyConfigs{
+					{JobName: "unit", JobID: 2, DependentJobID: 1},
+					{JobName: "lint", JobID: 3, DependentJobID: 1},
+				}
+				fakeJob.AlgorithmDependenciesReturns(dependencies, nil)
+			})
+
+			It("computes the dependencies", func() {
+				Expect(fakeAlgorithm.ComputeDependenciesCallCount()).To(Equal(1))
+				_, actualJob, actualDependencies := fakeAlgorithm.ComputeDependenciesArgsForCall(0)
+				Expect(actualJob.Name()).To(Equal("some-job"))
+				Expect(actualDependencies).To(Equal(dependencies))
+			})
+
+			Context("when fetching the dependencies fails", func() {
+				BeforeEach(func() {
+					fakeJob.AlgorithmDependenciesReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(scheduleErr).To(Equal(fmt.Errorf("dependencies: %w", disaster)))
+				})
+			})
+
+			Context("when computing the dependencies fails", func() {
+				BeforeEach(func() {
+					fakeAlgorithm.ComputeDependenciesReturns(nil, false, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(scheduleErr).To(Equal(fmt.Errorf("compute dependencies: %w", disaster)))
+				})
+			})
+
+			Context("when a dependency has not succeeded yet", func() {
+				var dependencyMapping db.DependencyMapping
+
+				BeforeEach(func() {
+					dependencyMapping = db.DependencyMapping{
+						"unit": {BuildID: 10, FirstOccurrence: true},
+						"lint": {ResolveError: db.NoSucceededDependencyBuild},
+					}
+					fakeAlgorithm.ComputeDependenciesReturns(dependencyMapping, false, nil)
+				})
+
+				It("saves the inputs as not determined", func() {
+					Expect(fakeJob.SaveNextInputMappingCallCount()).To(Equal(1))
+					_, resolved := fakeJob.SaveNextInputMappingArgsForCall(0)
+					Expect(resolved).To(BeFalse())
+				})
+
+				It("saves the dependency mapping", func() {
+					Expect(fakeJob.SaveNextDependencyMappingCallCount()).To(Equal(1))
+					Expect(fakeJob.SaveNextDependencyMappingArgsForCall(0)).To(Equal(dependencyMapping))
+				})
+			})
+
+			Context("when a dependency has a new succeeded build", func() {
+				BeforeEach(func() {
+					fakeAlgorithm.ComputeDependenciesReturns(db.DependencyMapping{
+						"unit": {BuildID: 10, FirstOccurrence: true},
+						"lint": {BuildID: 11, FirstOccurrence: false},
+					}, true, nil)
+				})
+
+				It("saves the inputs as determined", func() {
+					Expect(fakeJob.SaveNextInputMappingCallCount()).To(Equal(1))
+					_, resolved := fakeJob.SaveNextInputMappingArgsForCall(0)
+					Expect(resolved).To(BeTrue())
+				})
+
+				It("creates a pending build", func() {
+					Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+				})
+
+				It("marks the job as having new inputs", func() {
+					Expect(fakeJob.SetHasNewInputsCallCount()).To(Equal(1))
+					Expect(fakeJob.SetHasNewInputsArgsForCall(0)).To(BeTrue())
+				})
+
+				Context("when saving the dependency mapping fails", func() {
+					BeforeEach(func() {
+						fakeJob.SaveNextDependencyMappingReturns(disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(fmt.Errorf("save next dependency mapping: %w", disaster)))
+					})
+				})
+
+				Context("when a trigger: true input already created a pending build", func() {
+					BeforeEach(func() {
+						fakeJob.AlgorithmInputsReturns(db.InputConfigs{{Name: "a", Trigger: true}}, nil)
+						fakeJob.GetFullNextBuildInputsReturns([]db.BuildInput{
+							{
+								Name:            "a",
+								Version:         atc.Version{"ref": "v1"},
+								ResourceID:      11,
+								FirstOccurrence: true,
+							},
+						}, true, nil)
+					})
+
+					It("creates only one pending build", func() {
+						Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when no dependency has a new succeeded build", func() {
+				BeforeEach(func() {
+					fakeAlgorithm.ComputeDependenciesReturns(db.DependencyMapping{
+						"unit": {BuildID: 10, FirstOccurrence: false},
+						"lint": {BuildID: 11, FirstOccurrence: false},
+					}, true, nil)
+				})
+
+				It("doesn't create a pending build", func() {
+					Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the job has no dependencies", func() {
+			BeforeEach(func() {
+				fakeJob.AlgorithmInputsReturns(nil, nil)
+				fakeAlgorithm.ComputeReturns(db.InputMapping{}, true, false, nil)
+			})
+
+			It("does not compute or save dependencies", func() {
+				Expect(fakeAlgorithm.ComputeDependenciesCallCount()).To(BeZero())
+				Expect(fakeJob.SaveNextDependencyMappingCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when the job inputs fail to fetch", func() {
 			BeforeEach(func() {
 				fakeJob.AlgorithmInputsReturns(nil, disaster)
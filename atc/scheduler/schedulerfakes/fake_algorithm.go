@@ -29,6 +29,23 @@ type FakeAlgorithm struct {
 		result3 bool
 		result4 error
 	}
+	ComputeDependenciesStub        func(context.Context, db.Job, db.DependencyConfigs) (db.DependencyMapping, bool, error)
+	computeDependenciesMutex       sync.RWMutex
+	computeDependenciesArgsForCall []struct {
+		arg1 context.Context
+		arg2 db.Job
+		arg3 db.DependencyConfigs
+	}
+	computeDependenciesReturns struct {
+		result1 db.DependencyMapping
+		result2 bool
+		result3 error
+	}
+	computeDependenciesReturnsOnCall map[int]struct {
+		result1 db.DependencyMapping
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -104,11 +121,81 @@ func (fake *FakeAlgorithm) ComputeReturnsOnCall(i int, result1 db.InputMapping,
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeAlgorithm) ComputeDependencies(arg1 context.Context, arg2 db.Job, arg3 db.DependencyConfigs) (db.DependencyMapping, bool, error) {
+	fake.computeDependenciesMutex.Lock()
+	ret, specificReturn := fake.computeDependenciesReturnsOnCall[len(fake.computeDependenciesArgsForCall)]
+	fake.computeDependenciesArgsForCall = append(fake.computeDependenciesArgsForCall, struct {
+		arg1 context.Context
+		arg2 db.Job
+		arg3 db.DependencyConfigs
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ComputeDependencies", []interface{}{arg1, arg2, arg3})
+	fake.computeDependenciesMutex.Unlock()
+	if fake.ComputeDependenciesStub != nil {
+		return fake.ComputeDependenciesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.computeDependenciesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAlgorithm) ComputeDependenciesCallCount() int {
+	fake.computeDependenciesMutex.RLock()
+	defer fake.computeDependenciesMutex.RUnlock()
+	return len(fake.computeDependenciesArgsForCall)
+}
+
+func (fake *FakeAlgorithm) ComputeDependenciesCalls(stub func(context.Context, db.Job, db.DependencyConfigs) (db.DependencyMapping, bool, error)) {
+	fake.computeDependenciesMutex.Lock()
+	defer fake.computeDependenciesMutex.Unlock()
+	fake.ComputeDependenciesStub = stub
+}
+
+func (fake *FakeAlgorithm) ComputeDependenciesArgsForCall(i int) (context.Context, db.Job, db.DependencyConfigs) {
+	fake.computeDependenciesMutex.RLock()
+	defer fake.computeDependenciesMutex.RUnlock()
+	argsForCall := fake.computeDependenciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAlgorithm) ComputeDependenciesReturns(result1 db.DependencyMapping, result2 bool, result3 error) {
+	fake.computeDependenciesMutex.Lock()
+	defer fake.computeDependenciesMutex.Unlock()
+	fake.ComputeDependenciesStub = nil
+	fake.computeDependenciesReturns = struct {
+		result1 db.DependencyMapping
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAlgorithm) ComputeDependenciesReturnsOnCall(i int, result1 db.DependencyMapping, result2 bool, result3 error) {
+	fake.computeDependenciesMutex.Lock()
+	defer fake.computeDependenciesMutex.Unlock()
+	fake.ComputeDependenciesStub = nil
+	if fake.computeDependenciesReturnsOnCall == nil {
+		fake.computeDependenciesReturnsOnCall = make(map[int]struct {
+			result1 db.DependencyMapping
+			result2 bool
+			result3 error
+		})
+	}
+	fake.computeDependenciesReturnsOnCall[i] = struct {
+		result1 db.DependencyMapping
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeAlgorithm) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.computeMutex.RLock()
 	defer fake.computeMutex.RUnlock()
+	fake.computeDependenciesMutex.RLock()
+	defer fake.computeDependenciesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
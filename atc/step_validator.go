@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/concourse/concourse/atc/versionfilter"
 )
 
 // StepValidator is a StepVisitor which validates each step that visits it,
@@ -131,6 +133,26 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 		validator.recordError("unknown resource '%s'", resourceName)
 	}
 
+	if step.Version != nil && step.Version.Filter != "" {
+		_, err := versionfilter.Parse(step.Version.Filter)
+		if err != nil {
+			validator.pushContext(".version.filter")
+			validator.recordError("invalid filter: %s", err)
+			validator.popContext()
+		}
+	}
+
+	if step.Version != nil {
+		for i, label := range step.Version.Labels {
+			err := ValidateVersionLabel(label)
+			if err != nil {
+				validator.pushContext(fmt.Sprintf(".version.labels[%d]", i))
+				validator.recordError("%s", err)
+				validator.popContext()
+			}
+		}
+	}
+
 	validator.pushContext(".passed")
 
 	for _, job := range step.Passed {
@@ -162,6 +184,27 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 		}
 	}
 
+	if len(step.Passed) == 0 && (len(step.PassedStatuses) != 0 || step.PassedMaxAge != "") {
+		validator.recordError("no jobs to apply status or max_age to")
+	}
+
+	for _, status := range step.PassedStatuses {
+		switch status {
+		case StatusSucceeded, StatusFailed, StatusErrored, StatusAborted:
+		default:
+			validator.recordError("unknown status '%s' (must be one of succeeded, failed, errored, or aborted)", status)
+		}
+	}
+
+	if step.PassedMaxAge != "" {
+		maxAge, err := time.ParseDuration(step.PassedMaxAge)
+		if err != nil {
+			validator.recordError("invalid max_age: %s", err)
+		} else if maxAge <= 0 {
+			validator.recordError("max_age must be positive")
+		}
+	}
+
 	validator.popContext()
 
 	return nil
@@ -229,6 +272,31 @@ func (validator *StepValidator) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+func (validator *StepValidator) VisitApproval(step *ApprovalStep) error {
+	validator.pushContext(".approval(%s)", step.Name)
+	defer validator.popContext()
+
+	warning, err := ValidateIdentifier(step.Name, validator.context...)
+	if err != nil {
+		validator.recordError(err.Error())
+	}
+	if warning != nil {
+		validator.recordWarning(*warning)
+	}
+
+	if step.Approvers < 0 {
+		validator.recordError("approvers must not be negative")
+	}
+
+	switch step.Role {
+	case "", "owner", "member", "pipeline-operator":
+	default:
+		validator.recordError("unknown role '%s' (must be one of owner, member, or pipeline-operator)", step.Role)
+	}
+
+	return nil
+}
+
 func (validator *StepValidator) VisitTry(step *TryStep) error {
 	validator.pushContext(".try")
 	defer validator.popContext()
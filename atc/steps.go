@@ -193,6 +193,7 @@ type StepVisitor interface {
 	VisitPut(*PutStep) error
 	VisitSetPipeline(*SetPipelineStep) error
 	VisitLoadVar(*LoadVarStep) error
+	VisitApproval(*ApprovalStep) error
 	VisitTry(*TryStep) error
 	VisitDo(*DoStep) error
 	VisitInParallel(*InParallelStep) error
@@ -273,6 +274,10 @@ var StepPrecedence = []StepDetector{
 		Key: "load_var",
 		New: func() StepConfig { return &LoadVarStep{} },
 	},
+	{
+		Key: "approval",
+		New: func() StepConfig { return &ApprovalStep{} },
+	},
 	{
 		Key: "try",
 		New: func() StepConfig { return &TryStep{} },
@@ -296,6 +301,59 @@ type GetStep struct {
 	Trigger  bool           `json:"trigger,omitempty"`
 	Tags     Tags           `json:"tags,omitempty"`
 	Timeout  string         `json:"timeout,omitempty"`
+
+	// PassedStatuses and PassedMaxAge narrow down which builds of the passed
+	// jobs may provide versions. They are configured through the object form
+	// of passed, e.g. passed: {jobs: [...], status: [failed], max_age: 24h}.
+	PassedStatuses []BuildStatus `json:"-"`
+	PassedMaxAge   string        `json:"-"`
+}
+
+func (step *GetStep) UnmarshalJSON(data []byte) error {
+	type target GetStep
+
+	var config struct {
+		*target
+		Passed *PassedConfig `json:"passed,omitempty"`
+	}
+
+	config.target = (*target)(step)
+
+	err := json.Unmarshal(data, &config)
+	if err != nil {
+		return err
+	}
+
+	if config.Passed != nil {
+		step.Passed = config.Passed.Jobs
+		step.PassedStatuses = config.Passed.Status
+		step.PassedMaxAge = config.Passed.MaxAge
+	}
+
+	return nil
+}
+
+func (step GetStep) MarshalJSON() ([]byte, error) {
+	type target GetStep
+
+	config := struct {
+		target
+		Passed interface{} `json:"passed,omitempty"`
+	}{
+		target: target(step),
+	}
+
+	if len(step.PassedStatuses) != 0 || step.PassedMaxAge != "" {
+		config.Passed = PassedConfig{
+			Jobs:   step.Passed,
+			Status: step.PassedStatuses,
+			MaxAge: step.PassedMaxAge,
+		}
+	} else if len(step.Passed) != 0 {
+		config.Passed = step.Passed
+	}
+
+	return json.Marshal(config)
 }
 
 func (step *GetStep) ResourceName() string {
@@ -344,6 +402,7 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+	Restartable       bool              `json:"restartable,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
@@ -374,6 +433,23 @@ func (step *LoadVarStep) Visit(v StepVisitor) error {
 	return v.VisitLoadVar(step)
 }
 
+// ApprovalStep pauses the build until enough users have approved it, or
+// fails it as soon as one of them rejects it.
+type ApprovalStep struct {
+	Name string `json:"approval"`
+
+	// The number of approvals required. Defaults to 1.
+	Approvers int `json:"approvers,omitempty"`
+
+	// The minimum team role a user needs in order to approve or reject the
+	// build. Defaults to "member".
+	Role string `json:"role,omitempty"`
+}
+
+func (step *ApprovalStep) Visit(v StepVisitor) error {
+	return v.VisitApproval(step)
+}
+
 type TryStep struct {
 	Step Step `json:"try"`
 }
@@ -646,10 +722,17 @@ func (c *MaxInFlightConfig) EffectiveLimit(numSteps int) int {
 
 // A VersionConfig represents the choice to include every version of a
 // resource, the latest version of a resource, or a pinned (specific) one.
+//
+// Either of the first two can be narrowed down with a filter expression
+// and/or labels the versions must have been annotated with, e.g.
+// {filter: 'tag =~ "^2\."', labels: [qa-approved], every: true}. A version
+// with a field named "filter" or "labels" therefore cannot be pinned this way.
 type VersionConfig struct {
 	Every  bool
 	Latest bool
 	Pinned Version
+	Filter string
+	Labels []string
 }
 
 func (c *VersionConfig) UnmarshalJSON(version []byte) error {
@@ -665,6 +748,12 @@ func (c *VersionConfig) UnmarshalJSON(version []byte) error {
 		c.Every = actual == "every"
 		c.Latest = actual == "latest"
 	case map[string]interface{}:
+		_, hasFilter := actual[VersionFilter]
+		_, hasLabels := actual[VersionLabels]
+		if hasFilter || hasLabels {
+			return c.unmarshalConstraints(actual)
+		}
+
 		version := Version{}
 
 		for k, v := range actual {
@@ -684,10 +773,73 @@ func (c *VersionConfig) UnmarshalJSON(version []byte) error {
 	return nil
 }
 
+func (c *VersionConfig) unmarshalConstraints(config map[string]interface{}) error {
+	constraint := VersionFilter
+	if _, found := config[VersionFilter]; !found {
+		constraint = VersionLabels
+	}
+
+	for k, v := range config {
+		switch k {
+		case VersionFilter:
+			expression, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("the version filter %v is not a string", v)
+			}
+
+			c.Filter = expression
+		case VersionLabels:
+			labels, ok := v.([]interface{})
+			if !ok {
+				return fmt.Errorf("the version labels %v are not a list", v)
+			}
+
+			for _, l := range labels {
+				label, ok := l.(string)
+				if !ok {
+					return fmt.Errorf("the version label %v is not a string", l)
+				}
+
+				c.Labels = append(c.Labels, label)
+			}
+		case VersionEvery:
+			every, ok := v.(bool)
+			if !ok {
+				return fmt.Errorf("the value %v of every is not a boolean", v)
+			}
+
+			c.Every = every
+		default:
+			return fmt.Errorf("unknown field '%s' alongside version %s", k, constraint)
+		}
+	}
+
+	return nil
+}
+
 const VersionLatest = "latest"
 const VersionEvery = "every"
+const VersionFilter = "filter"
+const VersionLabels = "labels"
 
 func (c *VersionConfig) MarshalJSON() ([]byte, error) {
+	if c.Filter != "" || len(c.Labels) != 0 {
+		config := map[string]interface{}{}
+		if c.Filter != "" {
+			config[VersionFilter] = c.Filter
+		}
+
+		if len(c.Labels) != 0 {
+			config[VersionLabels] = c.Labels
+		}
+
+		if c.Every {
+			config[VersionEvery] = true
+		}
+
+		return json.Marshal(config)
+	}
+
 	if c.Latest {
 		return json.Marshal(VersionLatest)
 	}
@@ -703,6 +855,32 @@ func (c *VersionConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal("")
 }
 
+// A PassedConfig represents the jobs a get step's versions must have passed
+// through, either as a plain list of job names or as an object which also
+// restricts the status and age of their builds.
+type PassedConfig struct {
+	Jobs   []string      `json:"jobs"`
+	Status []BuildStatus `json:"status,omitempty"`
+	MaxAge string        `json:"max_age,omitempty"`
+}
+
+func (c *PassedConfig) UnmarshalJSON(passed []byte) error {
+	var jobs []string
+	if json.Unmarshal(passed, &jobs) == nil {
+		c.Jobs = jobs
+		return nil
+	}
+
+	type target PassedConfig
+
+	err := unmarshalStrict(passed, (*target)(c))
+	if err != nil {
+		return fmt.Errorf("passed must be a list of jobs or an object with jobs, status, and max_age: %w", err)
+	}
+
+	return nil
+}
+
 // A InputsConfig represents the choice to include every artifact within the
 // job as an input to the put step or specific ones.
 type InputsConfig struct {
@@ -45,6 +45,111 @@ var factoryTests = []StepTest{
 			Timeout:  "1h",
 		},
 	},
+	{
+		Title: "get step with passed constraints",
+		ConfigYAML: `
+			get: some-name
+			passed: [job-1, job-2]
+			trigger: true
+		`,
+		StepConfig: &atc.GetStep{
+			Name:    "some-name",
+			Passed:  []string{"job-1", "job-2"},
+			Trigger: true,
+		},
+	},
+	{
+		Title: "get step with filtered passed constraints",
+		ConfigYAML: `
+			get: some-name
+			passed:
+			  jobs: [job-1, job-2]
+			  status: [succeeded, failed]
+			  max_age: 24h
+		`,
+		StepConfig: &atc.GetStep{
+			Name:           "some-name",
+			Passed:         []string{"job-1", "job-2"},
+			PassedStatuses: []atc.BuildStatus{atc.StatusSucceeded, atc.StatusFailed},
+			PassedMaxAge:   "24h",
+		},
+	},
+	{
+		Title: "get step with invalid passed constraints",
+		ConfigYAML: `
+			get: some-name
+			passed:
+			  job: [job-1]
+		`,
+		Err: `malformed get step: passed must be a list of jobs or an object with jobs, status, and max_age: json: unknown field "job"`,
+	},
+	{
+		Title: "get step with version filter",
+		ConfigYAML: `
+			get: some-name
+			version:
+			  filter: tag =~ "^2\."
+			  every: true
+		`,
+		StepConfig: &atc.GetStep{
+			Name: "some-name",
+			Version: &atc.VersionConfig{
+				Filter: `tag =~ "^2\."`,
+				Every:  true,
+			},
+		},
+	},
+	{
+		Title: "get step with version labels",
+		ConfigYAML: `
+			get: some-name
+			version:
+			  labels: [qa-approved, canary-ok]
+		`,
+		StepConfig: &atc.GetStep{
+			Name: "some-name",
+			Version: &atc.VersionConfig{
+				Labels: []string{"qa-approved", "canary-ok"},
+			},
+		},
+	},
+	{
+		Title: "get step with version filter and labels",
+		ConfigYAML: `
+			get: some-name
+			version:
+			  filter: tag =~ "^2\."
+			  labels: [qa-approved]
+			  every: true
+		`,
+		StepConfig: &atc.GetStep{
+			Name: "some-name",
+			Version: &atc.VersionConfig{
+				Filter: `tag =~ "^2\."`,
+				Labels: []string{"qa-approved"},
+				Every:  true,
+			},
+		},
+	},
+	{
+		Title: "get step with version labels that are not a list",
+		ConfigYAML: `
+			get: some-name
+			version:
+			  labels: qa-approved
+		`,
+		Err: `malformed get step: the version labels qa-approved are not a list`,
+	},
+	{
+		Title: "get step with unknown field alongside version filter",
+		ConfigYAML: `
+			get: some-name
+			version:
+			  filter: tag == "1"
+			  latest: true
+		`,
+		Err: `malformed get step: unknown field 'latest' alongside version filter`,
+	},
 	{
 		Title: "put step",
 
@@ -84,6 +189,7 @@ var factoryTests = []StepTest{
 			output_mapping: {specific: generic}
 			image: some-image
 			timeout: 1h
+			restartable: true
 		`,
 
 		StepConfig: &atc.TaskStep{
@@ -101,6 +207,7 @@ var factoryTests = []StepTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			Restartable:       true,
 		},
 	},
 	{
@@ -163,6 +270,21 @@ var factoryTests = []StepTest{
 			Reveal: true,
 		},
 	},
+	{
+		Title: "approval step",
+
+		ConfigYAML: `
+			approval: deploy-to-prod
+			approvers: 2
+			role: owner
+		`,
+
+		StepConfig: &atc.ApprovalStep{
+			Name:      "deploy-to-prod",
+			Approvers: 2,
+			Role:      "owner",
+		},
+	},
 	{
 		Title: "try step",
 
@@ -19,8 +19,9 @@ type JobSummary struct {
 	NextBuild       *BuildSummary `json:"next_build,omitempty"`
 	TransitionBuild *BuildSummary `json:"transition_build,omitempty"`
 
-	Inputs  []JobInputSummary  `json:"inputs,omitempty"`
-	Outputs []JobOutputSummary `json:"outputs,omitempty"`
+	Inputs    []JobInputSummary  `json:"inputs,omitempty"`
+	Outputs   []JobOutputSummary `json:"outputs,omitempty"`
+	DependsOn []string           `json:"depends_on,omitempty"`
 }
 
 type BuildSummary struct {
@@ -0,0 +1,94 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	WebhookTypeGitHub    = "github"
+	WebhookTypeGitLab    = "gitlab"
+	WebhookTypeBitbucket = "bitbucket"
+)
+
+var ErrWebhookSecretEmpty = errors.New("webhook secret must not be empty")
+
+// TeamWebhook receives payloads from a code host on behalf of a whole team
+// and triggers checks for every resource whose source matches the payload.
+type TeamWebhook struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Secret string `json:"secret,omitempty"`
+
+	// ResourceTypes limits the resources considered to those of the given
+	// types. All resources are considered if it is empty.
+	ResourceTypes []string `json:"resource_types,omitempty"`
+
+	// Match rules which must all match for a resource to be checked. Each
+	// type has sensible default rules if none are given.
+	Match []WebhookMatch `json:"match,omitempty"`
+}
+
+// WebhookMatch matches resources whose source field contains the value of a
+// field in the payload. Both fields are dot-separated paths.
+type WebhookMatch struct {
+	PayloadField string `json:"payload_field"`
+	SourceField  string `json:"source_field"`
+}
+
+func (webhook TeamWebhook) Validate() error {
+	switch webhook.Type {
+	case WebhookTypeGitHub, WebhookTypeGitLab, WebhookTypeBitbucket:
+	default:
+		return fmt.Errorf("unknown webhook type '%s'", webhook.Type)
+	}
+
+	if webhook.Secret == "" {
+		return ErrWebhookSecretEmpty
+	}
+
+	for i, match := range webhook.Match {
+		if match.PayloadField == "" || match.SourceField == "" {
+			return fmt.Errorf("match rule %d must specify both payload_field and source_field", i)
+		}
+	}
+
+	return nil
+}
+
+// MatchRules returns the configured match rules, falling back on the rules
+// which identify the repository for the webhook's type.
+func (webhook TeamWebhook) MatchRules() []WebhookMatch {
+	if len(webhook.Match) > 0 {
+		return webhook.Match
+	}
+
+	switch webhook.Type {
+	case WebhookTypeGitLab:
+		return []WebhookMatch{{PayloadField: "project.path_with_namespace", SourceField: "uri"}}
+	default:
+		return []WebhookMatch{{PayloadField: "repository.full_name", SourceField: "uri"}}
+	}
+}
+
+type WebhookDelivery struct {
+	ID         int    `json:"id"`
+	Webhook    string `json:"webhook"`
+	Event      string `json:"event,omitempty"`
+	ReceivedAt int64  `json:"received_at"`
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+
+	// Values extracted from the payload, keyed by payload field.
+	Values map[string]string `json:"values,omitempty"`
+
+	CheckedResources []WebhookDeliveryResource `json:"checked_resources,omitempty"`
+}
+
+type WebhookDeliveryResource struct {
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	Resource             string       `json:"resource"`
+	BuildID              int          `json:"build_id,omitempty"`
+	Error                string       `json:"error,omitempty"`
+}
@@ -0,0 +1,63 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamWebhook", func() {
+	var webhook atc.TeamWebhook
+
+	BeforeEach(func() {
+		webhook = atc.TeamWebhook{
+			Name:   "some-webhook",
+			Type:   atc.WebhookTypeGitHub,
+			Secret: "some-secret",
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid webhook", func() {
+			Expect(webhook.Validate()).To(Succeed())
+		})
+
+		It("rejects unknown types", func() {
+			webhook.Type = "some-type"
+			Expect(webhook.Validate()).To(MatchError("unknown webhook type 'some-type'"))
+		})
+
+		It("requires a secret", func() {
+			webhook.Secret = ""
+			Expect(webhook.Validate()).To(Equal(atc.ErrWebhookSecretEmpty))
+		})
+
+		It("requires both fields of a match rule", func() {
+			webhook.Match = []atc.WebhookMatch{{PayloadField: "repository.full_name"}}
+			Expect(webhook.Validate()).To(MatchError("match rule 0 must specify both payload_field and source_field"))
+		})
+	})
+
+	Describe("MatchRules", func() {
+		It("defaults to matching the repository for the type", func() {
+			Expect(webhook.MatchRules()).To(Equal([]atc.WebhookMatch{
+				{PayloadField: "repository.full_name", SourceField: "uri"},
+			}))
+
+			webhook.Type = atc.WebhookTypeBitbucket
+			Expect(webhook.MatchRules()).To(Equal([]atc.WebhookMatch{
+				{PayloadField: "repository.full_name", SourceField: "uri"},
+			}))
+
+			webhook.Type = atc.WebhookTypeGitLab
+			Expect(webhook.MatchRules()).To(Equal([]atc.WebhookMatch{
+				{PayloadField: "project.path_with_namespace", SourceField: "uri"},
+			}))
+		})
+
+		It("uses the configured rules", func() {
+			webhook.Match = []atc.WebhookMatch{{PayloadField: "repo", SourceField: "repository"}}
+			Expect(webhook.MatchRules()).To(Equal(webhook.Match))
+		})
+	})
+})
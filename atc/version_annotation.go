@@ -0,0 +1,30 @@
+package atc
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var validVersionLabel = regexp.MustCompile(`^[\p{Ll}\d][\p{Ll}\d\-_.]*$`)
+
+// VersionAnnotation labels a version of a resource, e.g. to record that it
+// has been promoted. Get steps can require versions to carry labels.
+type VersionAnnotation struct {
+	Label     string `json:"label"`
+	Author    string `json:"author,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// ValidateVersionLabel returns an error if the label cannot be used to
+// annotate a version.
+func ValidateVersionLabel(label string) error {
+	if label == "" {
+		return fmt.Errorf("version label cannot be an empty string")
+	}
+
+	if !validVersionLabel.MatchString(label) {
+		return fmt.Errorf("'%s' is not a valid version label: must consist of lowercase letters, digits, '-', '_' and '.'", label)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateVersionLabel", func() {
+	It("accepts lowercase labels with separators", func() {
+		Expect(atc.ValidateVersionLabel("qa-approved")).To(Succeed())
+		Expect(atc.ValidateVersionLabel("canary_ok.2")).To(Succeed())
+	})
+
+	It("rejects empty labels", func() {
+		Expect(atc.ValidateVersionLabel("")).To(MatchError("version label cannot be an empty string"))
+	})
+
+	It("rejects labels with illegal characters", func() {
+		Expect(atc.ValidateVersionLabel("QA approved")).To(MatchError(ContainSubstring("'QA approved' is not a valid version label")))
+	})
+
+	It("rejects labels starting with a separator", func() {
+		Expect(atc.ValidateVersionLabel("-approved")).ToNot(Succeed())
+	})
+})
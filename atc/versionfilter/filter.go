@@ -0,0 +1,209 @@
+// Package versionfilter implements the expressions used to narrow down which
+// versions of a resource a get step may use, e.g.
+//
+//	tag =~ "^2\." and metadata.branch == "main"
+//
+// A bare field name, or one prefixed with "version.", refers to a field of the
+// version. One prefixed with "metadata." refers to the version's metadata.
+// Fields can be compared with ==, != and, using regular expressions, with =~
+// and !~. Comparisons can be combined with and, or, not and parentheses.
+//
+// A field which is missing never equals or matches anything.
+//
+// Patterns are matched with Go's regexp package when previewing, but with
+// PostgreSQL's ~ operator when scheduling. To make sure both agree, only the
+// syntax the two share is accepted:
+//
+//   - the escapes \b, \B, \z, \p, \P, \Q, \E, \C and \x{...} are rejected, as
+//     PostgreSQL either does not support them or gives them another meaning
+//   - the class escapes \d, \D, \s, \S, \w and \W may not be used inside
+//     brackets; write them outside of brackets or use e.g. [[:digit:]]
+//   - groups may only be plain (...) or non-capturing (?:...); flags and
+//     named groups are rejected
+//
+// Backreferences and lookaround are not supported by Go, and so are rejected
+// too. As in PostgreSQL, . also matches newlines.
+package versionfilter
+
+import (
+	"regexp"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Filter is a parsed filter expression. The zero value matches every
+// version.
+type Filter struct {
+	source string
+	expr   expr
+}
+
+// Parse parses a filter expression, returning an error describing where it
+// went wrong if it is invalid.
+func Parse(source string) (Filter, error) {
+	p := &parser{lexer: newLexer(source)}
+
+	expr, err := p.parse()
+	if err != nil {
+		return Filter{}, err
+	}
+
+	return Filter{
+		source: source,
+		expr:   expr,
+	}, nil
+}
+
+// IsZero returns true if the filter matches every version.
+func (f Filter) IsZero() bool {
+	return f.expr == nil
+}
+
+func (f Filter) String() string {
+	return f.source
+}
+
+// Match returns true if the version, with the given metadata, passes the
+// filter.
+func (f Filter) Match(version map[string]string, metadata map[string]string) bool {
+	if f.expr == nil {
+		return true
+	}
+
+	return f.expr.match(version, metadata)
+}
+
+// Sqlizer returns a condition selecting the versions which pass the filter,
+// given the names of the version and metadata columns of the
+// resource_config_versions table.
+func (f Filter) Sqlizer(versionColumn string, metadataColumn string) sq.Sqlizer {
+	if f.expr == nil {
+		return sq.Expr("true")
+	}
+
+	return f.expr.sql(columns{
+		version:  versionColumn,
+		metadata: metadataColumn,
+	})
+}
+
+type columns struct {
+	version  string
+	metadata string
+}
+
+type expr interface {
+	match(version map[string]string, metadata map[string]string) bool
+	sql(columns) sq.Sqlizer
+}
+
+type andExpr struct {
+	left, right expr
+}
+
+func (e andExpr) match(version map[string]string, metadata map[string]string) bool {
+	return e.left.match(version, metadata) && e.right.match(version, metadata)
+}
+
+func (e andExpr) sql(cols columns) sq.Sqlizer {
+	return sq.And{e.left.sql(cols), e.right.sql(cols)}
+}
+
+type orExpr struct {
+	left, right expr
+}
+
+func (e orExpr) match(version map[string]string, metadata map[string]string) bool {
+	return e.left.match(version, metadata) || e.right.match(version, metadata)
+}
+
+func (e orExpr) sql(cols columns) sq.Sqlizer {
+	return sq.Or{e.left.sql(cols), e.right.sql(cols)}
+}
+
+type notExpr struct {
+	expr expr
+}
+
+func (e notExpr) match(version map[string]string, metadata map[string]string) bool {
+	return !e.expr.match(version, metadata)
+}
+
+func (e notExpr) sql(cols columns) sq.Sqlizer {
+	return not{e.expr.sql(cols)}
+}
+
+type not struct {
+	sq.Sqlizer
+}
+
+func (n not) ToSql() (string, []interface{}, error) {
+	sql, args, err := n.Sqlizer.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "NOT (" + sql + ")", args, nil
+}
+
+type source int
+
+const (
+	sourceVersion source = iota
+	sourceMetadata
+)
+
+type compareExpr struct {
+	source  source
+	field   string
+	negated bool
+
+	// value is the pattern's source if pattern is set
+	value   string
+	pattern *regexp.Regexp
+}
+
+func (e compareExpr) match(version map[string]string, metadata map[string]string) bool {
+	fields := version
+	if e.source == sourceMetadata {
+		fields = metadata
+	}
+
+	value, found := fields[e.field]
+
+	var matched bool
+	if found {
+		if e.pattern != nil {
+			matched = e.pattern.MatchString(value)
+		} else {
+			matched = value == e.value
+		}
+	}
+
+	return matched != e.negated
+}
+
+func (e compareExpr) sql(cols columns) sq.Sqlizer {
+	operator := "="
+	if e.pattern != nil {
+		operator = "~"
+	}
+
+	var condition sq.Sqlizer
+	switch e.source {
+	case sourceMetadata:
+		condition = sq.Expr(
+			"EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof("+cols.metadata+") = 'array' THEN "+cols.metadata+" ELSE '[]'::jsonb END) m WHERE m->>'Name' = ? AND m->>'Value' "+operator+" ?)",
+			e.field,
+			e.value,
+		)
+	default:
+		condition = sq.Expr("COALESCE("+cols.version+"->>? "+operator+" ?, false)", e.field, e.value)
+	}
+
+	if e.negated {
+		return not{condition}
+	}
+
+	return condition
+}
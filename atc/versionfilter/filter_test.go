@@ -0,0 +1,132 @@
+package versionfilter_test
+
+import (
+	"github.com/concourse/concourse/atc/versionfilter"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filter", func() {
+	var (
+		version  = map[string]string{"tag": "2.1.0", "ref": "abcdef"}
+		metadata = map[string]string{"branch": "main", "author": "some \"quoted\" author"}
+	)
+
+	DescribeTable("Match",
+		func(source string, expected bool) {
+			filter, err := versionfilter.Parse(source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter.Match(version, metadata)).To(Equal(expected))
+		},
+
+		Entry("equal version field", `tag == "2.1.0"`, true),
+		Entry("unequal version field", `tag == "2.1.1"`, false),
+		Entry("prefixed version field", `version.ref == "abcdef"`, true),
+		Entry("not equal", `tag != "2.1.1"`, true),
+		Entry("matching regular expression", `tag =~ "^2\."`, true),
+		Entry("non-matching regular expression", `tag =~ "^3\."`, false),
+		Entry("negated regular expression", `tag !~ "^3\."`, true),
+		Entry("metadata field", `metadata.branch == "main"`, true),
+		Entry("metadata field with escaped quotes", `metadata.author == "some \"quoted\" author"`, true),
+		Entry("missing field", `missing == ""`, false),
+		Entry("missing field negated", `missing != "anything"`, true),
+		Entry("missing metadata field", `metadata.missing =~ ".*"`, false),
+		Entry("and", `tag =~ "^2\." and metadata.branch == "main"`, true),
+		Entry("and with a false side", `tag =~ "^2\." and metadata.branch == "dev"`, false),
+		Entry("or", `metadata.branch == "dev" or ref == "abcdef"`, true),
+		Entry("not", `not metadata.branch == "dev"`, true),
+		Entry("and binding tighter than or", `ref == "nope" and tag == "nope" or tag == "2.1.0"`, true),
+		Entry("parentheses", `ref == "nope" and (tag == "nope" or tag == "2.1.0")`, false),
+	)
+
+	It("matches every version when zero", func() {
+		var filter versionfilter.Filter
+		Expect(filter.IsZero()).To(BeTrue())
+		Expect(filter.Match(version, metadata)).To(BeTrue())
+	})
+
+	It("keeps the source", func() {
+		filter, err := versionfilter.Parse(`tag == "1"`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filter.IsZero()).To(BeFalse())
+		Expect(filter.String()).To(Equal(`tag == "1"`))
+	})
+
+	DescribeTable("invalid expressions",
+		func(source string, message string) {
+			_, err := versionfilter.Parse(source)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+
+		Entry("empty", ``, "expected a field name at position 1, got end of expression"),
+		Entry("missing operator", `tag "1"`, `expected an operator after 'tag' at position 5`),
+		Entry("unknown operator", `tag = "1"`, "unknown operator at position 5"),
+		Entry("unquoted value", `tag == 1`, "expected a quoted string at position 8, got '1'"),
+		Entry("unterminated string", `tag == "1`, "unterminated string starting at position 8"),
+		Entry("invalid regular expression", `tag =~ "("`, "invalid regular expression at position 8"),
+		Entry("unbalanced parentheses", `(tag == "1"`, "expected ')' at position 12, got end of expression"),
+		Entry("trailing tokens", `tag == "1" "2"`, `unexpected '"2"' at position 12`),
+		Entry("unexpected character", `tag == "1" & ref == "2"`, "unexpected character '&' at position 12"),
+		Entry("empty metadata field", `metadata. == "1"`, "missing field name at position 1"),
+		Entry("word boundary", `tag =~ "\b2"`, `invalid regular expression at position 8: \b is not supported`),
+		Entry("end of text", `tag =~ "0\z"`, `\z is not supported`),
+		Entry("unicode class", `tag =~ "\pL"`, `\p is not supported`),
+		Entry("quoted literal", `tag =~ "\Q.\E"`, `\Q is not supported`),
+		Entry("braced hex escape", `tag =~ "\x{41}"`, `\x{...} is not supported`),
+		Entry("class escape in brackets", `tag =~ "[\d.]+"`, `\d is not supported inside brackets`),
+		Entry("class escape in brackets after a character class", `tag =~ "[[:alpha:]\w]"`, `\w is not supported inside brackets`),
+		Entry("flags", `tag =~ "(?i)abc"`, "only (...) and (?:...) groups are supported"),
+		Entry("named group", `tag =~ "(?P<major>2)"`, "only (...) and (?:...) groups are supported"),
+		Entry("lookahead", `tag =~ "2(?=\.)"`, "invalid regular expression at position 8"),
+		Entry("backreference", `tag =~ "(2)\1"`, "invalid regular expression at position 8"),
+	)
+
+	DescribeTable("portable regular expressions",
+		func(source string, expected bool) {
+			filter, err := versionfilter.Parse(source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter.Match(version, metadata)).To(Equal(expected))
+		},
+
+		Entry("class escapes outside of brackets", `tag =~ "^\d+\.\d+"`, true),
+		Entry("character classes in brackets", `tag =~ "^[[:digit:].]+$"`, true),
+		Entry("literal closing bracket", `tag =~ "[]x]"`, false),
+		Entry("escaped characters in brackets", `tag =~ "^[\]2]"`, true),
+		Entry("non-capturing groups", `tag =~ "^(?:2|3)\."`, true),
+	)
+
+	It("matches newlines with . as PostgreSQL does", func() {
+		filter, err := versionfilter.Parse(`metadata.message =~ "^fix.*bug$"`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filter.Match(version, map[string]string{"message": "fix\nsome bug"})).To(BeTrue())
+	})
+
+	Describe("Sqlizer", func() {
+		It("compares version fields", func() {
+			filter, err := versionfilter.Parse(`tag =~ "^2\." and not ref == "abc"`)
+			Expect(err).ToNot(HaveOccurred())
+
+			sql, args, err := filter.Sqlizer("rcv.version", "rcv.metadata").ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal(`(COALESCE(rcv.version->>? ~ ?, false) AND NOT (COALESCE(rcv.version->>? = ?, false)))`))
+			Expect(args).To(Equal([]interface{}{"tag", `^2\.`, "ref", "abc"}))
+		})
+
+		It("compares metadata fields", func() {
+			filter, err := versionfilter.Parse(`metadata.branch != "main" or tag == "1"`)
+			Expect(err).ToNot(HaveOccurred())
+
+			sql, args, err := filter.Sqlizer("version", "metadata").ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal(`(NOT (EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(metadata) = 'array' THEN metadata ELSE '[]'::jsonb END) m WHERE m->>'Name' = ? AND m->>'Value' = ?)) OR COALESCE(version->>? = ?, false))`))
+			Expect(args).To(Equal([]interface{}{"branch", "main", "tag", "1"}))
+		})
+
+		It("selects everything when zero", func() {
+			sql, _, err := versionfilter.Filter{}.Sqlizer("version", "metadata").ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal("true"))
+		})
+	})
+})
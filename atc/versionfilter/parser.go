@@ -0,0 +1,324 @@
+package versionfilter
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+
+	return fmt.Sprintf("'%s'", t.text)
+}
+
+type lexer struct {
+	source string
+	pos    int
+}
+
+func newLexer(source string) *lexer {
+	return &lexer{source: source}
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.source) && strings.ContainsRune(" \t\r\n", rune(l.source[l.pos])) {
+		l.pos++
+	}
+
+	start := l.pos
+	if start >= len(l.source) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	switch c := l.source[start]; {
+	case c == '(':
+		l.pos++
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case c == '"':
+		return l.string()
+	case c == '=' || c == '!':
+		if start+1 < len(l.source) {
+			switch operator := l.source[start : start+2]; operator {
+			case "==", "!=", "=~", "!~":
+				l.pos += 2
+				return token{kind: tokenOperator, text: operator, pos: start}, nil
+			}
+		}
+
+		return token{}, fmt.Errorf("unknown operator at position %d (expected ==, !=, =~ or !~)", start+1)
+	case isIdentChar(c):
+		for l.pos < len(l.source) && (isIdentChar(l.source[l.pos]) || l.source[l.pos] == '.') {
+			l.pos++
+		}
+
+		text := l.source[start:l.pos]
+		return token{kind: tokenIdent, text: text, value: text, pos: start}, nil
+	default:
+		return token{}, fmt.Errorf("unexpected character '%c' at position %d", c, start+1)
+	}
+}
+
+// string lexes a double-quoted string. A backslash only escapes a double
+// quote or another backslash, and is otherwise kept as-is so that regular
+// expressions can be written naturally.
+func (l *lexer) string() (token, error) {
+	start := l.pos
+	l.pos++
+
+	var value strings.Builder
+	for l.pos < len(l.source) {
+		c := l.source[l.pos]
+
+		switch {
+		case c == '"':
+			l.pos++
+			return token{kind: tokenString, text: l.source[start:l.pos], value: value.String(), pos: start}, nil
+		case c == '\\' && l.pos+1 < len(l.source) && (l.source[l.pos+1] == '"' || l.source[l.pos+1] == '\\'):
+			value.WriteByte(l.source[l.pos+1])
+			l.pos += 2
+		default:
+			value.WriteByte(c)
+			l.pos++
+		}
+	}
+
+	return token{}, fmt.Errorf("unterminated string starting at position %d", start+1)
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '-' ||
+		('a' <= c && c <= 'z') ||
+		('A' <= c && c <= 'Z') ||
+		('0' <= c && c <= '9')
+}
+
+// parser is a recursive descent parser for the grammar:
+//
+//	or         := and ("or" and)*
+//	and        := unary ("and" unary)*
+//	unary      := "not" unary | "(" or ")" | comparison
+//	comparison := field ("==" | "!=" | "=~" | "!~") string
+type parser struct {
+	lexer *lexer
+
+	peeked *token
+}
+
+func (p *parser) parse() (expr, error) {
+	expr, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos+1)
+	}
+
+	return expr, nil
+}
+
+func (p *parser) peek() (token, error) {
+	if p.peeked == nil {
+		tok, err := p.lexer.next()
+		if err != nil {
+			return token{}, err
+		}
+
+		p.peeked = &tok
+	}
+
+	return *p.peeked, nil
+}
+
+func (p *parser) next() (token, error) {
+	tok, err := p.peek()
+	p.peeked = nil
+	return tok, err
+}
+
+func (p *parser) keyword(keyword string) (bool, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return false, err
+	}
+
+	if tok.kind == tokenIdent && tok.text == keyword {
+		p.peeked = nil
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (p *parser) or() (expr, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		found, err := p.keyword("or")
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return left, nil
+		}
+
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orExpr{left, right}
+	}
+}
+
+func (p *parser) and() (expr, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		found, err := p.keyword("and")
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return left, nil
+		}
+
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andExpr{left, right}
+	}
+}
+
+func (p *parser) unary() (expr, error) {
+	found, err := p.keyword("not")
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		expr, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notExpr{expr}, nil
+	}
+
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok.kind {
+	case tokenLParen:
+		expr, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		closing, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing.kind != tokenRParen {
+			return nil, fmt.Errorf("expected ')' at position %d, got %s", closing.pos+1, closing)
+		}
+
+		return expr, nil
+	case tokenIdent:
+		return p.comparison(tok)
+	default:
+		return nil, fmt.Errorf("expected a field name at position %d, got %s", tok.pos+1, tok)
+	}
+}
+
+func (p *parser) comparison(field token) (expr, error) {
+	compare := compareExpr{
+		source: sourceVersion,
+		field:  field.value,
+	}
+
+	if name := strings.TrimPrefix(field.value, "metadata."); name != field.value {
+		compare.source = sourceMetadata
+		compare.field = name
+	} else {
+		compare.field = strings.TrimPrefix(field.value, "version.")
+	}
+
+	if compare.field == "" {
+		return nil, fmt.Errorf("missing field name at position %d", field.pos+1)
+	}
+
+	operator, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if operator.kind != tokenOperator {
+		return nil, fmt.Errorf("expected an operator after %s at position %d, got %s", field, operator.pos+1, operator)
+	}
+
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if value.kind != tokenString {
+		return nil, fmt.Errorf("expected a quoted string at position %d, got %s", value.pos+1, value)
+	}
+
+	switch operator.text {
+	case "==", "!=":
+		compare.value = value.value
+	case "=~", "!~":
+		compare.value = value.value
+		compare.pattern, err = compilePattern(value.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression at position %d: %w", value.pos+1, err)
+		}
+	}
+
+	compare.negated = operator.text[0] == '!'
+
+	return compare, nil
+}
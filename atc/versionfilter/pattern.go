@@ -0,0 +1,90 @@
+package versionfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// compilePattern compiles a pattern, rejecting any syntax which PostgreSQL
+// would treat differently (see the package docs).
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	err := checkPortable(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return regexp.Compile("(?s)" + pattern)
+}
+
+func checkPortable(pattern string) error {
+	inBrackets := false
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			escape := pattern[i]
+
+			switch escape {
+			case 'b', 'B', 'z', 'p', 'P', 'Q', 'E', 'C':
+				return unsupportedSyntax(pattern[i-1 : i+1])
+			case 'x':
+				if i+1 < len(pattern) && pattern[i+1] == '{' {
+					return unsupportedSyntax(`\x{...}`)
+				}
+			case 'd', 'D', 's', 'S', 'w', 'W':
+				if inBrackets {
+					return fmt.Errorf("%s is not supported inside brackets", pattern[i-1:i+1])
+				}
+			}
+
+		case inBrackets:
+			if c == '[' && i+1 < len(pattern) && isCollatingDelimiter(pattern[i+1]) {
+				// skip over e.g. [:digit:] so that its ] doesn't end the brackets
+				end := i + 2
+				for end+1 < len(pattern) && !(pattern[end] == pattern[i+1] && pattern[end+1] == ']') {
+					end++
+				}
+
+				i = end + 1
+			} else if c == ']' {
+				inBrackets = false
+			}
+
+		case c == '[':
+			inBrackets = true
+
+			// a ] right after the opening bracket (or its negation) is literal
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				i++
+			}
+
+			if i+1 < len(pattern) && pattern[i+1] == ']' {
+				i++
+			}
+
+		case c == '(' && i+1 < len(pattern) && pattern[i+1] == '?':
+			if i+2 >= len(pattern) || pattern[i+2] != ':' {
+				return errors.New("only (...) and (?:...) groups are supported")
+			}
+		}
+	}
+
+	return nil
+}
+
+func isCollatingDelimiter(c byte) bool {
+	return c == ':' || c == '.' || c == '='
+}
+
+func unsupportedSyntax(syntax string) error {
+	return fmt.Errorf("%s is not supported, as PostgreSQL treats it differently", syntax)
+}
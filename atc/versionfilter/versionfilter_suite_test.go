@@ -0,0 +1,13 @@
+package versionfilter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestVersionfilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Versionfilter Suite")
+}
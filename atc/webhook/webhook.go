@@ -0,0 +1,222 @@
+// Package webhook implements the team-level webhook receiver: verifying that
+// a payload was signed by the code host, pulling fields out of it and
+// deciding which resources it concerns.
+//
+// Signatures are verified in the style of each code host:
+//
+//	github     X-Hub-Signature-256: sha256=<hmac> (or X-Hub-Signature: sha1=<hmac>)
+//	bitbucket  X-Hub-Signature: sha256=<hmac>
+//	gitlab     X-Gitlab-Token: <secret>
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Verify checks that the payload was signed with the secret.
+func Verify(webhookType string, secret string, header http.Header, body []byte) error {
+	switch webhookType {
+	case atc.WebhookTypeGitHub:
+		if signature := header.Get("X-Hub-Signature-256"); signature != "" {
+			return verifyHMAC(sha256.New, "sha256=", secret, signature, body)
+		}
+
+		if signature := header.Get("X-Hub-Signature"); signature != "" {
+			return verifyHMAC(sha1.New, "sha1=", secret, signature, body)
+		}
+
+		return ErrMissingSignature
+
+	case atc.WebhookTypeBitbucket:
+		signature := header.Get("X-Hub-Signature")
+		if signature == "" {
+			return ErrMissingSignature
+		}
+
+		return verifyHMAC(sha256.New, "sha256=", secret, signature, body)
+
+	case atc.WebhookTypeGitLab:
+		token := header.Get("X-Gitlab-Token")
+		if token == "" {
+			return ErrMissingSignature
+		}
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return ErrInvalidSignature
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown webhook type '%s'", webhookType)
+	}
+}
+
+func verifyHMAC(newHash func() hash.Hash, prefix string, secret string, signature string, body []byte) error {
+	if !strings.HasPrefix(signature, prefix) {
+		return ErrInvalidSignature
+	}
+
+	given, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+
+	if !hmac.Equal(given, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Event returns the kind of event the code host says the payload is for.
+func Event(webhookType string, header http.Header) string {
+	switch webhookType {
+	case atc.WebhookTypeGitHub:
+		return header.Get("X-GitHub-Event")
+	case atc.WebhookTypeGitLab:
+		return header.Get("X-Gitlab-Event")
+	case atc.WebhookTypeBitbucket:
+		return header.Get("X-Event-Key")
+	default:
+		return ""
+	}
+}
+
+// ParsePayload decodes a JSON payload, keeping numbers as they were written.
+func ParsePayload(body []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var payload map[string]interface{}
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ExtractValues pulls the payload fields used by the rules out of the payload.
+// Fields which are missing, or are not scalars, are left out.
+func ExtractValues(payload map[string]interface{}, rules []atc.WebhookMatch) map[string]string {
+	values := map[string]string{}
+	for _, rule := range rules {
+		value, found := Extract(payload, rule.PayloadField)
+		if found {
+			values[rule.PayloadField] = value
+		}
+	}
+
+	return values
+}
+
+// Extract returns the scalar at the dot-separated path as a string.
+func Extract(object map[string]interface{}, path string) (string, bool) {
+	var value interface{} = object
+	for _, key := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+
+		value, ok = fields[key]
+		if !ok {
+			return "", false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int:
+		return strconv.Itoa(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// Matches reports whether the source satisfies every rule. A rule is
+// satisfied if the source field contains the payload field's value as a
+// whole segment, so that a payload for org/repo does not match a source for
+// org/repo-fork.
+func Matches(source atc.Source, rules []atc.WebhookMatch, values map[string]string) bool {
+	if len(rules) == 0 {
+		return false
+	}
+
+	for _, rule := range rules {
+		value, found := values[rule.PayloadField]
+		if !found || value == "" {
+			return false
+		}
+
+		sourceValue, found := Extract(map[string]interface{}(source), rule.SourceField)
+		if !found {
+			return false
+		}
+
+		if !containsSegment(strings.ToLower(sourceValue), strings.ToLower(value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsSegment(s string, segment string) bool {
+	for offset := 0; offset <= len(s)-len(segment); {
+		i := strings.Index(s[offset:], segment)
+		if i == -1 {
+			return false
+		}
+
+		start := offset + i
+		end := start + len(segment)
+
+		if (start == 0 || isSeparator(s[start-1])) && (end == len(s) || isSeparator(s[end])) {
+			return true
+		}
+
+		offset = start + 1
+	}
+
+	return false
+}
+
+func isSeparator(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_':
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,183 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/webhook"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func sign(newHash func() hash.Hash, secret string, body []byte) string {
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe("Webhook", func() {
+	var body = []byte(`{"repository":{"full_name":"some-org/some-repo"}}`)
+
+	Describe("Verify", func() {
+		DescribeTable("signatures",
+			func(webhookType string, header http.Header, expected error) {
+				err := webhook.Verify(webhookType, "some-secret", header, body)
+				if expected == nil {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(Equal(expected))
+				}
+			},
+			Entry("github sha256", atc.WebhookTypeGitHub, http.Header{
+				"X-Hub-Signature-256": {"sha256=" + sign(sha256.New, "some-secret", body)},
+			}, nil),
+			Entry("github sha1", atc.WebhookTypeGitHub, http.Header{
+				"X-Hub-Signature": {"sha1=" + sign(sha1.New, "some-secret", body)},
+			}, nil),
+			Entry("github signed with another secret", atc.WebhookTypeGitHub, http.Header{
+				"X-Hub-Signature-256": {"sha256=" + sign(sha256.New, "other-secret", body)},
+			}, webhook.ErrInvalidSignature),
+			Entry("github with a malformed signature", atc.WebhookTypeGitHub, http.Header{
+				"X-Hub-Signature-256": {"sha256=not-hex"},
+			}, webhook.ErrInvalidSignature),
+			Entry("github with the wrong algorithm prefix", atc.WebhookTypeGitHub, http.Header{
+				"X-Hub-Signature-256": {"sha1=" + sign(sha256.New, "some-secret", body)},
+			}, webhook.ErrInvalidSignature),
+			Entry("github without a signature", atc.WebhookTypeGitHub, http.Header{}, webhook.ErrMissingSignature),
+			Entry("bitbucket sha256", atc.WebhookTypeBitbucket, http.Header{
+				"X-Hub-Signature": {"sha256=" + sign(sha256.New, "some-secret", body)},
+			}, nil),
+			Entry("bitbucket signed with another secret", atc.WebhookTypeBitbucket, http.Header{
+				"X-Hub-Signature": {"sha256=" + sign(sha256.New, "other-secret", body)},
+			}, webhook.ErrInvalidSignature),
+			Entry("bitbucket without a signature", atc.WebhookTypeBitbucket, http.Header{}, webhook.ErrMissingSignature),
+			Entry("gitlab token", atc.WebhookTypeGitLab, http.Header{
+				"X-Gitlab-Token": {"some-secret"},
+			}, nil),
+			Entry("gitlab with the wrong token", atc.WebhookTypeGitLab, http.Header{
+				"X-Gitlab-Token": {"other-secret"},
+			}, webhook.ErrInvalidSignature),
+			Entry("gitlab without a token", atc.WebhookTypeGitLab, http.Header{}, webhook.ErrMissingSignature),
+		)
+
+		It("errors for an unknown type", func() {
+			err := webhook.Verify("some-type", "some-secret", http.Header{}, body)
+			Expect(err).To(MatchError("unknown webhook type 'some-type'"))
+		})
+	})
+
+	Describe("Event", func() {
+		It("reads the event header for the type", func() {
+			header := http.Header{
+				"X-Github-Event": {"push"},
+				"X-Gitlab-Event": {"Push Hook"},
+				"X-Event-Key":    {"repo:refs_changed"},
+			}
+
+			Expect(webhook.Event(atc.WebhookTypeGitHub, header)).To(Equal("push"))
+			Expect(webhook.Event(atc.WebhookTypeGitLab, header)).To(Equal("Push Hook"))
+			Expect(webhook.Event(atc.WebhookTypeBitbucket, header)).To(Equal("repo:refs_changed"))
+		})
+	})
+
+	Describe("ExtractValues", func() {
+		It("extracts scalars at nested paths", func() {
+			payload, err := webhook.ParsePayload([]byte(`{
+				"ref": "refs/heads/main",
+				"repository": {"full_name": "some-org/some-repo", "id": 1234567890123, "private": true, "owner": {}}
+			}`))
+			Expect(err).ToNot(HaveOccurred())
+
+			values := webhook.ExtractValues(payload, []atc.WebhookMatch{
+				{PayloadField: "ref"},
+				{PayloadField: "repository.full_name"},
+				{PayloadField: "repository.id"},
+				{PayloadField: "repository.private"},
+				{PayloadField: "repository.owner"},
+				{PayloadField: "repository.missing"},
+				{PayloadField: "ref.nested"},
+			})
+
+			Expect(values).To(Equal(map[string]string{
+				"ref":                  "refs/heads/main",
+				"repository.full_name": "some-org/some-repo",
+				"repository.id":        "1234567890123",
+				"repository.private":   "true",
+			}))
+		})
+	})
+
+	Describe("Matches", func() {
+		var (
+			rules  []atc.WebhookMatch
+			values map[string]string
+		)
+
+		BeforeEach(func() {
+			rules = []atc.WebhookMatch{{PayloadField: "repository.full_name", SourceField: "uri"}}
+			values = map[string]string{"repository.full_name": "some-org/some-repo"}
+		})
+
+		DescribeTable("uris",
+			func(uri string, matches bool) {
+				Expect(webhook.Matches(atc.Source{"uri": uri}, rules, values)).To(Equal(matches))
+			},
+			Entry("https", "https://github.com/some-org/some-repo", true),
+			Entry("https with .git", "https://github.com/some-org/some-repo.git", true),
+			Entry("ssh", "git@github.com:some-org/some-repo.git", true),
+			Entry("different case", "https://github.com/Some-Org/Some-Repo", true),
+			Entry("another repo", "https://github.com/some-org/other-repo", false),
+			Entry("a repo with the same prefix", "https://github.com/some-org/some-repo-fork", false),
+			Entry("an org with the same suffix", "https://github.com/awesome-org/some-repo", false),
+		)
+
+		It("does not match when the source field is missing", func() {
+			Expect(webhook.Matches(atc.Source{"repository": "some-org/some-repo"}, rules, values)).To(BeFalse())
+		})
+
+		It("does not match when the payload field is missing", func() {
+			Expect(webhook.Matches(atc.Source{"uri": "https://github.com/some-org/some-repo"}, rules, map[string]string{})).To(BeFalse())
+		})
+
+		It("does not match without any rules", func() {
+			Expect(webhook.Matches(atc.Source{"uri": "https://github.com/some-org/some-repo"}, nil, values)).To(BeFalse())
+		})
+
+		Context("with several rules", func() {
+			BeforeEach(func() {
+				rules = append(rules, atc.WebhookMatch{PayloadField: "repository.default_branch", SourceField: "branch"})
+				values["repository.default_branch"] = "main"
+			})
+
+			It("requires all of them to match", func() {
+				Expect(webhook.Matches(atc.Source{
+					"uri":    "https://github.com/some-org/some-repo",
+					"branch": "main",
+				}, rules, values)).To(BeTrue())
+
+				Expect(webhook.Matches(atc.Source{
+					"uri":    "https://github.com/some-org/some-repo",
+					"branch": "develop",
+				}, rules, values)).To(BeFalse())
+			})
+		})
+
+		Context("with a nested source field", func() {
+			BeforeEach(func() {
+				rules = []atc.WebhookMatch{{PayloadField: "repository.full_name", SourceField: "repo.name"}}
+			})
+
+			It("looks it up", func() {
+				Expect(webhook.Matches(atc.Source{
+					"repo": map[string]interface{}{"name": "some-org/some-repo"},
+				}, rules, values)).To(BeTrue())
+			})
+		})
+	})
+})
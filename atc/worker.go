@@ -20,6 +20,16 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	ResourcePressure *WorkerResourcePressure `json:"resource_pressure,omitempty"`
+
+	// Set by workers which have found themselves to be unhealthy. Such workers
+	// are landing until they recover.
+	StalledReason string `json:"stalled_reason,omitempty"`
+
+	// Set when an admin has asked for a diagnostics bundle which the worker has
+	// not yet reported.
+	DiagnosticsRequested bool `json:"diagnostics_requested,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -56,6 +66,59 @@ type WorkerResourceType struct {
 	UniqueVersionHistory bool   `json:"unique_version_history"`
 }
 
+// WorkerResourcePressure is a sample of how saturated a worker's host is,
+// reported by the worker itself on each heartbeat.
+type WorkerResourcePressure struct {
+	// Load average over the last minute, divided by the number of CPUs.
+	CPULoad float64 `json:"cpu_load"`
+
+	// Fraction of memory in use, from 0 to 1.
+	MemoryPressure float64 `json:"memory_pressure"`
+
+	// Free space on the filesystem backing the worker's work dir.
+	FreeDiskBytes uint64 `json:"free_disk_bytes"`
+
+	// Number of file descriptors currently allocated on the host.
+	OpenFiles int `json:"open_files"`
+}
+
 type PruneWorkerResponseBody struct {
 	Stderr string `json:"stderr"`
 }
+
+// WorkerDiagnostics is a bundle of information collected by a worker on
+// request, for debugging workers which cannot be reached directly.
+type WorkerDiagnostics struct {
+	CollectedAt int64 `json:"collected_at"`
+
+	Version        string `json:"version"`
+	Runtime        string `json:"runtime"`
+	RuntimeVersion string `json:"runtime_version,omitempty"`
+
+	Containers []WorkerDiagnosticsContainer `json:"containers"`
+	Volumes    []WorkerDiagnosticsVolume    `json:"volumes"`
+	Disk       *WorkerDiagnosticsDisk       `json:"disk,omitempty"`
+
+	// The most recent lines logged by the worker, oldest first.
+	Logs []string `json:"logs"`
+
+	// Any errors encountered while collecting the rest of the bundle; a
+	// partial bundle is more useful than none at all.
+	Errors []string `json:"errors,omitempty"`
+}
+
+type WorkerDiagnosticsContainer struct {
+	Handle string `json:"handle"`
+	State  string `json:"state"`
+}
+
+type WorkerDiagnosticsVolume struct {
+	Handle string `json:"handle"`
+	Path   string `json:"path"`
+}
+
+type WorkerDiagnosticsDisk struct {
+	Path       string `json:"path"`
+	TotalBytes uint64 `json:"total_bytes"`
+	FreeBytes  uint64 `json:"free_bytes"`
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"strconv"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -65,14 +66,16 @@ type Client interface {
 	) (GetResult, error)
 }
 
-func NewClient(worker Worker) *client {
+func NewClient(worker Worker, clock clock.Clock) *client {
 	return &client{
 		worker: worker,
+		clock:  clock,
 	}
 }
 
 type client struct {
 	worker Worker
+	clock  clock.Clock
 }
 
 type TaskResult struct {
@@ -115,6 +118,9 @@ func (client *client) RunCheckStep(
 ) (CheckResult, error) {
 	logger := lagerctx.FromContext(ctx)
 
+	ctx, landed, stopWatching := client.watchForLanding(ctx)
+	defer stopWatching()
+
 	container, err := client.worker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -130,6 +136,10 @@ func (client *client) RunCheckStep(
 
 	versions, err := checkable.Check(ctx, processSpec, container)
 	if err != nil {
+		if landed() {
+			return CheckResult{}, WorkerLandingError{WorkerName: client.Name()}
+		}
+
 		return CheckResult{}, fmt.Errorf("check: %w", err)
 	}
 
@@ -146,6 +156,9 @@ func (client *client) RunTaskStep(
 ) (TaskResult, error) {
 	logger := lagerctx.FromContext(ctx)
 
+	ctx, landed, stopWatching := client.watchForLanding(ctx)
+	defer stopWatching()
+
 	container, err := client.worker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -230,6 +243,11 @@ func (client *client) RunTaskStep(
 		}
 
 		status := <-exitStatusChan
+
+		if landed() {
+			return TaskResult{}, WorkerLandingError{WorkerName: client.Name()}
+		}
+
 		return TaskResult{
 			ExitStatus:   status.processStatus,
 			VolumeMounts: container.VolumeMounts(),
@@ -274,6 +292,9 @@ func (client *client) RunGetStep(
 
 	lockName := lockName(sign, client.worker.Name())
 
+	ctx, landed, stopWatching := client.watchForLanding(ctx)
+	defer stopWatching()
+
 	// TODO: this needs to be emitted right before executing the `in` script
 	eventDelegate.Starting(logger)
 
@@ -289,6 +310,10 @@ func (client *client) RunGetStep(
 		resourceCache,
 		lockName,
 	)
+	if err != nil && landed() {
+		return GetResult{}, WorkerLandingError{WorkerName: client.Name()}
+	}
+
 	return getResult, err
 }
 
@@ -303,6 +328,9 @@ func (client *client) RunPutStep(
 ) (PutResult, error) {
 	logger := lagerctx.FromContext(ctx)
 
+	ctx, landed, stopWatching := client.watchForLanding(ctx)
+	defer stopWatching()
+
 	container, err := client.worker.FindOrCreateContainer(
 		ctx,
 		logger,
@@ -334,6 +362,10 @@ func (client *client) RunPutStep(
 
 	vr, err := resource.Put(ctx, spec, container)
 	if err != nil {
+		if landed() {
+			return PutResult{}, WorkerLandingError{WorkerName: client.Name()}
+		}
+
 		if failErr, ok := err.(runtime.ErrResourceScriptFailed); ok {
 			return PutResult{
 				ExitStatus:    failErr.ExitStatus,
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"code.cloudfoundry.org/lager"
@@ -28,6 +30,7 @@ import (
 var _ = Describe("Client", func() {
 	var (
 		fakeWorker *workerfakes.FakeWorker
+		fakeClock  *fakeclock.FakeClock
 		client     worker.Client
 	)
 
@@ -35,11 +38,14 @@ var _ = Describe("Client", func() {
 		fakeWorker = new(workerfakes.FakeWorker)
 		fakeWorker.NameReturns("some-worker")
 
-		client = worker.NewClient(fakeWorker)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		client = worker.NewClient(fakeWorker, fakeClock)
 	})
 
 	Describe("RunCheckStep", func() {
 		var (
+			ctx               context.Context
 			containerSpec     worker.ContainerSpec
 			result            worker.CheckResult
 			err, expectedErr  error
@@ -49,6 +55,7 @@ var _ = Describe("Client", func() {
 		)
 
 		BeforeEach(func() {
+			ctx = context.Background()
 			fakeResource = new(resourcefakes.FakeResource)
 			fakeEventDelegate = new(runtimefakes.FakeStartingEventDelegate)
 			stdout := new(gbytes.Buffer)
@@ -72,7 +79,7 @@ var _ = Describe("Client", func() {
 			owner := new(dbfakes.FakeContainerOwner)
 
 			result, err = client.RunCheckStep(
-				context.Background(),
+				ctx,
 				owner,
 				containerSpec,
 				metadata,
@@ -149,6 +156,65 @@ var _ = Describe("Client", func() {
 					Expect(errors.Is(err, expectedErr)).To(BeTrue())
 				})
 			})
+
+			Context("when run with RescheduleOnLanding", func() {
+				BeforeEach(func() {
+					ctx = worker.RescheduleOnLanding(context.Background())
+
+					fakeResource.CheckStub = func(ctx context.Context, _ runtime.ProcessSpec, _ runtime.Runner) ([]atc.Version, error) {
+						<-ctx.Done()
+						return nil, ctx.Err()
+					}
+				})
+
+				Context("when the worker starts landing", func() {
+					BeforeEach(func() {
+						polls := 0
+						fakeWorker.CurrentStateStub = func() (db.WorkerState, bool, error) {
+							polls++
+							if polls == 1 {
+								// let the next poll happen
+								fakeClock.Increment(worker.LandingPollInterval)
+								return db.WorkerStateRunning, true, nil
+							}
+
+							return db.WorkerStateLanding, true, nil
+						}
+
+						go fakeClock.WaitForWatcherAndIncrement(worker.LandingPollInterval)
+					})
+
+					It("interrupts the check and returns a WorkerLandingError", func() {
+						Expect(err).To(Equal(worker.WorkerLandingError{WorkerName: "some-worker"}))
+						Expect(fakeWorker.CurrentStateCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("when the worker goes away", func() {
+					BeforeEach(func() {
+						fakeWorker.CurrentStateReturns("", false, nil)
+
+						go fakeClock.WaitForWatcherAndIncrement(worker.LandingPollInterval)
+					})
+
+					It("returns a WorkerLandingError", func() {
+						Expect(err).To(Equal(worker.WorkerLandingError{WorkerName: "some-worker"}))
+					})
+				})
+
+				Context("when the check finishes while the worker is running", func() {
+					BeforeEach(func() {
+						fakeWorker.CurrentStateReturns(db.WorkerStateRunning, true, nil)
+						fakeResource.CheckStub = nil
+						fakeResource.CheckReturns([]atc.Version{{"version": "1"}}, nil)
+					})
+
+					It("returns the versions", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(result.Versions).To(Equal([]atc.Version{{"version": "1"}}))
+					})
+				})
+			})
 		})
 	})
 
@@ -646,6 +712,36 @@ var _ = Describe("Client", func() {
 					})
 				})
 
+				Context("when run with RescheduleOnLanding and the worker starts landing", func() {
+					BeforeEach(func() {
+						ctx = worker.RescheduleOnLanding(ctx)
+
+						fakeWorker.CurrentStateReturns(db.WorkerStateLanding, true, nil)
+
+						go fakeClock.WaitForWatcherAndIncrement(worker.LandingPollInterval)
+
+						stopped := make(chan struct{})
+						fakeProcess.WaitStub = func() (int, error) {
+							<-stopped
+							return 128 + 15, nil
+						}
+
+						fakeContainer.StopStub = func(bool) error {
+							close(stopped)
+							return nil
+						}
+					})
+
+					It("stops the container and returns a WorkerLandingError", func() {
+						Expect(fakeContainer.StopCallCount()).To(Equal(1))
+						Expect(err).To(Equal(worker.WorkerLandingError{WorkerName: "some-worker"}))
+					})
+
+					It("does not save the exit status", func() {
+						Expect(fakeContainer.SetPropertyCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when the process exits successfully", func() {
 					It("returns a successful result", func() {
 						Expect(status).To(BeZero())
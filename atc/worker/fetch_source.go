@@ -140,6 +140,19 @@ func (s *fetchSource) Create(ctx context.Context) (GetResult, Volume, error) {
 		return findResult, volume, nil
 	}
 
+	_, found, err = s.worker.FindOrCreateSharedVolumeForResourceCache(s.logger, s.cache)
+	if err != nil {
+		sLog.Error("failed-to-create-shared-volume", err)
+		return GetResult{}, nil, err
+	}
+
+	if found {
+		sLog.Debug("shared-volume-with-same-digest")
+
+		findResult, volume, _, err = s.Find()
+		return findResult, volume, err
+	}
+
 	s.containerSpec.BindMounts = []BindMountSource{
 		&CertsVolumeMount{Logger: s.logger},
 	}
@@ -265,5 +265,51 @@ var _ = Describe("FetchSource", func() {
 				Expect(volume).ToNot(BeNil())
 			})
 		})
+
+		Context("when a volume with the same digest can be shared", func() {
+			var sharedVolume *workerfakes.FakeVolume
+
+			BeforeEach(func() {
+				sharedVolume = new(workerfakes.FakeVolume)
+				sharedVolume.HandleReturns("shared-handle")
+
+				fakeWorker.FindVolumeForResourceCacheReturnsOnCall(0, nil, false, nil)
+				fakeWorker.FindVolumeForResourceCacheReturnsOnCall(1, sharedVolume, true, nil)
+				fakeWorker.FindOrCreateSharedVolumeForResourceCacheReturns(sharedVolume, true, nil)
+			})
+
+			It("shares the volume for the resource cache", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateSharedVolumeForResourceCacheCallCount()).To(Equal(1))
+				_, rc := fakeWorker.FindOrCreateSharedVolumeForResourceCacheArgsForCall(0)
+				Expect(rc).To(Equal(fakeUsedResourceCache))
+			})
+
+			It("does not fetch resource", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(0))
+				Expect(fakeResource.GetCallCount()).To(Equal(0))
+			})
+
+			It("returns a successful GetResult with the shared volume", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(getResult.ExitStatus).To(BeZero())
+				Expect(getResult.GetArtifact.VolumeHandle).To(Equal("shared-handle"))
+				Expect(volume).To(Equal(sharedVolume))
+			})
+
+			Context("when sharing the volume fails", func() {
+				var disaster error
+
+				BeforeEach(func() {
+					disaster = errors.New("failed")
+					fakeWorker.FindOrCreateSharedVolumeForResourceCacheReturns(nil, false, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(disaster))
+				})
+			})
+		})
 	})
 })
@@ -8,14 +8,20 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type ContainerPlacementStrategyOptions struct {
-	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
+	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" choice:"limit-resource-pressure" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
 	MaxActiveTasksPerWorker      int      `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
 	MaxActiveContainersPerWorker int      `long:"max-active-containers-per-worker" default:"0" description:"Maximum allowed number of active containers per worker. Has effect only when used with limit-active-containers placement strategy. 0 means no limit."`
 	MaxActiveVolumesPerWorker    int      `long:"max-active-volumes-per-worker" default:"0" description:"Maximum allowed number of active volumes per worker. Has effect only when used with limit-active-volumes placement strategy. 0 means no limit."`
+
+	MaxCPULoadPerWorker        float64 `long:"max-cpu-load-per-worker" default:"0" description:"Maximum allowed load average per CPU reported by a worker. Has effect only when used with limit-resource-pressure placement strategy. 0 means no limit."`
+	MaxMemoryPressurePerWorker float64 `long:"max-memory-pressure-per-worker" default:"0" description:"Maximum allowed fraction (0-1) of memory in use reported by a worker. Has effect only when used with limit-resource-pressure placement strategy. 0 means no limit."`
+	MinFreeDiskPerWorker       uint64  `long:"min-free-disk-per-worker" default:"0" description:"Minimum free bytes in the work dir reported by a worker. Has effect only when used with limit-resource-pressure placement strategy. 0 means no limit."`
+	MaxOpenFilesPerWorker      int     `long:"max-open-files-per-worker" default:"0" description:"Maximum allowed number of open file descriptors reported by a worker. Has effect only when used with limit-resource-pressure placement strategy. 0 means no limit."`
 }
 
 type NoWorkerFitContainerPlacementStrategyError struct {
@@ -67,6 +73,17 @@ func NewContainerPlacementStrategy(opts ContainerPlacementStrategyOptions) (*con
 				return nil, errors.New("max-active-volumes-per-worker must be greater or equal than 0")
 			}
 			cps.nodes = append(cps.nodes, newLimitActiveVolumesPlacementStrategy(strategy, opts.MaxActiveVolumesPerWorker))
+		case "limit-resource-pressure":
+			if opts.MaxCPULoadPerWorker < 0 {
+				return nil, errors.New("max-cpu-load-per-worker must be greater or equal than 0")
+			}
+			if opts.MaxMemoryPressurePerWorker < 0 || opts.MaxMemoryPressurePerWorker > 1 {
+				return nil, errors.New("max-memory-pressure-per-worker must be between 0 and 1")
+			}
+			if opts.MaxOpenFilesPerWorker < 0 {
+				return nil, errors.New("max-open-files-per-worker must be greater or equal than 0")
+			}
+			cps.nodes = append(cps.nodes, newLimitResourcePressurePlacementStrategy(strategy, opts))
 		case "volume-locality":
 			cps.nodes = append(cps.nodes, newVolumeLocalityPlacementStrategyNode(strategy))
 		default:
@@ -293,3 +310,69 @@ func (strategy *LimitActiveVolumesPlacementStrategyNode) ModifiesActiveTasks() b
 func (strategy *LimitActiveVolumesPlacementStrategyNode) StrategyName() string {
 	return strategy.GivenName
 }
+
+type LimitResourcePressurePlacementStrategyNode struct {
+	GivenName         string
+	maxCPULoad        float64
+	maxMemoryPressure float64
+	minFreeDisk       uint64
+	maxOpenFiles      int
+}
+
+func newLimitResourcePressurePlacementStrategy(name string, opts ContainerPlacementStrategyOptions) ContainerPlacementStrategyChainNode {
+	return &LimitResourcePressurePlacementStrategyNode{
+		GivenName:         name,
+		maxCPULoad:        opts.MaxCPULoadPerWorker,
+		maxMemoryPressure: opts.MaxMemoryPressurePerWorker,
+		minFreeDisk:       opts.MinFreeDiskPerWorker,
+		maxOpenFiles:      opts.MaxOpenFilesPerWorker,
+	}
+}
+
+func (strategy *LimitResourcePressurePlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates := []Worker{}
+
+	for _, w := range workers {
+		if strategy.saturated(w.ResourcePressure()) {
+			logger.Info("worker-saturated", lager.Data{"worker": w.Name()})
+			continue
+		}
+
+		candidates = append(candidates, w)
+	}
+
+	return candidates, nil
+}
+
+// workers that don't report resource pressure are never considered saturated
+func (strategy *LimitResourcePressurePlacementStrategyNode) saturated(pressure *atc.WorkerResourcePressure) bool {
+	if pressure == nil {
+		return false
+	}
+
+	if strategy.maxCPULoad > 0 && pressure.CPULoad > strategy.maxCPULoad {
+		return true
+	}
+
+	if strategy.maxMemoryPressure > 0 && pressure.MemoryPressure > strategy.maxMemoryPressure {
+		return true
+	}
+
+	if strategy.minFreeDisk > 0 && pressure.FreeDiskBytes < strategy.minFreeDisk {
+		return true
+	}
+
+	if strategy.maxOpenFiles > 0 && pressure.OpenFiles > strategy.maxOpenFiles {
+		return true
+	}
+
+	return false
+}
+
+func (strategy *LimitResourcePressurePlacementStrategyNode) ModifiesActiveTasks() bool {
+	return false
+}
+
+func (strategy *LimitResourcePressurePlacementStrategyNode) StrategyName() string {
+	return strategy.GivenName
+}
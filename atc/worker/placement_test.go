@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -713,6 +714,113 @@ var _ = Describe("LimitActiveVolumesPlacementStrategyNode", func() {
 	})
 })
 
+var _ = Describe("LimitResourcePressurePlacementStrategyNode", func() {
+	Describe("Choose", func() {
+		var idleWorker *workerfakes.FakeWorker
+		var busyWorker *workerfakes.FakeWorker
+		var unreportedWorker *workerfakes.FakeWorker
+		var opts ContainerPlacementStrategyOptions
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("resource-pressure-placement-test")
+			idleWorker = new(workerfakes.FakeWorker)
+			idleWorker.NameReturns("idleWorker")
+			idleWorker.ResourcePressureReturns(&atc.WorkerResourcePressure{
+				CPULoad:        0.1,
+				MemoryPressure: 0.2,
+				FreeDiskBytes:  100 * 1024 * 1024 * 1024,
+				OpenFiles:      1000,
+			})
+			busyWorker = new(workerfakes.FakeWorker)
+			busyWorker.NameReturns("busyWorker")
+			busyWorker.ResourcePressureReturns(&atc.WorkerResourcePressure{
+				CPULoad:        3.5,
+				MemoryPressure: 0.95,
+				FreeDiskBytes:  1024 * 1024,
+				OpenFiles:      500000,
+			})
+			unreportedWorker = new(workerfakes.FakeWorker)
+			unreportedWorker.NameReturns("unreportedWorker")
+			workers = []Worker{idleWorker, busyWorker, unreportedWorker}
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+				TeamID:    4567,
+				Inputs:    []InputSource{},
+			}
+
+			opts = ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"limit-resource-pressure"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			strategy, newStrategyError = NewContainerPlacementStrategy(opts)
+		})
+
+		Context("when there are no limits", func() {
+			It("returns all workers", func() {
+				Expect(newStrategyError).ToNot(HaveOccurred())
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Or(Equal(idleWorker), Equal(busyWorker), Equal(unreportedWorker)))
+			})
+		})
+
+		for _, example := range []struct {
+			limit string
+			set   func(*ContainerPlacementStrategyOptions)
+		}{
+			{"cpu load", func(o *ContainerPlacementStrategyOptions) { o.MaxCPULoadPerWorker = 2 }},
+			{"memory pressure", func(o *ContainerPlacementStrategyOptions) { o.MaxMemoryPressurePerWorker = 0.9 }},
+			{"free disk", func(o *ContainerPlacementStrategyOptions) { o.MinFreeDiskPerWorker = 1024 * 1024 * 1024 }},
+			{"open files", func(o *ContainerPlacementStrategyOptions) { o.MaxOpenFilesPerWorker = 100000 }},
+		} {
+			example := example
+
+			Context("when there is a "+example.limit+" limit", func() {
+				BeforeEach(func() {
+					example.set(&opts)
+				})
+
+				It("skips the saturated worker", func() {
+					Expect(newStrategyError).ToNot(HaveOccurred())
+					Consistently(func() Worker {
+						chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+						Expect(chooseErr).ToNot(HaveOccurred())
+						return chosenWorker
+					}).Should(Or(Equal(idleWorker), Equal(unreportedWorker)))
+				})
+			})
+		}
+
+		Context("when every reporting worker is saturated", func() {
+			BeforeEach(func() {
+				opts.MaxCPULoadPerWorker = 0.01
+				workers = []Worker{idleWorker, busyWorker}
+			})
+
+			It("returns no worker", func() {
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).To(Equal(NoWorkerFitContainerPlacementStrategyError{Strategy: "limit-resource-pressure"}))
+				Expect(chosenWorker).To(BeNil())
+			})
+		})
+
+		Context("when the memory pressure limit is greater than 1", func() {
+			BeforeEach(func() {
+				opts.MaxMemoryPressurePerWorker = 90
+			})
+
+			It("returns an error", func() {
+				Expect(newStrategyError).To(HaveOccurred())
+			})
+		})
+	})
+})
+
 var _ = Describe("ChainedPlacementStrategy #Choose", func() {
 
 	var someWorker1 *workerfakes.FakeWorker
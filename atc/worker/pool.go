@@ -7,6 +7,7 @@ import (
 	"math/rand"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 
@@ -16,6 +17,7 @@ import (
 var (
 	ErrNoWorkers             = errors.New("no workers")
 	ErrFailedAcquirePoolLock = errors.New("failed to acquire pool lock")
+	ErrNoWorkerForVolume     = errors.New("no worker for volume")
 )
 
 type NoCompatibleWorkersError struct {
@@ -32,6 +34,7 @@ type Pool interface {
 	FindContainer(lager.Logger, int, string) (Container, bool, error)
 	VolumeFinder
 	CreateVolume(lager.Logger, VolumeSpec, WorkerSpec, db.VolumeType) (Volume, error)
+	CreateChildVolume(lager.Logger, int, Volume) (Volume, error)
 
 	ContainerInWorker(lager.Logger, db.ContainerOwner, WorkerSpec) (bool, error)
 
@@ -53,12 +56,14 @@ type VolumeFinder interface {
 type pool struct {
 	provider WorkerProvider
 	rand     *rand.Rand
+	clock    clock.Clock
 }
 
-func NewPool(provider WorkerProvider) Pool {
+func NewPool(provider WorkerProvider, clock clock.Clock) Pool {
 	return &pool{
 		provider: provider,
 		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		clock:    clock,
 	}
 }
 
@@ -143,6 +148,25 @@ func (pool *pool) CreateVolume(logger lager.Logger, volumeSpec VolumeSpec, worke
 	return worker.CreateVolume(logger, volumeSpec, workerSpec.TeamID, volumeType)
 }
 
+// CreateChildVolume creates a copy-on-write artifact volume of the parent
+// volume on the worker that holds it.
+func (pool *pool) CreateChildVolume(logger lager.Logger, teamID int, parent Volume) (Volume, error) {
+	worker, found, err := pool.provider.FindWorkerForVolume(
+		logger.Session("find-worker"),
+		teamID,
+		parent.Handle(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrNoWorkerForVolume
+	}
+
+	return worker.CreateChildVolume(logger, parent)
+}
+
 func (pool *pool) ContainerInWorker(logger lager.Logger, owner db.ContainerOwner, workerSpec WorkerSpec) (bool, error) {
 	workersWithContainer, err := pool.provider.FindWorkersForContainerByOwner(
 		logger.Session("find-worker"),
@@ -207,7 +231,7 @@ dance:
 			return nil, err
 		}
 	}
-	return NewClient(worker), nil
+	return NewClient(worker, pool.clock), nil
 }
 
 func (pool *pool) chooseRandomWorkerForVolume(
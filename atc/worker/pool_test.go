@@ -1,10 +1,12 @@
 package worker_test
 
 import (
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"context"
 	"errors"
+	"time"
 
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
@@ -28,7 +30,7 @@ var _ = Describe("Pool", func() {
 		logger = lagertest.NewTestLogger("test")
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
 
-		pool = NewPool(fakeProvider)
+		pool = NewPool(fakeProvider, fakeclock.NewFakeClock(time.Unix(123, 456)))
 	})
 
 	Describe("FindContainer", func() {
@@ -203,6 +205,75 @@ var _ = Describe("Pool", func() {
 		})
 	})
 
+	Describe("CreateChildVolume", func() {
+		var (
+			fakeParent *workerfakes.FakeVolume
+			fakeWorker *workerfakes.FakeWorker
+			fakeVolume *workerfakes.FakeVolume
+
+			childVolume worker.Volume
+			err         error
+		)
+
+		BeforeEach(func() {
+			fakeParent = new(workerfakes.FakeVolume)
+			fakeParent.HandleReturns("parent-handle")
+			fakeParent.COWStrategyReturns(baggageclaim.COWStrategy{})
+		})
+
+		JustBeforeEach(func() {
+			childVolume, err = pool.CreateChildVolume(logger, 1, fakeParent)
+		})
+
+		It("looks up the worker holding the parent volume", func() {
+			Expect(fakeProvider.FindWorkerForVolumeCallCount()).To(Equal(1))
+			_, teamID, handle := fakeProvider.FindWorkerForVolumeArgsForCall(0)
+			Expect(teamID).To(Equal(1))
+			Expect(handle).To(Equal("parent-handle"))
+		})
+
+		Context("when looking up the worker errors", func() {
+			BeforeEach(func() {
+				fakeProvider.FindWorkerForVolumeReturns(nil, false, errors.New("nope"))
+			})
+
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the worker is not found", func() {
+			BeforeEach(func() {
+				fakeProvider.FindWorkerForVolumeReturns(nil, false, nil)
+			})
+
+			It("errors", func() {
+				Expect(err).To(Equal(worker.ErrNoWorkerForVolume))
+			})
+		})
+
+		Context("when the worker is found", func() {
+			BeforeEach(func() {
+				fakeWorker = new(workerfakes.FakeWorker)
+				fakeProvider.FindWorkerForVolumeReturns(fakeWorker, true, nil)
+
+				fakeVolume = new(workerfakes.FakeVolume)
+				fakeWorker.CreateChildVolumeReturns(fakeVolume, nil)
+			})
+
+			It("creates a child of the parent on the worker", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeWorker.CreateChildVolumeCallCount()).To(Equal(1))
+				_, parent := fakeWorker.CreateChildVolumeArgsForCall(0)
+				Expect(parent).To(Equal(fakeParent))
+			})
+
+			It("returns the volume", func() {
+				Expect(childVolume).To(Equal(fakeVolume))
+			})
+		})
+	})
+
 	Describe("SelectWorker", func() {
 		var (
 			spec       ContainerSpec
@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// LandingPollInterval is how often the worker running a reschedulable step is
+// checked for whether it has started landing.
+const LandingPollInterval = 10 * time.Second
+
+// WorkerLandingError is returned when a step run with RescheduleOnLanding is
+// interrupted because its worker stopped running, so that it can be run
+// again on another worker.
+type WorkerLandingError struct {
+	WorkerName string
+}
+
+func (err WorkerLandingError) Error() string {
+	return fmt.Sprintf("worker %s is landing", err.WorkerName)
+}
+
+type rescheduleOnLandingKey struct{}
+
+// RescheduleOnLanding marks any step run with the returned context as safe to
+// interrupt if its worker starts landing.
+func RescheduleOnLanding(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rescheduleOnLandingKey{}, true)
+}
+
+func reschedulesOnLanding(ctx context.Context) bool {
+	reschedule, _ := ctx.Value(rescheduleOnLandingKey{}).(bool)
+	return reschedule
+}
+
+// watchForLanding returns a context which is cancelled once the worker is no
+// longer running, along with a func reporting whether that happened. The
+// returned stop func must be called once the step has finished.
+//
+// If the step was not run with RescheduleOnLanding, the context is returned
+// as-is.
+func (client *client) watchForLanding(ctx context.Context) (context.Context, func() bool, func()) {
+	if !reschedulesOnLanding(ctx) {
+		return ctx, func() bool { return false }, func() {}
+	}
+
+	logger := lagerctx.FromContext(ctx).Session("watch-for-landing")
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	landed := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		ticker := client.clock.NewTicker(LandingPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-watchCtx.Done():
+				return
+			case <-ticker.C():
+			}
+
+			state, found, err := client.worker.CurrentState()
+			if err != nil {
+				logger.Error("failed-to-get-worker-state", err)
+				continue
+			}
+
+			if found && state == db.WorkerStateRunning {
+				continue
+			}
+
+			logger.Info("worker-no-longer-running", lager.Data{
+				"worker": client.worker.Name(),
+				"state":  state,
+			})
+
+			close(landed)
+			cancel()
+			return
+		}
+	}()
+
+	hasLanded := func() bool {
+		select {
+		case <-landed:
+			return true
+		default:
+			return false
+		}
+	}
+
+	stop := func() {
+		close(done)
+		cancel()
+	}
+
+	return watchCtx, hasLanded, stop
+}
@@ -46,10 +46,19 @@ type VolumeClient interface {
 		string,
 		db.VolumeType,
 	) (Volume, error)
+	CreateCOWVolumeForArtifact(
+		lager.Logger,
+		VolumeSpec,
+		Volume,
+	) (Volume, error)
 	FindVolumeForResourceCache(
 		lager.Logger,
 		db.UsedResourceCache,
 	) (Volume, bool, error)
+	FindOrCreateSharedVolumeForResourceCache(
+		lager.Logger,
+		db.UsedResourceCache,
+	) (Volume, bool, error)
 	FindVolumeForTaskCache(
 		logger lager.Logger,
 		teamID int,
@@ -192,6 +201,23 @@ func (c *volumeClient) CreateVolume(
 	)
 }
 
+func (c *volumeClient) CreateCOWVolumeForArtifact(
+	logger lager.Logger,
+	volumeSpec VolumeSpec,
+	parent Volume,
+) (Volume, error) {
+	return c.findOrCreateVolume(
+		logger.Session("create-cow-volume-for-artifact"),
+		volumeSpec,
+		func() (db.CreatingVolume, db.CreatedVolume, error) {
+			return nil, nil, nil
+		},
+		func() (db.CreatingVolume, error) {
+			return parent.CreateChildForArtifact()
+		},
+	)
+}
+
 func (c *volumeClient) FindOrCreateVolumeForBaseResourceType(
 	logger lager.Logger,
 	volumeSpec VolumeSpec,
@@ -247,6 +273,61 @@ func (c *volumeClient) FindVolumeForResourceCache(
 	return NewVolume(bcVolume, dbVolume, c), true, nil
 }
 
+// FindOrCreateSharedVolumeForResourceCache looks for a volume on the worker
+// belonging to another resource cache with the same content digest. If one is
+// found, a copy-on-write child of it is initialized as the volume for the
+// given resource cache, so that identical content (e.g. an image pulled using
+// different credentials) is only stored once.
+func (c *volumeClient) FindOrCreateSharedVolumeForResourceCache(
+	logger lager.Logger,
+	usedResourceCache db.UsedResourceCache,
+) (Volume, bool, error) {
+	logger = logger.Session("find-or-create-shared-volume-for-resource-cache")
+
+	dbParentVolume, found, err := c.dbVolumeRepository.FindResourceCacheVolumeByDigest(c.dbWorker.Name(), usedResourceCache)
+	if err != nil {
+		logger.Error("failed-to-lookup-resource-cache-volume-by-digest-in-db", err)
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	bcParentVolume, found, err := c.baggageclaimClient.LookupVolume(logger, dbParentVolume.Handle())
+	if err != nil {
+		logger.Error("failed-to-lookup-volume-in-bc", err)
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	parent := NewVolume(bcParentVolume, dbParentVolume, c)
+
+	volume, err := c.findOrCreateVolume(
+		logger.WithData(lager.Data{"parent": parent.Handle()}),
+		VolumeSpec{
+			Strategy:   parent.COWStrategy(),
+			Privileged: false,
+		},
+		func() (db.CreatingVolume, db.CreatedVolume, error) {
+			return c.dbVolumeRepository.FindCreatingOrCreatedResourceCacheVolume(c.dbWorker.Name(), usedResourceCache)
+		},
+		func() (db.CreatingVolume, error) {
+			return dbParentVolume.CreateChildForResourceCache(usedResourceCache)
+		},
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	metric.Metrics.VolumesSharedByDigest.Inc()
+
+	return volume, true, nil
+}
+
 func (c *volumeClient) CreateVolumeForTaskCache(
 	logger lager.Logger,
 	volumeSpec VolumeSpec,
@@ -567,6 +567,177 @@ var _ = Describe("VolumeClient", func() {
 		})
 	})
 
+	Describe("FindOrCreateSharedVolumeForResourceCache", func() {
+		var (
+			fakeResourceCache      *dbfakes.FakeUsedResourceCache
+			fakeParentBCVolume     *baggageclaimfakes.FakeVolume
+			fakeBaggageclaimVolume *baggageclaimfakes.FakeVolume
+			fakeParentVolume       *dbfakes.FakeCreatedVolume
+			fakeCreatingVolume     *dbfakes.FakeCreatingVolume
+			fakeCreatedVolume      *dbfakes.FakeCreatedVolume
+
+			volume worker.Volume
+			found  bool
+			err    error
+		)
+
+		BeforeEach(func() {
+			fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+
+			fakeParentBCVolume = new(baggageclaimfakes.FakeVolume)
+			fakeParentBCVolume.HandleReturns("parent-handle")
+
+			fakeBaggageclaimVolume = new(baggageclaimfakes.FakeVolume)
+			fakeBaggageclaimVolume.HandleReturns("child-handle")
+
+			fakeParentVolume = new(dbfakes.FakeCreatedVolume)
+			fakeParentVolume.HandleReturns("parent-handle")
+
+			fakeCreatedVolume = new(dbfakes.FakeCreatedVolume)
+			fakeCreatedVolume.HandleReturns("child-handle")
+
+			fakeCreatingVolume = new(dbfakes.FakeCreatingVolume)
+			fakeCreatingVolume.HandleReturns("child-handle")
+			fakeCreatingVolume.CreatedReturns(fakeCreatedVolume, nil)
+
+			fakeParentVolume.CreateChildForResourceCacheReturns(fakeCreatingVolume, nil)
+			fakeLockFactory.AcquireReturns(fakeLock, true, nil)
+
+			fakeBaggageclaimClient.LookupVolumeStub = func(logger lager.Logger, handle string) (baggageclaim.Volume, bool, error) {
+				if handle == "parent-handle" {
+					return fakeParentBCVolume, true, nil
+				}
+
+				return nil, false, nil
+			}
+			fakeBaggageclaimClient.CreateVolumeReturns(fakeBaggageclaimVolume, nil)
+		})
+
+		JustBeforeEach(func() {
+			volume, found, err = volumeClient.FindOrCreateSharedVolumeForResourceCache(testLogger, fakeResourceCache)
+		})
+
+		Context("when no volume with the same digest exists on the worker", func() {
+			BeforeEach(func() {
+				fakeDBVolumeRepository.FindResourceCacheVolumeByDigestReturns(nil, false, nil)
+			})
+
+			It("does not find a volume", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+				Expect(volume).To(BeNil())
+			})
+
+			It("does not create a volume", func() {
+				Expect(fakeBaggageclaimClient.CreateVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when looking up the volume by digest fails", func() {
+			var disaster = errors.New("disaster")
+
+			BeforeEach(func() {
+				fakeDBVolumeRepository.FindResourceCacheVolumeByDigestReturns(nil, false, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(Equal(disaster))
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when a volume with the same digest exists on the worker", func() {
+			BeforeEach(func() {
+				fakeDBVolumeRepository.FindResourceCacheVolumeByDigestReturns(fakeParentVolume, true, nil)
+			})
+
+			It("looks up the volume on the worker for the resource cache", func() {
+				Expect(fakeDBVolumeRepository.FindResourceCacheVolumeByDigestCallCount()).To(Equal(1))
+				workerName, resourceCache := fakeDBVolumeRepository.FindResourceCacheVolumeByDigestArgsForCall(0)
+				Expect(workerName).To(Equal("some-worker"))
+				Expect(resourceCache).To(Equal(fakeResourceCache))
+			})
+
+			It("creates a child volume for the resource cache in the db", func() {
+				Expect(fakeParentVolume.CreateChildForResourceCacheCallCount()).To(Equal(1))
+				Expect(fakeParentVolume.CreateChildForResourceCacheArgsForCall(0)).To(Equal(fakeResourceCache))
+			})
+
+			It("creates a copy-on-write volume in baggageclaim", func() {
+				Expect(fakeBaggageclaimClient.CreateVolumeCallCount()).To(Equal(1))
+				_, handle, spec := fakeBaggageclaimClient.CreateVolumeArgsForCall(0)
+				Expect(handle).To(Equal("child-handle"))
+				Expect(spec.Strategy).To(Equal(baggageclaim.COWStrategy{Parent: fakeParentBCVolume}))
+				Expect(spec.Privileged).To(BeFalse())
+			})
+
+			It("marks the volume as created and returns it", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(fakeCreatingVolume.CreatedCallCount()).To(Equal(1))
+				Expect(volume.Handle()).To(Equal("child-handle"))
+			})
+
+			Context("when it could not acquire creating lock", func() {
+				BeforeEach(func() {
+					callCount := 0
+					fakeLockFactory.AcquireStub = func(logger lager.Logger, lockID lock.LockID) (lock.Lock, bool, error) {
+						callCount++
+						go fakeClock.WaitForWatcherAndIncrement(time.Second)
+
+						if callCount < 3 {
+							fakeDBVolumeRepository.FindCreatingOrCreatedResourceCacheVolumeReturns(fakeCreatingVolume, nil, nil)
+							return nil, false, nil
+						}
+
+						return fakeLock, true, nil
+					}
+				})
+
+				It("retries to find the volume created for the resource cache", func() {
+					Expect(fakeLockFactory.AcquireCallCount()).To(Equal(3))
+					Expect(fakeDBVolumeRepository.FindCreatingOrCreatedResourceCacheVolumeCallCount()).To(Equal(3))
+
+					workerName, resourceCache := fakeDBVolumeRepository.FindCreatingOrCreatedResourceCacheVolumeArgsForCall(2)
+					Expect(workerName).To(Equal("some-worker"))
+					Expect(resourceCache).To(Equal(fakeResourceCache))
+				})
+
+				It("only creates the child volume once", func() {
+					Expect(fakeParentVolume.CreateChildForResourceCacheCallCount()).To(Equal(1))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(volume.Handle()).To(Equal("child-handle"))
+				})
+			})
+
+			Context("when the volume is missing from baggageclaim", func() {
+				BeforeEach(func() {
+					fakeBaggageclaimClient.LookupVolumeReturns(nil, false, nil)
+					fakeBaggageclaimClient.LookupVolumeStub = nil
+				})
+
+				It("does not find a volume", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+					Expect(fakeParentVolume.CreateChildForResourceCacheCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when creating the child volume fails", func() {
+				var disaster = errors.New("disaster")
+
+				BeforeEach(func() {
+					fakeParentVolume.CreateChildForResourceCacheReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(disaster))
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("FindVolumeForTaskCache", func() {
 		Context("when worker task cache does not exist", func() {
 			BeforeEach(func() {
@@ -57,6 +57,7 @@ type Worker interface {
 	) (Container, error)
 
 	FindVolumeForResourceCache(logger lager.Logger, resourceCache db.UsedResourceCache) (Volume, bool, error)
+	FindOrCreateSharedVolumeForResourceCache(logger lager.Logger, resourceCache db.UsedResourceCache) (Volume, bool, error)
 	FindResourceCacheForVolume(volume Volume) (db.UsedResourceCache, bool, error)
 	FindVolumeForTaskCache(lager.Logger, int, int, string, string) (Volume, bool, error)
 	Fetch(
@@ -75,12 +76,15 @@ type Worker interface {
 	CertsVolume(lager.Logger) (volume Volume, found bool, err error)
 	LookupVolume(lager.Logger, string) (Volume, bool, error)
 	CreateVolume(logger lager.Logger, spec VolumeSpec, teamID int, volumeType db.VolumeType) (Volume, error)
+	CreateChildVolume(logger lager.Logger, parent Volume) (Volume, error)
 
 	GardenClient() gclient.Client
 	ActiveTasks() (int, error)
+	CurrentState() (db.WorkerState, bool, error)
 
 	ActiveContainers() int
 	ActiveVolumes() int
+	ResourcePressure() *atc.WorkerResourcePressure
 }
 
 type gardenWorker struct {
@@ -181,6 +185,10 @@ func (worker *gardenWorker) FindVolumeForResourceCache(logger lager.Logger, reso
 	return worker.volumeClient.FindVolumeForResourceCache(logger, resourceCache)
 }
 
+func (worker *gardenWorker) FindOrCreateSharedVolumeForResourceCache(logger lager.Logger, resourceCache db.UsedResourceCache) (Volume, bool, error) {
+	return worker.volumeClient.FindOrCreateSharedVolumeForResourceCache(logger, resourceCache)
+}
+
 func (worker *gardenWorker) FindResourceCacheForVolume(volume Volume) (db.UsedResourceCache, bool, error) {
 	if volume.GetResourceCacheID() != 0 {
 		return worker.resourceCacheFactory.FindResourceCacheByID(volume.GetResourceCacheID())
@@ -202,6 +210,16 @@ func (worker *gardenWorker) CreateVolume(logger lager.Logger, spec VolumeSpec, t
 	return worker.volumeClient.CreateVolume(logger.Session("find-or-create"), spec, teamID, worker.dbWorker.Name(), volumeType)
 }
 
+// CreateChildVolume creates a copy-on-write artifact volume of the parent,
+// recording the parent so that it is not collected while the child exists.
+func (worker *gardenWorker) CreateChildVolume(logger lager.Logger, parent Volume) (Volume, error) {
+	spec := VolumeSpec{
+		Strategy: parent.COWStrategy(),
+	}
+
+	return worker.volumeClient.CreateCOWVolumeForArtifact(logger.Session("create-child"), spec, parent)
+}
+
 func (worker *gardenWorker) LookupVolume(logger lager.Logger, handle string) (Volume, bool, error) {
 	return worker.volumeClient.LookupVolume(logger, handle)
 }
@@ -789,6 +807,10 @@ func (worker *gardenWorker) DecreaseActiveTasks() error {
 	return worker.dbWorker.DecreaseActiveTasks()
 }
 
+func (worker *gardenWorker) CurrentState() (db.WorkerState, bool, error) {
+	return worker.dbWorker.CurrentState()
+}
+
 func (worker *gardenWorker) ActiveContainers() int {
 	return worker.dbWorker.ActiveContainers()
 }
@@ -796,3 +818,7 @@ func (worker *gardenWorker) ActiveContainers() int {
 func (worker *gardenWorker) ActiveVolumes() int {
 	return worker.dbWorker.ActiveVolumes()
 }
+
+func (worker *gardenWorker) ResourcePressure() *atc.WorkerResourcePressure {
+	return worker.dbWorker.ResourcePressure()
+}
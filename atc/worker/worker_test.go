@@ -530,6 +530,39 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("CreateChildVolume", func() {
+		var (
+			fakeParent *workerfakes.FakeVolume
+			fakeVolume *workerfakes.FakeVolume
+			volume     Volume
+			err        error
+		)
+
+		BeforeEach(func() {
+			fakeParent = new(workerfakes.FakeVolume)
+			fakeParent.COWStrategyReturns(baggageclaim.COWStrategy{})
+
+			fakeVolume = new(workerfakes.FakeVolume)
+			fakeVolumeClient.CreateCOWVolumeForArtifactReturns(fakeVolume, nil)
+		})
+
+		JustBeforeEach(func() {
+			volume, err = gardenWorker.CreateChildVolume(logger, fakeParent)
+		})
+
+		It("creates a copy-on-write volume of the parent", func() {
+			Expect(fakeVolumeClient.CreateCOWVolumeForArtifactCallCount()).To(Equal(1))
+			_, spec, parent := fakeVolumeClient.CreateCOWVolumeForArtifactArgsForCall(0)
+			Expect(spec).To(Equal(VolumeSpec{
+				Strategy: baggageclaim.COWStrategy{},
+			}))
+			Expect(parent).To(Equal(fakeParent))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volume).To(Equal(fakeVolume))
+		})
+	})
+
 	Describe("Satisfies", func() {
 		var (
 			spec WorkerSpec
@@ -26,6 +26,21 @@ type FakePool struct {
 		result1 bool
 		result2 error
 	}
+	CreateChildVolumeStub        func(lager.Logger, int, worker.Volume) (worker.Volume, error)
+	createChildVolumeMutex       sync.RWMutex
+	createChildVolumeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 worker.Volume
+	}
+	createChildVolumeReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createChildVolumeReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, worker.WorkerSpec, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -162,6 +177,71 @@ func (fake *FakePool) ContainerInWorkerReturnsOnCall(i int, result1 bool, result
 	}{result1, result2}
 }
 
+func (fake *FakePool) CreateChildVolume(arg1 lager.Logger, arg2 int, arg3 worker.Volume) (worker.Volume, error) {
+	fake.createChildVolumeMutex.Lock()
+	ret, specificReturn := fake.createChildVolumeReturnsOnCall[len(fake.createChildVolumeArgsForCall)]
+	fake.createChildVolumeArgsForCall = append(fake.createChildVolumeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 worker.Volume
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateChildVolume", []interface{}{arg1, arg2, arg3})
+	fake.createChildVolumeMutex.Unlock()
+	if fake.CreateChildVolumeStub != nil {
+		return fake.CreateChildVolumeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePool) CreateChildVolumeCallCount() int {
+	fake.createChildVolumeMutex.RLock()
+	defer fake.createChildVolumeMutex.RUnlock()
+	return len(fake.createChildVolumeArgsForCall)
+}
+
+func (fake *FakePool) CreateChildVolumeCalls(stub func(lager.Logger, int, worker.Volume) (worker.Volume, error)) {
+	fake.createChildVolumeMutex.Lock()
+	defer fake.createChildVolumeMutex.Unlock()
+	fake.CreateChildVolumeStub = stub
+}
+
+func (fake *FakePool) CreateChildVolumeArgsForCall(i int) (lager.Logger, int, worker.Volume) {
+	fake.createChildVolumeMutex.RLock()
+	defer fake.createChildVolumeMutex.RUnlock()
+	argsForCall := fake.createChildVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePool) CreateChildVolumeReturns(result1 worker.Volume, result2 error) {
+	fake.createChildVolumeMutex.Lock()
+	defer fake.createChildVolumeMutex.Unlock()
+	fake.CreateChildVolumeStub = nil
+	fake.createChildVolumeReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePool) CreateChildVolumeReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createChildVolumeMutex.Lock()
+	defer fake.createChildVolumeMutex.Unlock()
+	fake.CreateChildVolumeStub = nil
+	if fake.createChildVolumeReturnsOnCall == nil {
+		fake.createChildVolumeReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createChildVolumeReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePool) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 worker.WorkerSpec, arg4 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -436,6 +516,8 @@ func (fake *FakePool) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.containerInWorkerMutex.RLock()
 	defer fake.containerInWorkerMutex.RUnlock()
+	fake.createChildVolumeMutex.RLock()
+	defer fake.createChildVolumeMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
 	fake.findContainerMutex.RLock()
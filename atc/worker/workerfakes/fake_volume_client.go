@@ -10,6 +10,21 @@ import (
 )
 
 type FakeVolumeClient struct {
+	CreateCOWVolumeForArtifactStub        func(lager.Logger, worker.VolumeSpec, worker.Volume) (worker.Volume, error)
+	createCOWVolumeForArtifactMutex       sync.RWMutex
+	createCOWVolumeForArtifactArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.VolumeSpec
+		arg3 worker.Volume
+	}
+	createCOWVolumeForArtifactReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createCOWVolumeForArtifactReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, int, string, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -63,6 +78,22 @@ type FakeVolumeClient struct {
 		result1 worker.Volume
 		result2 error
 	}
+	FindOrCreateSharedVolumeForResourceCacheStub        func(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error)
+	findOrCreateSharedVolumeForResourceCacheMutex       sync.RWMutex
+	findOrCreateSharedVolumeForResourceCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.UsedResourceCache
+	}
+	findOrCreateSharedVolumeForResourceCacheReturns struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
+	findOrCreateSharedVolumeForResourceCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
 	FindOrCreateVolumeForBaseResourceTypeStub        func(lager.Logger, worker.VolumeSpec, int, string) (worker.Volume, error)
 	findOrCreateVolumeForBaseResourceTypeMutex       sync.RWMutex
 	findOrCreateVolumeForBaseResourceTypeArgsForCall []struct {
@@ -166,6 +197,71 @@ type FakeVolumeClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeVolumeClient) CreateCOWVolumeForArtifact(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 worker.Volume) (worker.Volume, error) {
+	fake.createCOWVolumeForArtifactMutex.Lock()
+	ret, specificReturn := fake.createCOWVolumeForArtifactReturnsOnCall[len(fake.createCOWVolumeForArtifactArgsForCall)]
+	fake.createCOWVolumeForArtifactArgsForCall = append(fake.createCOWVolumeForArtifactArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.VolumeSpec
+		arg3 worker.Volume
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateCOWVolumeForArtifact", []interface{}{arg1, arg2, arg3})
+	fake.createCOWVolumeForArtifactMutex.Unlock()
+	if fake.CreateCOWVolumeForArtifactStub != nil {
+		return fake.CreateCOWVolumeForArtifactStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createCOWVolumeForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeClient) CreateCOWVolumeForArtifactCallCount() int {
+	fake.createCOWVolumeForArtifactMutex.RLock()
+	defer fake.createCOWVolumeForArtifactMutex.RUnlock()
+	return len(fake.createCOWVolumeForArtifactArgsForCall)
+}
+
+func (fake *FakeVolumeClient) CreateCOWVolumeForArtifactCalls(stub func(lager.Logger, worker.VolumeSpec, worker.Volume) (worker.Volume, error)) {
+	fake.createCOWVolumeForArtifactMutex.Lock()
+	defer fake.createCOWVolumeForArtifactMutex.Unlock()
+	fake.CreateCOWVolumeForArtifactStub = stub
+}
+
+func (fake *FakeVolumeClient) CreateCOWVolumeForArtifactArgsForCall(i int) (lager.Logger, worker.VolumeSpec, worker.Volume) {
+	fake.createCOWVolumeForArtifactMutex.RLock()
+	defer fake.createCOWVolumeForArtifactMutex.RUnlock()
+	argsForCall := fake.createCOWVolumeForArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeVolumeClient) CreateCOWVolumeForArtifactReturns(result1 worker.Volume, result2 error) {
+	fake.createCOWVolumeForArtifactMutex.Lock()
+	defer fake.createCOWVolumeForArtifactMutex.Unlock()
+	fake.CreateCOWVolumeForArtifactStub = nil
+	fake.createCOWVolumeForArtifactReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeClient) CreateCOWVolumeForArtifactReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createCOWVolumeForArtifactMutex.Lock()
+	defer fake.createCOWVolumeForArtifactMutex.Unlock()
+	fake.CreateCOWVolumeForArtifactStub = nil
+	if fake.createCOWVolumeForArtifactReturnsOnCall == nil {
+		fake.createCOWVolumeForArtifactReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createCOWVolumeForArtifactReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeClient) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 string, arg5 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -369,6 +465,73 @@ func (fake *FakeVolumeClient) FindOrCreateCOWVolumeForContainerReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeClient) FindOrCreateSharedVolumeForResourceCache(arg1 lager.Logger, arg2 db.UsedResourceCache) (worker.Volume, bool, error) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	ret, specificReturn := fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall[len(fake.findOrCreateSharedVolumeForResourceCacheArgsForCall)]
+	fake.findOrCreateSharedVolumeForResourceCacheArgsForCall = append(fake.findOrCreateSharedVolumeForResourceCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.UsedResourceCache
+	}{arg1, arg2})
+	fake.recordInvocation("FindOrCreateSharedVolumeForResourceCache", []interface{}{arg1, arg2})
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	if fake.FindOrCreateSharedVolumeForResourceCacheStub != nil {
+		return fake.FindOrCreateSharedVolumeForResourceCacheStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findOrCreateSharedVolumeForResourceCacheReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeVolumeClient) FindOrCreateSharedVolumeForResourceCacheCallCount() int {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.RLock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.RUnlock()
+	return len(fake.findOrCreateSharedVolumeForResourceCacheArgsForCall)
+}
+
+func (fake *FakeVolumeClient) FindOrCreateSharedVolumeForResourceCacheCalls(stub func(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error)) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	fake.FindOrCreateSharedVolumeForResourceCacheStub = stub
+}
+
+func (fake *FakeVolumeClient) FindOrCreateSharedVolumeForResourceCacheArgsForCall(i int) (lager.Logger, db.UsedResourceCache) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.RLock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.RUnlock()
+	argsForCall := fake.findOrCreateSharedVolumeForResourceCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeClient) FindOrCreateSharedVolumeForResourceCacheReturns(result1 worker.Volume, result2 bool, result3 error) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	fake.FindOrCreateSharedVolumeForResourceCacheStub = nil
+	fake.findOrCreateSharedVolumeForResourceCacheReturns = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeVolumeClient) FindOrCreateSharedVolumeForResourceCacheReturnsOnCall(i int, result1 worker.Volume, result2 bool, result3 error) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	fake.FindOrCreateSharedVolumeForResourceCacheStub = nil
+	if fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall == nil {
+		fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeVolumeClient) FindOrCreateVolumeForBaseResourceType(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 string) (worker.Volume, error) {
 	fake.findOrCreateVolumeForBaseResourceTypeMutex.Lock()
 	ret, specificReturn := fake.findOrCreateVolumeForBaseResourceTypeReturnsOnCall[len(fake.findOrCreateVolumeForBaseResourceTypeArgsForCall)]
@@ -775,12 +938,16 @@ func (fake *FakeVolumeClient) LookupVolumeReturnsOnCall(i int, result1 worker.Vo
 func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createCOWVolumeForArtifactMutex.RLock()
+	defer fake.createCOWVolumeForArtifactMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
 	fake.createVolumeForTaskCacheMutex.RLock()
 	defer fake.createVolumeForTaskCacheMutex.RUnlock()
 	fake.findOrCreateCOWVolumeForContainerMutex.RLock()
 	defer fake.findOrCreateCOWVolumeForContainerMutex.RUnlock()
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.RLock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.RUnlock()
 	fake.findOrCreateVolumeForBaseResourceTypeMutex.RLock()
 	defer fake.findOrCreateVolumeForBaseResourceTypeMutex.RUnlock()
 	fake.findOrCreateVolumeForContainerMutex.RLock()
@@ -74,6 +74,20 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	CreateChildVolumeStub        func(lager.Logger, worker.Volume) (worker.Volume, error)
+	createChildVolumeMutex       sync.RWMutex
+	createChildVolumeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.Volume
+	}
+	createChildVolumeReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createChildVolumeReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, int, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -90,6 +104,20 @@ type FakeWorker struct {
 		result1 worker.Volume
 		result2 error
 	}
+	CurrentStateStub        func() (db.WorkerState, bool, error)
+	currentStateMutex       sync.RWMutex
+	currentStateArgsForCall []struct {
+	}
+	currentStateReturns struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}
+	currentStateReturnsOnCall map[int]struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}
 	DescriptionStub        func() string
 	descriptionMutex       sync.RWMutex
 	descriptionArgsForCall []struct {
@@ -168,6 +196,22 @@ type FakeWorker struct {
 		result1 worker.Container
 		result2 error
 	}
+	FindOrCreateSharedVolumeForResourceCacheStub        func(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error)
+	findOrCreateSharedVolumeForResourceCacheMutex       sync.RWMutex
+	findOrCreateSharedVolumeForResourceCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.UsedResourceCache
+	}
+	findOrCreateSharedVolumeForResourceCacheReturns struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
+	findOrCreateSharedVolumeForResourceCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
 	FindResourceCacheForVolumeStub        func(worker.Volume) (db.UsedResourceCache, bool, error)
 	findResourceCacheForVolumeMutex       sync.RWMutex
 	findResourceCacheForVolumeArgsForCall []struct {
@@ -276,6 +320,16 @@ type FakeWorker struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourcePressureStub        func() *atc.WorkerResourcePressure
+	resourcePressureMutex       sync.RWMutex
+	resourcePressureArgsForCall []struct {
+	}
+	resourcePressureReturns struct {
+		result1 *atc.WorkerResourcePressure
+	}
+	resourcePressureReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResourcePressure
+	}
 	ResourceTypesStub        func() []atc.WorkerResourceType
 	resourceTypesMutex       sync.RWMutex
 	resourceTypesArgsForCall []struct {
@@ -599,6 +653,70 @@ func (fake *FakeWorker) CertsVolumeReturnsOnCall(i int, result1 worker.Volume, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) CreateChildVolume(arg1 lager.Logger, arg2 worker.Volume) (worker.Volume, error) {
+	fake.createChildVolumeMutex.Lock()
+	ret, specificReturn := fake.createChildVolumeReturnsOnCall[len(fake.createChildVolumeArgsForCall)]
+	fake.createChildVolumeArgsForCall = append(fake.createChildVolumeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.Volume
+	}{arg1, arg2})
+	fake.recordInvocation("CreateChildVolume", []interface{}{arg1, arg2})
+	fake.createChildVolumeMutex.Unlock()
+	if fake.CreateChildVolumeStub != nil {
+		return fake.CreateChildVolumeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CreateChildVolumeCallCount() int {
+	fake.createChildVolumeMutex.RLock()
+	defer fake.createChildVolumeMutex.RUnlock()
+	return len(fake.createChildVolumeArgsForCall)
+}
+
+func (fake *FakeWorker) CreateChildVolumeCalls(stub func(lager.Logger, worker.Volume) (worker.Volume, error)) {
+	fake.createChildVolumeMutex.Lock()
+	defer fake.createChildVolumeMutex.Unlock()
+	fake.CreateChildVolumeStub = stub
+}
+
+func (fake *FakeWorker) CreateChildVolumeArgsForCall(i int) (lager.Logger, worker.Volume) {
+	fake.createChildVolumeMutex.RLock()
+	defer fake.createChildVolumeMutex.RUnlock()
+	argsForCall := fake.createChildVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) CreateChildVolumeReturns(result1 worker.Volume, result2 error) {
+	fake.createChildVolumeMutex.Lock()
+	defer fake.createChildVolumeMutex.Unlock()
+	fake.CreateChildVolumeStub = nil
+	fake.createChildVolumeReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CreateChildVolumeReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createChildVolumeMutex.Lock()
+	defer fake.createChildVolumeMutex.Unlock()
+	fake.CreateChildVolumeStub = nil
+	if fake.createChildVolumeReturnsOnCall == nil {
+		fake.createChildVolumeReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createChildVolumeReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -665,6 +783,64 @@ func (fake *FakeWorker) CreateVolumeReturnsOnCall(i int, result1 worker.Volume,
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) CurrentState() (db.WorkerState, bool, error) {
+	fake.currentStateMutex.Lock()
+	ret, specificReturn := fake.currentStateReturnsOnCall[len(fake.currentStateArgsForCall)]
+	fake.currentStateArgsForCall = append(fake.currentStateArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentState", []interface{}{})
+	fake.currentStateMutex.Unlock()
+	if fake.CurrentStateStub != nil {
+		return fake.CurrentStateStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.currentStateReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) CurrentStateCallCount() int {
+	fake.currentStateMutex.RLock()
+	defer fake.currentStateMutex.RUnlock()
+	return len(fake.currentStateArgsForCall)
+}
+
+func (fake *FakeWorker) CurrentStateCalls(stub func() (db.WorkerState, bool, error)) {
+	fake.currentStateMutex.Lock()
+	defer fake.currentStateMutex.Unlock()
+	fake.CurrentStateStub = stub
+}
+
+func (fake *FakeWorker) CurrentStateReturns(result1 db.WorkerState, result2 bool, result3 error) {
+	fake.currentStateMutex.Lock()
+	defer fake.currentStateMutex.Unlock()
+	fake.CurrentStateStub = nil
+	fake.currentStateReturns = struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) CurrentStateReturnsOnCall(i int, result1 db.WorkerState, result2 bool, result3 error) {
+	fake.currentStateMutex.Lock()
+	defer fake.currentStateMutex.Unlock()
+	fake.CurrentStateStub = nil
+	if fake.currentStateReturnsOnCall == nil {
+		fake.currentStateReturnsOnCall = make(map[int]struct {
+			result1 db.WorkerState
+			result2 bool
+			result3 error
+		})
+	}
+	fake.currentStateReturnsOnCall[i] = struct {
+		result1 db.WorkerState
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) Description() string {
 	fake.descriptionMutex.Lock()
 	ret, specificReturn := fake.descriptionReturnsOnCall[len(fake.descriptionArgsForCall)]
@@ -979,6 +1155,73 @@ func (fake *FakeWorker) FindOrCreateContainerReturnsOnCall(i int, result1 worker
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) FindOrCreateSharedVolumeForResourceCache(arg1 lager.Logger, arg2 db.UsedResourceCache) (worker.Volume, bool, error) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	ret, specificReturn := fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall[len(fake.findOrCreateSharedVolumeForResourceCacheArgsForCall)]
+	fake.findOrCreateSharedVolumeForResourceCacheArgsForCall = append(fake.findOrCreateSharedVolumeForResourceCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.UsedResourceCache
+	}{arg1, arg2})
+	fake.recordInvocation("FindOrCreateSharedVolumeForResourceCache", []interface{}{arg1, arg2})
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	if fake.FindOrCreateSharedVolumeForResourceCacheStub != nil {
+		return fake.FindOrCreateSharedVolumeForResourceCacheStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findOrCreateSharedVolumeForResourceCacheReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) FindOrCreateSharedVolumeForResourceCacheCallCount() int {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.RLock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.RUnlock()
+	return len(fake.findOrCreateSharedVolumeForResourceCacheArgsForCall)
+}
+
+func (fake *FakeWorker) FindOrCreateSharedVolumeForResourceCacheCalls(stub func(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error)) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	fake.FindOrCreateSharedVolumeForResourceCacheStub = stub
+}
+
+func (fake *FakeWorker) FindOrCreateSharedVolumeForResourceCacheArgsForCall(i int) (lager.Logger, db.UsedResourceCache) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.RLock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.RUnlock()
+	argsForCall := fake.findOrCreateSharedVolumeForResourceCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) FindOrCreateSharedVolumeForResourceCacheReturns(result1 worker.Volume, result2 bool, result3 error) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	fake.FindOrCreateSharedVolumeForResourceCacheStub = nil
+	fake.findOrCreateSharedVolumeForResourceCacheReturns = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) FindOrCreateSharedVolumeForResourceCacheReturnsOnCall(i int, result1 worker.Volume, result2 bool, result3 error) {
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.Lock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.Unlock()
+	fake.FindOrCreateSharedVolumeForResourceCacheStub = nil
+	if fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall == nil {
+		fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findOrCreateSharedVolumeForResourceCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) FindResourceCacheForVolume(arg1 worker.Volume) (db.UsedResourceCache, bool, error) {
 	fake.findResourceCacheForVolumeMutex.Lock()
 	ret, specificReturn := fake.findResourceCacheForVolumeReturnsOnCall[len(fake.findResourceCacheForVolumeArgsForCall)]
@@ -1466,6 +1709,58 @@ func (fake *FakeWorker) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ResourcePressure() *atc.WorkerResourcePressure {
+	fake.resourcePressureMutex.Lock()
+	ret, specificReturn := fake.resourcePressureReturnsOnCall[len(fake.resourcePressureArgsForCall)]
+	fake.resourcePressureArgsForCall = append(fake.resourcePressureArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourcePressure", []interface{}{})
+	fake.resourcePressureMutex.Unlock()
+	if fake.ResourcePressureStub != nil {
+		return fake.ResourcePressureStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourcePressureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourcePressureCallCount() int {
+	fake.resourcePressureMutex.RLock()
+	defer fake.resourcePressureMutex.RUnlock()
+	return len(fake.resourcePressureArgsForCall)
+}
+
+func (fake *FakeWorker) ResourcePressureCalls(stub func() *atc.WorkerResourcePressure) {
+	fake.resourcePressureMutex.Lock()
+	defer fake.resourcePressureMutex.Unlock()
+	fake.ResourcePressureStub = stub
+}
+
+func (fake *FakeWorker) ResourcePressureReturns(result1 *atc.WorkerResourcePressure) {
+	fake.resourcePressureMutex.Lock()
+	defer fake.resourcePressureMutex.Unlock()
+	fake.ResourcePressureStub = nil
+	fake.resourcePressureReturns = struct {
+		result1 *atc.WorkerResourcePressure
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourcePressureReturnsOnCall(i int, result1 *atc.WorkerResourcePressure) {
+	fake.resourcePressureMutex.Lock()
+	defer fake.resourcePressureMutex.Unlock()
+	fake.ResourcePressureStub = nil
+	if fake.resourcePressureReturnsOnCall == nil {
+		fake.resourcePressureReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResourcePressure
+		})
+	}
+	fake.resourcePressureReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResourcePressure
+	}{result1}
+}
+
 func (fake *FakeWorker) ResourceTypes() []atc.WorkerResourceType {
 	fake.resourceTypesMutex.Lock()
 	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
@@ -1696,8 +1991,12 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.buildContainersMutex.RUnlock()
 	fake.certsVolumeMutex.RLock()
 	defer fake.certsVolumeMutex.RUnlock()
+	fake.createChildVolumeMutex.RLock()
+	defer fake.createChildVolumeMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
+	fake.currentStateMutex.RLock()
+	defer fake.currentStateMutex.RUnlock()
 	fake.descriptionMutex.RLock()
 	defer fake.descriptionMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
@@ -1708,6 +2007,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.findContainerByHandleMutex.RUnlock()
 	fake.findOrCreateContainerMutex.RLock()
 	defer fake.findOrCreateContainerMutex.RUnlock()
+	fake.findOrCreateSharedVolumeForResourceCacheMutex.RLock()
+	defer fake.findOrCreateSharedVolumeForResourceCacheMutex.RUnlock()
 	fake.findResourceCacheForVolumeMutex.RLock()
 	defer fake.findResourceCacheForVolumeMutex.RUnlock()
 	fake.findVolumeForResourceCacheMutex.RLock()
@@ -1724,6 +2025,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.lookupVolumeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.resourcePressureMutex.RLock()
+	defer fake.resourcePressureMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.satisfiesMutex.RLock()
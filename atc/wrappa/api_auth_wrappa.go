@@ -51,7 +51,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.ApproveBuild:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -61,7 +62,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
-			atc.ReportWorkerVolumes:
+			atc.ReportWorkerVolumes,
+			atc.ReportWorkerDiagnostics:
 			newHandler = wrappa.checkWorkerTeamAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// pipeline is public or authorized
@@ -95,6 +97,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// unauthenticated / delegating to handler (validate token if provided)
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.ReceiveTeamWebhook,
 			atc.GetInfo,
 			atc.ListTeams,
 			atc.ListAllPipelines,
@@ -113,13 +116,19 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.RequestWorkerDiagnostics,
+			atc.GetWorkerDiagnostics:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team and has required role, or is admin)
 		case atc.GetTeam,
 			atc.SetTeam,
 			atc.RenameTeam,
+			atc.ListTeamWebhooks,
+			atc.SetTeamWebhook,
+			atc.DestroyTeamWebhook,
+			atc.ListTeamWebhookDeliveries,
 			atc.ListContainers,
 			atc.GetContainer,
 			atc.HijackContainer,
@@ -128,12 +137,15 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CheckResource,
 			atc.CheckResourceType,
 			atc.CreateJobBuild,
+			atc.RunJob,
 			atc.RerunJobBuild,
 			atc.CreatePipelineBuild,
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
 			atc.PinResourceVersion,
+			atc.AnnotateResourceVersion,
+			atc.RemoveVersionAnnotation,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
@@ -153,7 +165,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.PatchArtifact:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
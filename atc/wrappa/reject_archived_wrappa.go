@@ -33,9 +33,12 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
 			atc.PinResourceVersion,
+			atc.AnnotateResourceVersion,
+			atc.RemoveVersionAnnotation,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
-			atc.RerunJobBuild:
+			atc.RerunJobBuild,
+			atc.RunJob:
 
 			newHandler = rw.handlerFactory.RejectArchived(handler)
 
@@ -49,6 +52,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
 			atc.AbortBuild,
+			atc.ApproveBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
@@ -82,10 +86,18 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
+			atc.RequestWorkerDiagnostics,
+			atc.GetWorkerDiagnostics,
+			atc.ReportWorkerDiagnostics,
 			atc.GetTeam,
 			atc.SetTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
+			atc.ListTeamWebhooks,
+			atc.SetTeamWebhook,
+			atc.DestroyTeamWebhook,
+			atc.ReceiveTeamWebhook,
+			atc.ListTeamWebhookDeliveries,
 			atc.GetUser,
 			atc.GetInfo,
 			atc.DownloadCLI,
@@ -119,7 +131,8 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CreatePipelineBuild,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.PatchArtifact:
 
 		default:
 			panic("how do archived pipelines affect your endpoint?")
@@ -40,9 +40,12 @@ var _ = Describe("RejectArchivedWrappa", func() {
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
 			atc.PinResourceVersion,
+			atc.AnnotateResourceVersion,
+			atc.RemoveVersionAnnotation,
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.RerunJobBuild,
+			atc.RunJob,
 		}
 
 		rejectArchivedLookup := make(map[string]bool)
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type AnnotateVersionCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  *atc.Version             `short:"v" long:"version" required:"true" value-name:"KEY:VALUE" description:"Version of the resource to annotate. The given key value pair(s) has to be an exact match but not all fields are needed. In the case of multiple resource versions matched, it will annotate the latest one."`
+	Labels   []string                 `short:"l" long:"label" required:"true" value-name:"LABEL" description:"Label to attach to the version (can be specified multiple times)"`
+	Remove   bool                     `long:"remove" description:"Remove the labels from the version instead"`
+}
+
+func (command *AnnotateVersionCommand) Execute([]string) error {
+	for _, label := range command.Labels {
+		err := atc.ValidateVersionLabel(label)
+		if err != nil {
+			return err
+		}
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	latestResourceVer, err := GetLatestResourceVersion(team, command.Resource, *command.Version)
+	if err != nil {
+		return err
+	}
+
+	versionBytes, err := json.Marshal(latestResourceVer.Version)
+	if err != nil {
+		return err
+	}
+
+	for _, label := range command.Labels {
+		if command.Remove {
+			removed, err := team.RemoveVersionAnnotation(command.Resource.PipelineRef, command.Resource.ResourceName, latestResourceVer.ID, label)
+			if err != nil {
+				return err
+			}
+
+			if removed {
+				fmt.Printf("removed label '%s' from '%s/%s' version %s\n", label, command.Resource.PipelineRef.String(), command.Resource.ResourceName, string(versionBytes))
+			} else {
+				fmt.Printf("'%s/%s' version %s is not labelled '%s'\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName, string(versionBytes), label)
+			}
+
+			continue
+		}
+
+		annotated, err := team.AnnotateResourceVersion(command.Resource.PipelineRef, command.Resource.ResourceName, latestResourceVer.ID, label)
+		if err != nil {
+			return err
+		}
+
+		if !annotated {
+			displayhelpers.Failf("could not annotate '%s/%s', make sure the resource version exists\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName)
+		}
+
+		fmt.Printf("labelled '%s/%s' version %s with '%s'\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName, string(versionBytes), label)
+	}
+
+	return nil
+}
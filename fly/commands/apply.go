@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mgutz/ansi"
+	"github.com/vito/go-interact/interact"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/applyhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type ApplyCommand struct {
+	Manifest atc.PathFlag `short:"f"  long:"file"  required:"true"  description:"Manifest listing every pipeline of the team"`
+	Team     string       `long:"team"  description:"Name of the team to which the pipelines belong, if different from the manifest or the target default"`
+
+	SkipInteractive  bool `short:"n"  long:"non-interactive"  description:"Skips interactions, uses default values"`
+	DryRun           bool `long:"dry-run"       description:"Show the plan without applying it"`
+	SkipArchive      bool `long:"skip-archive"  description:"Do not archive pipelines which are missing from the manifest"`
+	CheckCredentials bool `long:"check-creds"   description:"Validate credential variables against credential manager"`
+	DisableAnsiColor bool `long:"no-color"      description:"Disable color output"`
+}
+
+func (command *ApplyCommand) Execute(args []string) error {
+	manifest, err := applyhelpers.LoadManifest(string(command.Manifest))
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	teamName := command.Team
+	if teamName == "" {
+		teamName = manifest.Team
+	}
+
+	var team concourse.Team
+	if teamName != "" {
+		team, err = target.FindTeam(teamName)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	ansi.DisableColors(command.DisableAnsiColor)
+
+	plan, err := applyhelpers.NewPlan(team, manifest, command.SkipArchive)
+	if err != nil {
+		return err
+	}
+
+	if !plan.HasChanges() {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	plan.Show(stdout)
+
+	if command.DryRun {
+		return nil
+	}
+
+	if !command.SkipInteractive {
+		confirm := false
+		err = interact.NewInteraction("apply plan?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return nil
+		}
+	}
+
+	return plan.Apply(os.Stdout, team, command.CheckCredentials)
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type BuildLogsCommand struct {
+	Job       flaghelpers.JobFlag `short:"j"  long:"job"     value-name:"PIPELINE/JOB"  description:"Get the logs of a build of the given job"`
+	Build     string              `short:"b"  long:"build"                              description:"Get the logs of a specific build; its name if a job is given, otherwise its ID"`
+	Format    string              `long:"format"  default:"text"  choice:"text"  choice:"json"  choice:"ndjson"  description:"Format to write the events in"`
+	Step      string              `long:"step"  description:"Only include the events of steps with this name"`
+	Timestamp bool                `short:"t"  long:"timestamps"  description:"Print with local timestamp, for the text format"`
+}
+
+func (command *BuildLogsCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	var build atc.Build
+	if command.Job.JobName != "" || command.Build == "" {
+		build, err = GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef)
+	} else {
+		build, err = GetBuild(client, nil, "", command.Build, atc.PipelineRef{})
+	}
+	if err != nil {
+		return err
+	}
+
+	buildID := build.ID
+
+	events, err := client.BuildEvents(strconv.Itoa(buildID))
+	if err != nil {
+		return err
+	}
+
+	defer events.Close()
+
+	var src concourse.Events = events
+	if command.Step != "" {
+		plan, found, err := client.BuildPlan(buildID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("build plan not found")
+		}
+
+		ids, err := eventstream.StepPlanIDs(plan, command.Step)
+		if err != nil {
+			return err
+		}
+
+		src = eventstream.FilterOrigins(events, ids)
+	}
+
+	switch command.Format {
+	case "json":
+		return eventstream.WriteJSON(os.Stdout, src)
+	case "ndjson":
+		return eventstream.WriteNDJSON(os.Stdout, src)
+	}
+
+	exitCode := eventstream.Render(os.Stdout, src, eventstream.RenderOptions{
+		ShowTimestamp: command.Timestamp,
+	})
+	if exitCode == 255 {
+		return errors.New("failed to render build events")
+	}
+
+	return nil
+}
@@ -1,15 +1,21 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/versionfilter"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
 )
 
 type CheckResourceCommand struct {
@@ -17,6 +23,9 @@ type CheckResourceCommand struct {
 	Version  *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource to check from, e.g. ref:abcd or path:thing-1.2.3.tgz"`
 	Async    bool                     `short:"a" long:"async"                    value-name:"ASYNC"             description:"Return the check without waiting for its result"`
 	Shallow  bool                     `long:"shallow"                          value-name:"SHALLOW"         description:"Check the resource itself only"`
+
+	PreviewFilter string `long:"preview-filter" value-name:"EXPRESSION" description:"After the check finishes, show which of the resource's versions match a version filter, e.g. 'tag =~ \"^2\\.\"'"`
+	PreviewCount  int    `long:"preview-count" default:"50" description:"Number of the resource's most recent versions to preview the filter against"`
 }
 
 func (command *CheckResourceCommand) Execute(args []string) error {
@@ -35,6 +44,20 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
+	var filter versionfilter.Filter
+	if command.PreviewFilter != "" {
+		// the preview is shown once the check has finished, which --async
+		// doesn't wait for
+		if command.Async {
+			return errors.New("--preview-filter cannot be used with --async")
+		}
+
+		filter, err = versionfilter.Parse(command.PreviewFilter)
+		if err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
 	if !command.Shallow {
 		err = command.checkParent(target)
 		if err != nil {
@@ -71,6 +94,70 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		os.Exit(exitCode)
 	}
 
+	return command.preview(target, filter)
+}
+
+func (command *CheckResourceCommand) preview(target rc.Target, filter versionfilter.Filter) error {
+	if filter.IsZero() {
+		return nil
+	}
+
+	versions, _, found, err := target.Team().ResourceVersions(command.Resource.PipelineRef, command.Resource.ResourceName, concourse.Page{Limit: command.PreviewCount}, atc.Version{})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName)
+	}
+
+	fmt.Println()
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "matches", Color: color.New(color.Bold)},
+		},
+	}
+
+	matching := 0
+	for _, version := range versions {
+		metadata := map[string]string{}
+		for _, field := range version.Metadata {
+			metadata[field.Name] = field.Value
+		}
+
+		var matchesCell ui.TableCell
+		if filter.Match(version.Version, metadata) {
+			matching++
+			matchesCell.Color = ui.OnColor
+			matchesCell.Contents = "yes"
+		} else {
+			matchesCell.Contents = "no"
+		}
+
+		fields := []string{}
+		for k, v := range version.Version {
+			fields = append(fields, k+":"+v)
+		}
+
+		sort.Strings(fields)
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(version.ID)},
+			{Contents: strings.Join(fields, ",")},
+			matchesCell,
+		})
+	}
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d of %d versions match the filter\n", matching, len(versions))
+
 	return nil
 }
 
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+	"github.com/concourse/concourse/fly/pty"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+const (
+	enterAlternateScreen = "\x1b[?1049h\x1b[?25l"
+	leaveAlternateScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+type DashboardCommand struct {
+	Team     string        `long:"team"  description:"Name of the team whose pipelines to show, if different from the target default"`
+	Interval time.Duration `long:"interval"  default:"10s"  description:"How often to refresh pipelines and jobs"`
+}
+
+func (command *DashboardCommand) Execute(args []string) error {
+	if !pty.IsTerminal() {
+		return errors.New("dashboard must be run in a terminal")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	term, err := pty.OpenRawTerm()
+	if err != nil {
+		return err
+	}
+
+	defer term.Restore()
+
+	fmt.Fprint(os.Stdout, enterAlternateScreen)
+	defer fmt.Fprint(os.Stdout, leaveAlternateScreen)
+
+	return dashboard.New(target.Client(), team).Run(term, os.Stdout, func() (int, int, error) {
+		return pty.Getsize(os.Stdout)
+	}, command.Interval)
+}
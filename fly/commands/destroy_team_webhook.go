@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DestroyTeamWebhookCommand struct {
+	Name string `short:"n" long:"name" required:"true" description:"Name of the webhook to destroy"`
+}
+
+func (command *DestroyTeamWebhookCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Team().DestroyWebhook(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("webhook `%s` does not exist", command.Name)
+	}
+
+	fmt.Printf("webhook `%s` destroyed\n", command.Name)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"sigs.k8s.io/yaml"
+)
+
+type DiffPipelineCommand struct {
+	JSON bool   `short:"j" long:"json" description:"Print the changes as json"`
+	Team string `long:"team" description:"Name of the team to which the pipelines belong, if different from the target default"`
+
+	Var      []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       unquote:"false"  value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in local pipeline configs"`
+	YAMLVar  []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in local pipeline configs"`
+	VarsFrom []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in local pipeline configs from a YAML file"`
+
+	Args struct {
+		From flaghelpers.PipelineSourceFlag `positional-arg-name:"FROM" required:"true" description:"Pipeline config to compare from"`
+		To   flaghelpers.PipelineSourceFlag `positional-arg-name:"TO"   required:"true" description:"Pipeline config to compare to"`
+	} `positional-args:"yes"`
+}
+
+type diffPipelineOutput struct {
+	From    string             `json:"from"`
+	To      string             `json:"to"`
+	Changes []atc.ConfigChange `json:"changes"`
+}
+
+func (command *DiffPipelineCommand) Execute(args []string) error {
+	drifted, err := command.diff()
+	if err != nil {
+		return ExitCodeError{Err: err, ExitCode: 2}
+	}
+
+	if drifted {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+func (command *DiffPipelineCommand) diff() (bool, error) {
+	var team concourse.Team
+	if !command.Args.From.IsLocal() || !command.Args.To.IsLocal() {
+		target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+		if err != nil {
+			return false, err
+		}
+
+		err = target.Validate()
+		if err != nil {
+			return false, err
+		}
+
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team)
+			if err != nil {
+				return false, err
+			}
+		} else {
+			team = target.Team()
+		}
+	}
+
+	fromConfig, err := command.load(team, command.Args.From)
+	if err != nil {
+		return false, err
+	}
+
+	toConfig, err := command.load(team, command.Args.To)
+	if err != nil {
+		return false, err
+	}
+
+	var drifted bool
+	if command.JSON {
+		changes := fromConfig.Changes(toConfig)
+		drifted = len(changes) > 0
+
+		err = json.NewEncoder(os.Stdout).Encode(diffPipelineOutput{
+			From:    command.Args.From.String(),
+			To:      command.Args.To.String(),
+			Changes: changes,
+		})
+		if err != nil {
+			return false, err
+		}
+	} else {
+		drifted = fromConfig.Diff(os.Stdout, toConfig)
+		if !drifted {
+			fmt.Println("no differences")
+		}
+	}
+
+	return drifted, nil
+}
+
+func (command *DiffPipelineCommand) load(team concourse.Team, source flaghelpers.PipelineSourceFlag) (atc.Config, error) {
+	if source.IsLocal() {
+		evaluatedTemplate, err := templatehelpers.NewYamlTemplateWithParams(
+			source.Path,
+			command.VarsFrom,
+			command.Var,
+			command.YAMLVar,
+			nil,
+		).Evaluate(false, false)
+		if err != nil {
+			return atc.Config{}, err
+		}
+
+		var config atc.Config
+		err = yaml.Unmarshal(evaluatedTemplate, &config)
+		if err != nil {
+			return atc.Config{}, err
+		}
+
+		return config, nil
+	}
+
+	var (
+		config atc.Config
+		found  bool
+		err    error
+	)
+	if source.Version != 0 {
+		config, found, err = team.PipelineConfigRevision(source.Pipeline.Ref(), source.Version)
+	} else {
+		config, _, found, err = team.PipelineConfig(source.Pipeline.Ref())
+	}
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	if !found {
+		return atc.Config{}, errors.New("pipeline not found: " + source.String())
+	}
+
+	return config, nil
+}
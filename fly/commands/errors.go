@@ -0,0 +1,17 @@
+package commands
+
+// ExitCodeError is returned by commands which need to exit with a code other
+// than 1 when they fail, e.g. to distinguish failures from a result that is
+// reported with exit code 1.
+type ExitCodeError struct {
+	Err      error
+	ExitCode int
+}
+
+func (err ExitCodeError) Error() string {
+	return err.Err.Error()
+}
+
+func (err ExitCodeError) Unwrap() error {
+	return err.Err
+}
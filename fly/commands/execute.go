@@ -36,8 +36,13 @@ type ExecuteCommand struct {
 	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  unquote:"false"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    unquote:"false"  description:"Specify a YAML value to set for a variable in the pipeline"`
 	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+	Watch          bool                               `          long:"watch"                                 description:"Keep running, re-uploading changed inputs and re-running the task whenever local inputs change"`
 }
 
+// watchInterval is how often local inputs are scanned for changes when
+// running with --watch.
+const watchInterval = time.Second
+
 func (command *ExecuteCommand) Execute(args []string) error {
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -101,51 +106,219 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Watch {
+		return command.watch(target, planFactory, taskConfig, inputs, inputMappings, resourceTypes, outputs)
+	}
+
 	client := target.Client()
-	clientURL, err := url.Parse(client.URL())
+
+	build, err := command.createBuild(target, plan)
 	if err != nil {
 		return err
 	}
 
-	var build atc.Build
-	var buildURL *url.URL
+	terminate := make(chan os.Signal, 1)
 
-	if command.InputsFrom.PipelineRef.Name != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineRef, plan)
+	go abortOnSignal(client, terminate, build)
+
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	eventSource, err := client.BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	renderOptions := eventstream.RenderOptions{}
+
+	exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
+	eventSource.Close()
+
+	err = downloadOutputs(target, build, outputs)
+	if err != nil {
+		return err
+	}
+
+	os.Exit(exitCode)
+
+	return nil
+}
+
+func (command *ExecuteCommand) watch(
+	target rc.Target,
+	planFactory atc.PlanFactory,
+	taskConfig atc.TaskConfig,
+	inputs []executehelpers.Input,
+	inputMappings map[string]string,
+	resourceTypes atc.VersionedResourceTypes,
+	outputs []executehelpers.Output,
+) error {
+	watched, err := executehelpers.WatchInputs(inputs, command.IncludeIgnored, taskConfig.Platform, command.Tags)
+	if err != nil {
+		return err
+	}
+
+	if len(watched) == 0 {
+		displayhelpers.Failf("--watch requires at least one local input")
+	}
+
+	client := target.Client()
+
+	terminate := make(chan os.Signal, 1)
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		for i, input := range inputs {
+			for _, w := range watched {
+				if input.Name == w.Name {
+					inputs[i].Plan = w.Plan(planFactory)
+				}
+			}
+		}
+
+		plan, err := executehelpers.CreateBuildPlan(
+			planFactory,
+			target,
+			command.Privileged,
+			inputs,
+			inputMappings,
+			resourceTypes,
+			outputs,
+			taskConfig,
+			command.Tags,
+		)
 		if err != nil {
 			return err
 		}
-	} else {
-		build, err = target.Team().CreateBuild(plan)
+
+		build, err := command.createBuild(target, plan)
 		if err != nil {
 			return err
 		}
-	}
 
-	buildURL, err = url.Parse(fmt.Sprintf("/builds/%d", build.ID))
-	if err != nil {
-		return err
+		eventSource, err := client.BuildEvents(strconv.Itoa(build.ID))
+		if err != nil {
+			return err
+		}
+
+		done := make(chan struct{})
+		aborted := make(chan struct{})
+
+		go func() {
+			select {
+			case <-terminate:
+				close(aborted)
+				abortBuild(client, terminate, build)
+			case <-done:
+			}
+		}()
+
+		exitCode := eventstream.Render(os.Stdout, eventSource, eventstream.RenderOptions{})
+		eventSource.Close()
+
+		close(done)
+
+		err = downloadOutputs(target, build, outputs)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-aborted:
+			os.Exit(exitCode)
+		default:
+		}
+
+		fmt.Println("")
+		fmt.Println("waiting for changes to inputs...")
+
+		changed, err := waitForChanges(watched, terminate)
+		if err != nil {
+			return err
+		}
+
+		if len(changed) == 0 {
+			os.Exit(exitCode)
+		}
+
+		prog := progress.New()
+
+		for _, input := range changed {
+			input := input
+			prog.Go("syncing "+input.Name, func(bar *mpb.Bar) error {
+				return input.Sync(bar, target.Team())
+			})
+		}
+
+		err = prog.Wait()
+		if err != nil {
+			return err
+		}
 	}
+}
 
-	fmt.Printf("executing build %d at %s\n", build.ID, clientURL.ResolveReference(buildURL))
+// waitForChanges polls the watched inputs until any of them change,
+// returning the changed inputs, or none if told to terminate first.
+func waitForChanges(watched []*executehelpers.WatchedInput, terminate <-chan os.Signal) ([]*executehelpers.WatchedInput, error) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-terminate:
+			return nil, nil
+		case <-ticker.C:
+		}
 
-	terminate := make(chan os.Signal, 1)
+		changed := []*executehelpers.WatchedInput{}
+		for _, input := range watched {
+			inputChanged, err := input.Poll()
+			if err != nil {
+				return nil, err
+			}
 
-	go abortOnSignal(client, terminate, build)
+			if inputChanged {
+				changed = append(changed, input)
+			}
+		}
 
-	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+		if len(changed) != 0 {
+			return changed, nil
+		}
+	}
+}
 
-	eventSource, err := client.BuildEvents(strconv.Itoa(build.ID))
+func (command *ExecuteCommand) createBuild(target rc.Target, plan atc.Plan) (atc.Build, error) {
+	clientURL, err := url.Parse(target.Client().URL())
 	if err != nil {
-		return err
+		return atc.Build{}, err
 	}
 
-	renderOptions := eventstream.RenderOptions{}
+	var build atc.Build
 
-	exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
-	eventSource.Close()
+	if command.InputsFrom.PipelineRef.Name != "" {
+		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineRef, plan)
+		if err != nil {
+			return atc.Build{}, err
+		}
+	} else {
+		build, err = target.Team().CreateBuild(plan)
+		if err != nil {
+			return atc.Build{}, err
+		}
+	}
 
-	artifactList, err := client.ListBuildArtifacts(strconv.Itoa(build.ID))
+	buildURL, err := url.Parse(fmt.Sprintf("/builds/%d", build.ID))
+	if err != nil {
+		return atc.Build{}, err
+	}
+
+	fmt.Printf("executing build %d at %s\n", build.ID, clientURL.ResolveReference(buildURL))
+
+	return build, nil
+}
+
+func downloadOutputs(target rc.Target, build atc.Build, outputs []executehelpers.Output) error {
+	artifactList, err := target.Client().ListBuildArtifacts(strconv.Itoa(build.ID))
 	if err != nil {
 		return err
 	}
@@ -178,8 +351,6 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
-	os.Exit(exitCode)
-
 	return nil
 }
 
@@ -208,6 +379,14 @@ func abortOnSignal(
 ) {
 	<-terminate
 
+	abortBuild(client, terminate, build)
+}
+
+func abortBuild(
+	client concourse.Client,
+	terminate <-chan os.Signal,
+	build atc.Build,
+) {
 	fmt.Fprintf(ui.Stderr, "\naborting...\n")
 
 	err := client.AbortBuild(strconv.Itoa(build.ID))
@@ -18,10 +18,11 @@ type FlyCommand struct {
 
 	PrintTableHeaders bool `long:"print-table-headers" description:"Print table headers even for redirected output"`
 
-	Login  LoginCommand  `command:"login" alias:"l" description:"Authenticate with the target"`
-	Logout LogoutCommand `command:"logout" alias:"o" description:"Release authentication with the target"`
-	Status StatusCommand `command:"status" description:"Login status"`
-	Sync   SyncCommand   `command:"sync"  alias:"s" description:"Download and replace the current fly from the target"`
+	Login   LoginCommand   `command:"login" alias:"l" description:"Authenticate with the target"`
+	Logout  LogoutCommand  `command:"logout" alias:"o" description:"Release authentication with the target"`
+	Status  StatusCommand  `command:"status" description:"Login status"`
+	Sync    SyncCommand    `command:"sync"  alias:"s" description:"Download and replace the current fly from the target"`
+	Plugins PluginsCommand `command:"plugins" description:"List the fly-<name> executables which fly runs for unknown commands"`
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
@@ -32,10 +33,18 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	TeamWebhooks       TeamWebhooksCommand       `command:"team-webhooks"        alias:"tws" description:"List the team's webhooks"`
+	SetTeamWebhook     SetTeamWebhookCommand     `command:"set-team-webhook"     alias:"stw" description:"Create or update a webhook that checks the team's resources"`
+	DestroyTeamWebhook DestroyTeamWebhookCommand `command:"destroy-team-webhook" alias:"dtw" description:"Destroy a team webhook"`
+	WebhookDeliveries  WebhookDeliveriesCommand  `command:"webhook-deliveries"   alias:"whd" description:"List the recent deliveries to a team webhook"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
-	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
-	Watch   WatchCommand   `command:"watch"   alias:"w" description:"Stream a build's output"`
+	Execute     ExecuteCommand     `command:"execute"      alias:"e"  description:"Execute a one-off build using local bits"`
+	Watch       WatchCommand       `command:"watch"        alias:"w"  description:"Stream a build's output"`
+	BuildLogs   BuildLogsCommand   `command:"build-logs"   alias:"bl" description:"Export the events of a build as text or json"`
+	GetArtifact GetArtifactCommand `command:"get-artifact" alias:"ga" description:"Download the artifacts of a build as tarballs"`
+	Dashboard   DashboardCommand   `command:"dashboard"    alias:"db" description:"Interactively browse pipelines, jobs and builds"`
 
 	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
 	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
@@ -49,6 +58,8 @@ type FlyCommand struct {
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
 	GetPipeline      GetPipelineCommand      `command:"get-pipeline"        alias:"gp"   description:"Get a pipeline's current configuration"`
 	SetPipeline      SetPipelineCommand      `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
+	DiffPipeline     DiffPipelineCommand     `command:"diff-pipeline"       alias:"dfp"  description:"Compare the configuration of pipelines, past versions of them, or local files. Exits 1 if they differ and 2 on errors"`
+	Apply            ApplyCommand            `command:"apply"                            description:"Create, update, archive and order a team's pipelines to match a manifest"`
 	PausePipeline    PausePipelineCommand    `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	ArchivePipeline  ArchivePipelineCommand  `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
 	UnpausePipeline  UnpausePipelineCommand  `command:"unpause-pipeline"    alias:"up"   description:"Un-pause a pipeline"`
@@ -66,16 +77,19 @@ type FlyCommand struct {
 	UnpinResource          UnpinResourceCommand          `command:"unpin-resource"             alias:"ur"   description:"Unpin a resource"`
 	EnableResourceVersion  EnableResourceVersionCommand  `command:"enable-resource-version"    alias:"erv"  description:"Enable a version of a resource"`
 	DisableResourceVersion DisableResourceVersionCommand `command:"disable-resource-version"   alias:"drv"  description:"Disable a version of a resource"`
+	AnnotateVersion        AnnotateVersionCommand        `command:"annotate-version"           alias:"av"   description:"Label a version of a resource"`
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds       BuildsCommand       `command:"builds"      alias:"bs" description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build" alias:"ab" description:"Abort a build"`
+	ApproveBuild ApproveBuildCommand `command:"approve-build" alias:"apb" description:"Approve or reject a build awaiting approval"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build" alias:"rb" description:"Rerun a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
+	RunJob     RunJobCommand     `command:"run-job" alias:"rj" description:"Run a job's steps as a one-off build, optionally with local inputs"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
@@ -83,6 +97,8 @@ type FlyCommand struct {
 	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
 	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
 
+	WorkerDiagnostics WorkerDiagnosticsCommand `command:"worker-diagnostics" alias:"wd" description:"Collect diagnostics from a worker"`
+
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 
 	Completion CompletionCommand `command:"completion" description:"generate shell completion code"`
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type GetArtifactCommand struct {
+	Job      flaghelpers.JobFlag `short:"j"  long:"job"     value-name:"PIPELINE/JOB"  description:"Get the artifacts of a build of the given job"`
+	Build    string              `short:"b"  long:"build"                              description:"Get the artifacts of a specific build; its name if a job is given, otherwise its ID"`
+	Artifact string              `short:"a"  long:"artifact"  description:"Name of the artifact to download, instead of all of the build's artifacts"`
+	Output   string              `short:"o"  long:"output-dir"  default:"."  description:"Directory to write the artifact tarballs to"`
+}
+
+func (command *GetArtifactCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	var build atc.Build
+	if command.Job.JobName != "" || command.Build == "" {
+		build, err = GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef)
+	} else {
+		build, err = GetBuild(client, nil, "", command.Build, atc.PipelineRef{})
+	}
+	if err != nil {
+		return err
+	}
+
+	artifacts, err := client.ListBuildArtifacts(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	var selected []atc.WorkerArtifact
+	for _, artifact := range artifacts {
+		if command.Artifact == "" || artifact.Name == command.Artifact {
+			selected = append(selected, artifact)
+		}
+	}
+
+	if len(selected) == 0 {
+		if command.Artifact != "" {
+			return fmt.Errorf("artifact '%s' not found in build %d", command.Artifact, build.ID)
+		}
+
+		return fmt.Errorf("build %d has no artifacts", build.ID)
+	}
+
+	err = os.MkdirAll(command.Output, 0755)
+	if err != nil {
+		return err
+	}
+
+	team := client.Team(build.TeamName)
+	for _, artifact := range selected {
+		name := artifact.Name
+		if name == "" {
+			name = fmt.Sprintf("artifact-%d", artifact.ID)
+		}
+
+		// the name comes from the server, so make sure it can't be used to
+		// write outside of the output directory
+		if strings.ContainsAny(name, `/\`) {
+			return fmt.Errorf("refusing to download artifact with invalid name '%s'", name)
+		}
+
+		path := filepath.Join(command.Output, name+".tgz")
+
+		err = downloadArtifact(team.GetArtifact, artifact.ID, path)
+		if err != nil {
+			return fmt.Errorf("failed to download artifact '%s': %s", name, err)
+		}
+
+		fmt.Printf("downloaded %s to %s\n", name, path)
+	}
+
+	return nil
+}
+
+func downloadArtifact(getArtifact func(int) (io.ReadCloser, error), artifactID int, path string) error {
+	reader, err := getArtifact(artifactID)
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, reader)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
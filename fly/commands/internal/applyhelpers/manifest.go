@@ -0,0 +1,143 @@
+package applyhelpers
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
+	"github.com/concourse/concourse/vars"
+)
+
+// Manifest declares the complete set of pipelines a team should have. The
+// order of the pipelines is the order they are displayed in.
+type Manifest struct {
+	Team      string             `json:"team,omitempty"`
+	Pipelines []ManifestPipeline `json:"pipelines"`
+}
+
+type ManifestPipeline struct {
+	Name         string                 `json:"name"`
+	InstanceVars atc.InstanceVars       `json:"instance_vars,omitempty"`
+	Config       string                 `json:"config"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	VarsFiles    []string               `json:"vars_files,omitempty"`
+
+	// Paused and Exposed are left alone when not specified.
+	Paused  *bool `json:"paused,omitempty"`
+	Exposed *bool `json:"exposed,omitempty"`
+}
+
+func (pipeline ManifestPipeline) Ref() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         pipeline.Name,
+		InstanceVars: pipeline.InstanceVars,
+	}
+}
+
+// Template returns the pipeline's config template with its vars. Relative
+// paths have already been resolved by LoadManifest.
+func (pipeline ManifestPipeline) Template() templatehelpers.YamlTemplateWithParams {
+	var varsFiles []atc.PathFlag
+	for _, path := range pipeline.VarsFiles {
+		varsFiles = append(varsFiles, atc.PathFlag(path))
+	}
+
+	var yamlVars []flaghelpers.YAMLVariablePairFlag
+	for name, value := range pipeline.Vars {
+		yamlVars = append(yamlVars, flaghelpers.YAMLVariablePairFlag{
+			Ref:   vars.Reference{Path: name},
+			Value: value,
+		})
+	}
+
+	return templatehelpers.NewYamlTemplateWithParams(
+		atc.PathFlag(pipeline.Config),
+		varsFiles,
+		nil,
+		yamlVars,
+		pipeline.InstanceVars,
+	)
+}
+
+func LoadManifest(path string) (Manifest, error) {
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("could not read manifest: %s", err)
+	}
+
+	var manifest Manifest
+	err = yaml.UnmarshalStrict(payload, &manifest)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("malformed manifest: %s", err)
+	}
+
+	err = manifest.Validate()
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	dir := filepath.Dir(path)
+	for i, pipeline := range manifest.Pipelines {
+		manifest.Pipelines[i].Config = resolvePath(dir, pipeline.Config)
+		for j, varsFile := range pipeline.VarsFiles {
+			manifest.Pipelines[i].VarsFiles[j] = resolvePath(dir, varsFile)
+		}
+	}
+
+	return manifest, nil
+}
+
+func (manifest Manifest) Validate() error {
+	// applying an empty manifest would archive all of the team's pipelines,
+	// which is far more likely to be a mistake than intended
+	if len(manifest.Pipelines) == 0 {
+		return errors.New("invalid manifest: no pipelines declared")
+	}
+
+	var errorMessages []string
+
+	seen := map[string]bool{}
+	for i, pipeline := range manifest.Pipelines {
+		identifier := fmt.Sprintf("pipelines[%d]", i)
+		if pipeline.Name != "" {
+			identifier = fmt.Sprintf("pipelines.%s", pipeline.Ref())
+		}
+
+		if pipeline.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if strings.Contains(pipeline.Name, "/") {
+			errorMessages = append(errorMessages, identifier+" has a name containing '/'")
+		}
+
+		if pipeline.Config == "" {
+			errorMessages = append(errorMessages, identifier+" has no config")
+		}
+
+		ref := pipeline.Ref().String()
+		if seen[ref] {
+			errorMessages = append(errorMessages, identifier+" is declared more than once")
+		}
+		seen[ref] = true
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New("invalid manifest:\n- " + strings.Join(errorMessages, "\n- "))
+	}
+
+	return nil
+}
+
+func resolvePath(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
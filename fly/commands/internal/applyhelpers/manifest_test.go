@@ -0,0 +1,125 @@
+package applyhelpers_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/applyhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadManifest", func() {
+	var (
+		tmpdir       string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "apply-manifest")
+		Expect(err).NotTo(HaveOccurred())
+
+		manifestPath = filepath.Join(tmpdir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	writeManifest := func(content string) {
+		err := ioutil.WriteFile(manifestPath, []byte(content), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("resolves paths relative to the manifest", func() {
+		writeManifest(`
+team: some-team
+pipelines:
+- name: some-pipeline
+  instance_vars: {branch: master}
+  config: ci/pipeline.yml
+  vars_files: [ci/vars.yml, /etc/vars.yml]
+  paused: false
+`)
+
+		manifest, err := applyhelpers.LoadManifest(manifestPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		paused := false
+		Expect(manifest).To(Equal(applyhelpers.Manifest{
+			Team: "some-team",
+			Pipelines: []applyhelpers.ManifestPipeline{
+				{
+					Name:         "some-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "master"},
+					Config:       filepath.Join(tmpdir, "ci", "pipeline.yml"),
+					VarsFiles:    []string{filepath.Join(tmpdir, "ci", "vars.yml"), "/etc/vars.yml"},
+					Paused:       &paused,
+				},
+			},
+		}))
+	})
+
+	It("errors on unknown fields", func() {
+		writeManifest(`
+pipelines:
+- name: some-pipeline
+  config: pipeline.yml
+  pause: true
+`)
+
+		_, err := applyhelpers.LoadManifest(manifestPath)
+		Expect(err).To(MatchError(ContainSubstring("malformed manifest")))
+	})
+
+	It("errors on invalid pipelines", func() {
+		writeManifest(`
+pipelines:
+- config: pipeline.yml
+- name: some/pipeline
+  config: pipeline.yml
+- name: some-pipeline
+- name: other-pipeline
+  config: pipeline.yml
+- name: other-pipeline
+  config: pipeline.yml
+`)
+
+		_, err := applyhelpers.LoadManifest(manifestPath)
+		Expect(err).To(MatchError("invalid manifest:\n" +
+			"- pipelines[0] has no name\n" +
+			"- pipelines.some/pipeline has a name containing '/'\n" +
+			"- pipelines.some-pipeline has no config\n" +
+			"- pipelines.other-pipeline is declared more than once"))
+	})
+
+	It("errors when no pipelines are declared", func() {
+		writeManifest(`
+team: some-team
+pipelines: []
+`)
+
+		_, err := applyhelpers.LoadManifest(manifestPath)
+		Expect(err).To(MatchError("invalid manifest: no pipelines declared"))
+	})
+
+	It("allows instances of the same pipeline", func() {
+		writeManifest(`
+pipelines:
+- name: some-pipeline
+  instance_vars: {branch: master}
+  config: pipeline.yml
+- name: some-pipeline
+  instance_vars: {branch: develop}
+  config: pipeline.yml
+`)
+
+		manifest, err := applyhelpers.LoadManifest(manifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest.Pipelines).To(HaveLen(2))
+	})
+})
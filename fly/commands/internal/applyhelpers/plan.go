@@ -0,0 +1,340 @@
+package applyhelpers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// Plan is the set of changes needed to bring a team's pipelines in line with
+// a manifest.
+type Plan struct {
+	Pipelines []PipelinePlan
+	Archive   []atc.PipelineRef
+
+	// Ordering is the new pipeline ordering, or nil if it is unchanged.
+	Ordering []string
+}
+
+type PipelinePlan struct {
+	Ref    atc.PipelineRef
+	Create bool
+
+	// Config is the evaluated config template, which is only set if the
+	// pipeline is being created or its config has changed. ConfigVersion is
+	// the version it was compared against, so that a concurrent change to
+	// the pipeline fails the update rather than being overwritten.
+	Config         []byte
+	ConfigVersion  string
+	ExistingConfig atc.Config
+	NewConfig      atc.Config
+
+	// Pause and Expose are the desired states, or nil if they are unchanged.
+	Pause  *bool
+	Expose *bool
+}
+
+func (plan PipelinePlan) HasChanges() bool {
+	return plan.Config != nil || plan.Pause != nil || plan.Expose != nil
+}
+
+func (plan Plan) HasChanges() bool {
+	for _, pipeline := range plan.Pipelines {
+		if pipeline.HasChanges() {
+			return true
+		}
+	}
+
+	return len(plan.Archive) > 0 || plan.Ordering != nil
+}
+
+// NewPlan compares the manifest to the team's current pipelines. Pipelines
+// missing from the manifest are archived unless skipArchive is set.
+func NewPlan(team concourse.Team, manifest Manifest, skipArchive bool) (Plan, error) {
+	existingPipelines, err := team.ListPipelines()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	existing := map[string]atc.Pipeline{}
+	for _, pipeline := range existingPipelines {
+		if pipeline.Archived {
+			continue
+		}
+
+		existing[pipeline.Ref().String()] = pipeline
+	}
+
+	var plan Plan
+	declared := map[string]bool{}
+	for _, pipeline := range manifest.Pipelines {
+		ref := pipeline.Ref()
+		declared[ref.String()] = true
+
+		pipelinePlan, err := planPipeline(team, pipeline, existing)
+		if err != nil {
+			return Plan{}, fmt.Errorf("failed to plan pipeline %s: %w", ref, err)
+		}
+
+		plan.Pipelines = append(plan.Pipelines, pipelinePlan)
+	}
+
+	var currentOrdering []string
+	seen := map[string]bool{}
+	for _, pipeline := range existingPipelines {
+		if pipeline.Archived {
+			continue
+		}
+
+		ref := pipeline.Ref()
+		if !declared[ref.String()] {
+			if !skipArchive {
+				plan.Archive = append(plan.Archive, ref)
+			}
+			continue
+		}
+
+		if !seen[pipeline.Name] {
+			seen[pipeline.Name] = true
+			currentOrdering = append(currentOrdering, pipeline.Name)
+		}
+	}
+
+	var desiredOrdering []string
+	seen = map[string]bool{}
+	for _, pipeline := range manifest.Pipelines {
+		if !seen[pipeline.Name] {
+			seen[pipeline.Name] = true
+			desiredOrdering = append(desiredOrdering, pipeline.Name)
+		}
+	}
+
+	if !equalOrdering(currentOrdering, desiredOrdering) {
+		plan.Ordering = desiredOrdering
+	}
+
+	return plan, nil
+}
+
+func planPipeline(team concourse.Team, pipeline ManifestPipeline, existing map[string]atc.Pipeline) (PipelinePlan, error) {
+	ref := pipeline.Ref()
+
+	evaluatedTemplate, err := pipeline.Template().Evaluate(false, false)
+	if err != nil {
+		return PipelinePlan{}, err
+	}
+
+	var newConfig atc.Config
+	err = yaml.Unmarshal(evaluatedTemplate, &newConfig)
+	if err != nil {
+		return PipelinePlan{}, err
+	}
+
+	plan := PipelinePlan{
+		Ref:       ref,
+		NewConfig: newConfig,
+	}
+
+	current, found := existing[ref.String()]
+	if !found {
+		plan.Create = true
+		plan.Config = evaluatedTemplate
+
+		// new pipelines start out paused and hidden
+		if pipeline.Paused != nil && !*pipeline.Paused {
+			plan.Pause = pipeline.Paused
+		}
+		if pipeline.Exposed != nil && *pipeline.Exposed {
+			plan.Expose = pipeline.Exposed
+		}
+
+		return plan, nil
+	}
+
+	existingConfig, existingConfigVersion, _, err := team.PipelineConfig(ref)
+	if err != nil {
+		return PipelinePlan{}, err
+	}
+
+	plan.ExistingConfig = existingConfig
+	plan.ConfigVersion = existingConfigVersion
+
+	if len(existingConfig.Changes(newConfig)) > 0 {
+		plan.Config = evaluatedTemplate
+	}
+
+	if pipeline.Paused != nil && *pipeline.Paused != current.Paused {
+		plan.Pause = pipeline.Paused
+	}
+	if pipeline.Exposed != nil && *pipeline.Exposed != current.Public {
+		plan.Expose = pipeline.Exposed
+	}
+
+	return plan, nil
+}
+
+// Show prints the plan, including the config diff of each updated pipeline.
+func (plan Plan) Show(w io.Writer) {
+	var created, updated int
+	for _, pipeline := range plan.Pipelines {
+		switch {
+		case pipeline.Create:
+			created++
+			fmt.Fprintf(w, "create pipeline %s\n", pipeline.Ref)
+		case pipeline.Config != nil:
+			updated++
+			fmt.Fprintf(w, "update pipeline %s\n", pipeline.Ref)
+			pipeline.ExistingConfig.Diff(w, pipeline.NewConfig)
+		case pipeline.HasChanges():
+			updated++
+		}
+
+		if pipeline.Pause != nil {
+			if *pipeline.Pause {
+				fmt.Fprintf(w, "pause pipeline %s\n", pipeline.Ref)
+			} else {
+				fmt.Fprintf(w, "unpause pipeline %s\n", pipeline.Ref)
+			}
+		}
+
+		if pipeline.Expose != nil {
+			if *pipeline.Expose {
+				fmt.Fprintf(w, "expose pipeline %s\n", pipeline.Ref)
+			} else {
+				fmt.Fprintf(w, "hide pipeline %s\n", pipeline.Ref)
+			}
+		}
+	}
+
+	for _, ref := range plan.Archive {
+		fmt.Fprintf(w, "archive pipeline %s\n", ref)
+	}
+
+	if plan.Ordering != nil {
+		fmt.Fprintf(w, "order pipelines %s\n", strings.Join(plan.Ordering, ", "))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "plan: %d to create, %d to update, %d to archive\n", created, updated, len(plan.Archive))
+}
+
+// Apply makes the planned changes. Each pipeline is applied on its own: if
+// setting its config fails, its paused and exposed states are left alone and
+// the remaining pipelines are still applied. All failures are returned
+// together.
+func (plan Plan) Apply(w io.Writer, team concourse.Team, checkCredentials bool) error {
+	var failed []string
+	fail := func(ref atc.PipelineRef, action string, err error) {
+		fmt.Fprintf(w, "failed to %s pipeline %s: %s\n", action, ref, err)
+		failed = append(failed, ref.String())
+	}
+
+	for _, pipeline := range plan.Pipelines {
+		if pipeline.Config != nil {
+			_, _, warnings, err := team.CreateOrUpdatePipelineConfig(
+				pipeline.Ref,
+				pipeline.ConfigVersion,
+				pipeline.Config,
+				checkCredentials,
+			)
+			if err != nil {
+				fail(pipeline.Ref, "configure", err)
+				continue
+			}
+
+			for _, warning := range warnings {
+				fmt.Fprintf(w, "warning: pipeline %s: %s\n", pipeline.Ref, warning.Message)
+			}
+
+			if pipeline.Create {
+				fmt.Fprintf(w, "created pipeline %s\n", pipeline.Ref)
+			} else {
+				fmt.Fprintf(w, "updated pipeline %s\n", pipeline.Ref)
+			}
+		}
+
+		if pipeline.Pause != nil {
+			err := setState(*pipeline.Pause, team.PausePipeline, team.UnpausePipeline, pipeline.Ref)
+			if err != nil {
+				fail(pipeline.Ref, "pause or unpause", err)
+				continue
+			}
+		}
+
+		if pipeline.Expose != nil {
+			err := setState(*pipeline.Expose, team.ExposePipeline, team.HidePipeline, pipeline.Ref)
+			if err != nil {
+				fail(pipeline.Ref, "expose or hide", err)
+				continue
+			}
+		}
+	}
+
+	for _, ref := range plan.Archive {
+		found, err := team.ArchivePipeline(ref)
+		if err == nil && !found {
+			err = fmt.Errorf("pipeline not found")
+		}
+		if err != nil {
+			fail(ref, "archive", err)
+			continue
+		}
+
+		fmt.Fprintf(w, "archived pipeline %s\n", ref)
+	}
+
+	if plan.Ordering != nil {
+		err := team.OrderingPipelines(plan.Ordering)
+		if err != nil {
+			fmt.Fprintf(w, "failed to order pipelines: %s\n", err)
+			failed = append(failed, "ordering")
+		} else {
+			fmt.Fprintln(w, "ordered pipelines")
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to apply %d change(s)", len(failed))
+	}
+
+	return nil
+}
+
+type pipelineAction func(atc.PipelineRef) (bool, error)
+
+func setState(state bool, enable pipelineAction, disable pipelineAction, ref atc.PipelineRef) error {
+	action := disable
+	if state {
+		action = enable
+	}
+
+	found, err := action(ref)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline not found")
+	}
+
+	return nil
+}
+
+func equalOrdering(current []string, desired []string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	for i := range current {
+		if current[i] != desired[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,220 @@
+package applyhelpers_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/applyhelpers"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		tmpdir     string
+		configPath string
+		fakeTeam   *concoursefakes.FakeTeam
+		manifest   applyhelpers.Manifest
+
+		liveConfig atc.Config
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "apply-plan")
+		Expect(err).NotTo(HaveOccurred())
+
+		configPath = filepath.Join(tmpdir, "pipeline.yml")
+		err = ioutil.WriteFile(configPath, []byte(`
+resources:
+- name: some-resource
+  type: git
+  source: {branch: ((branch))}
+`), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		liveConfig = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: atc.Source{"branch": "master"}},
+			},
+		}
+
+		fakeTeam = new(concoursefakes.FakeTeam)
+		fakeTeam.PipelineConfigReturns(liveConfig, "42", true, nil)
+		fakeTeam.CreateOrUpdatePipelineConfigReturns(true, false, nil, nil)
+		fakeTeam.PausePipelineReturns(true, nil)
+		fakeTeam.UnpausePipelineReturns(true, nil)
+		fakeTeam.ExposePipelineReturns(true, nil)
+		fakeTeam.HidePipelineReturns(true, nil)
+		fakeTeam.ArchivePipelineReturns(true, nil)
+
+		manifest = applyhelpers.Manifest{
+			Pipelines: []applyhelpers.ManifestPipeline{
+				{Name: "a", Config: configPath, Vars: map[string]interface{}{"branch": "master"}},
+				{Name: "b", Config: configPath, Vars: map[string]interface{}{"branch": "master"}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	Describe("NewPlan", func() {
+		Context("when the team matches the manifest", func() {
+			BeforeEach(func() {
+				fakeTeam.ListPipelinesReturns([]atc.Pipeline{
+					{Name: "a"},
+					{Name: "b"},
+					{Name: "c", Archived: true},
+				}, nil)
+			})
+
+			It("has no changes", func() {
+				plan, err := applyhelpers.NewPlan(fakeTeam, manifest, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan.HasChanges()).To(BeFalse())
+			})
+		})
+
+		Context("when the team has drifted from the manifest", func() {
+			BeforeEach(func() {
+				paused := true
+				exposed := true
+				manifest.Pipelines[0].Vars["branch"] = "develop"
+				manifest.Pipelines[1].Paused = &paused
+				manifest.Pipelines = append(manifest.Pipelines, applyhelpers.ManifestPipeline{
+					Name:    "new",
+					Config:  configPath,
+					Vars:    map[string]interface{}{"branch": "master"},
+					Exposed: &exposed,
+				})
+
+				fakeTeam.ListPipelinesReturns([]atc.Pipeline{
+					{Name: "b"},
+					{Name: "a"},
+					{Name: "old"},
+				}, nil)
+			})
+
+			It("plans every change", func() {
+				plan, err := applyhelpers.NewPlan(fakeTeam, manifest, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan.HasChanges()).To(BeTrue())
+
+				Expect(plan.Pipelines).To(HaveLen(3))
+
+				Expect(plan.Pipelines[0].Create).To(BeFalse())
+				Expect(plan.Pipelines[0].Config).NotTo(BeNil())
+				Expect(plan.Pipelines[0].ConfigVersion).To(Equal("42"))
+
+				Expect(plan.Pipelines[1].Config).To(BeNil())
+				Expect(plan.Pipelines[1].Pause).NotTo(BeNil())
+				Expect(*plan.Pipelines[1].Pause).To(BeTrue())
+
+				Expect(plan.Pipelines[2].Create).To(BeTrue())
+				Expect(plan.Pipelines[2].Config).NotTo(BeNil())
+				Expect(plan.Pipelines[2].Pause).To(BeNil())
+				Expect(plan.Pipelines[2].Expose).NotTo(BeNil())
+				Expect(*plan.Pipelines[2].Expose).To(BeTrue())
+
+				Expect(plan.Archive).To(Equal([]atc.PipelineRef{{Name: "old"}}))
+				Expect(plan.Ordering).To(Equal([]string{"a", "b", "new"}))
+			})
+
+			It("does not archive pipelines when told to skip it", func() {
+				plan, err := applyhelpers.NewPlan(fakeTeam, manifest, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan.Archive).To(BeEmpty())
+			})
+
+			It("shows the plan", func() {
+				plan, err := applyhelpers.NewPlan(fakeTeam, manifest, false)
+				Expect(err).NotTo(HaveOccurred())
+
+				buffer := gbytes.NewBuffer()
+				plan.Show(buffer)
+
+				Expect(buffer).To(gbytes.Say("update pipeline a"))
+				Expect(buffer).To(gbytes.Say("branch: master"))
+				Expect(buffer).To(gbytes.Say("branch: develop"))
+				Expect(buffer).To(gbytes.Say("pause pipeline b"))
+				Expect(buffer).To(gbytes.Say("create pipeline new"))
+				Expect(buffer).To(gbytes.Say("expose pipeline new"))
+				Expect(buffer).To(gbytes.Say("archive pipeline old"))
+				Expect(buffer).To(gbytes.Say("order pipelines a, b, new"))
+				Expect(buffer).To(gbytes.Say("plan: 1 to create, 2 to update, 1 to archive"))
+			})
+
+			Describe("Apply", func() {
+				It("applies every change", func() {
+					plan, err := applyhelpers.NewPlan(fakeTeam, manifest, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = plan.Apply(gbytes.NewBuffer(), fakeTeam, true)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeTeam.CreateOrUpdatePipelineConfigCallCount()).To(Equal(2))
+					ref, version, _, checkCreds := fakeTeam.CreateOrUpdatePipelineConfigArgsForCall(0)
+					Expect(ref).To(Equal(atc.PipelineRef{Name: "a"}))
+					Expect(version).To(Equal("42"))
+					Expect(checkCreds).To(BeTrue())
+
+					ref, version, _, _ = fakeTeam.CreateOrUpdatePipelineConfigArgsForCall(1)
+					Expect(ref).To(Equal(atc.PipelineRef{Name: "new"}))
+					Expect(version).To(Equal(""))
+
+					Expect(fakeTeam.PausePipelineCallCount()).To(Equal(1))
+					Expect(fakeTeam.PausePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "b"}))
+
+					Expect(fakeTeam.ExposePipelineCallCount()).To(Equal(1))
+					Expect(fakeTeam.ExposePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "new"}))
+
+					Expect(fakeTeam.ArchivePipelineCallCount()).To(Equal(1))
+					Expect(fakeTeam.ArchivePipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "old"}))
+
+					Expect(fakeTeam.OrderingPipelinesCallCount()).To(Equal(1))
+					Expect(fakeTeam.OrderingPipelinesArgsForCall(0)).To(Equal([]string{"a", "b", "new"}))
+				})
+
+				Context("when configuring a pipeline fails", func() {
+					BeforeEach(func() {
+						fakeTeam.CreateOrUpdatePipelineConfigReturnsOnCall(1, false, false, nil, errors.New("nope"))
+					})
+
+					It("skips the rest of that pipeline and applies the others", func() {
+						plan, err := applyhelpers.NewPlan(fakeTeam, manifest, false)
+						Expect(err).NotTo(HaveOccurred())
+
+						buffer := gbytes.NewBuffer()
+						err = plan.Apply(buffer, fakeTeam, false)
+						Expect(err).To(MatchError("failed to apply 1 change(s)"))
+						Expect(buffer).To(gbytes.Say("failed to configure pipeline new: nope"))
+
+						Expect(fakeTeam.ExposePipelineCallCount()).To(Equal(0))
+						Expect(fakeTeam.PausePipelineCallCount()).To(Equal(1))
+						Expect(fakeTeam.ArchivePipelineCallCount()).To(Equal(1))
+						Expect(fakeTeam.OrderingPipelinesCallCount()).To(Equal(1))
+					})
+				})
+			})
+		})
+
+		Context("when the config cannot be evaluated", func() {
+			BeforeEach(func() {
+				manifest.Pipelines[0].Config = filepath.Join(tmpdir, "missing.yml")
+			})
+
+			It("errors", func() {
+				_, err := applyhelpers.NewPlan(fakeTeam, manifest, false)
+				Expect(err).To(MatchError(ContainSubstring("failed to plan pipeline a")))
+			})
+		})
+	})
+})
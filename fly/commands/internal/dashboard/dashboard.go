@@ -0,0 +1,473 @@
+package dashboard
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type View int
+
+const (
+	PipelinesView View = iota
+	JobsView
+	ResourcesView
+	VersionsView
+	BuildView
+)
+
+const versionsLimit = 50
+
+// Dashboard holds the state of the terminal dashboard. It is not safe for
+// concurrent use: everything other than the event streams runs on the
+// goroutine calling Run, and the streams hand their results back through
+// the updates channel.
+type Dashboard struct {
+	client concourse.Client
+	team   concourse.Team
+
+	view    View
+	cursors map[View]int
+	status  string
+
+	pipelines []atc.Pipeline
+	jobs      []atc.Job
+	resources []atc.Resource
+	versions  []atc.ResourceVersion
+
+	pipeline atc.Pipeline
+	resource atc.Resource
+	build    atc.Build
+
+	log       *logBuffer
+	logEvents concourse.Events
+
+	// watching is the set of running builds whose event streams are being
+	// followed so that their jobs refresh as soon as they finish.
+	watching map[int]bool
+
+	updates chan func()
+	done    chan struct{}
+}
+
+func New(client concourse.Client, team concourse.Team) *Dashboard {
+	return &Dashboard{
+		client: client,
+		team:   team,
+
+		cursors:  map[View]int{},
+		watching: map[int]bool{},
+
+		updates: make(chan func()),
+		done:    make(chan struct{}),
+	}
+}
+
+func (d *Dashboard) View() View {
+	return d.view
+}
+
+func (d *Dashboard) Status() string {
+	return d.status
+}
+
+// Refresh reloads everything shown in the current view.
+func (d *Dashboard) Refresh() {
+	pipelines, err := d.team.ListPipelines()
+	if err != nil {
+		d.fail("failed to list pipelines", err)
+		return
+	}
+
+	d.pipelines = nil
+	for _, pipeline := range pipelines {
+		if !pipeline.Archived {
+			d.pipelines = append(d.pipelines, pipeline)
+		}
+	}
+
+	jobs, err := d.client.ListAllJobs()
+	if err != nil {
+		d.fail("failed to list jobs", err)
+		return
+	}
+
+	d.jobs = nil
+	for _, job := range jobs {
+		if job.TeamName == d.team.Name() {
+			d.jobs = append(d.jobs, job)
+		}
+	}
+
+	for _, job := range d.jobs {
+		if job.NextBuild != nil && !d.watching[job.NextBuild.ID] {
+			d.watching[job.NextBuild.ID] = true
+			go d.watch(job.NextBuild.ID)
+		}
+	}
+
+	switch d.view {
+	case ResourcesView:
+		d.refreshResources()
+	case VersionsView:
+		d.refreshVersions()
+	case BuildView:
+		build, found, err := d.client.Build(strconv.Itoa(d.build.ID))
+		if err != nil {
+			d.fail("failed to get build", err)
+		} else if found {
+			d.build = build
+		}
+	}
+}
+
+// HandleKey acts on a key press, returning true when the dashboard should
+// exit.
+func (d *Dashboard) HandleKey(key Key) bool {
+	switch key {
+	case "q", KeyCtrlC:
+		d.closeLog()
+		close(d.done)
+		return true
+	case KeyUp, "k":
+		d.move(-1)
+	case KeyDown, "j":
+		d.move(1)
+	case KeyEscape, KeyBackspace, KeyLeft, "h":
+		d.back()
+	case "r":
+		d.status = ""
+		d.Refresh()
+	default:
+		d.handleViewKey(key)
+	}
+
+	return false
+}
+
+func (d *Dashboard) handleViewKey(key Key) {
+	open := key == KeyEnter || key == KeyRight || key == "l"
+
+	switch d.view {
+	case PipelinesView:
+		if open {
+			if pipeline, ok := d.selectedPipeline(); ok {
+				d.pipeline = pipeline
+				d.setView(JobsView)
+			}
+		}
+
+	case JobsView:
+		job, ok := d.selectedJob()
+		switch {
+		case key == "v":
+			d.setView(ResourcesView)
+			d.refreshResources()
+		case !ok:
+		case open:
+			d.openJobBuild(job)
+		case key == "t":
+			d.triggerJob(job)
+		case key == "a":
+			if job.NextBuild == nil {
+				d.status = "job has no running build"
+			} else {
+				d.abortBuild(*job.NextBuild)
+			}
+		}
+
+	case ResourcesView:
+		resource, ok := d.selectedResource()
+		switch {
+		case !ok:
+		case open:
+			d.resource = resource
+			d.setView(VersionsView)
+			d.refreshVersions()
+		case key == "u":
+			d.unpin(resource)
+		}
+
+	case VersionsView:
+		switch key {
+		case "p":
+			if version, ok := d.selectedVersion(); ok {
+				d.pin(version)
+			}
+		case "u":
+			d.unpin(d.resource)
+		}
+
+	case BuildView:
+		if key == "a" {
+			d.abortBuild(d.build)
+		}
+	}
+}
+
+func (d *Dashboard) back() {
+	switch d.view {
+	case JobsView:
+		d.view = PipelinesView
+	case ResourcesView:
+		d.view = JobsView
+	case VersionsView:
+		d.view = ResourcesView
+	case BuildView:
+		d.closeLog()
+		d.view = JobsView
+	}
+}
+
+func (d *Dashboard) setView(view View) {
+	d.view = view
+	d.cursors[view] = 0
+	d.status = ""
+}
+
+func (d *Dashboard) move(delta int) {
+	cursor := d.cursors[d.view] + delta
+	if cursor >= d.rowCount() {
+		cursor = d.rowCount() - 1
+	}
+	if cursor < 0 {
+		cursor = 0
+	}
+
+	d.cursors[d.view] = cursor
+}
+
+func (d *Dashboard) rowCount() int {
+	switch d.view {
+	case PipelinesView:
+		return len(d.pipelines)
+	case JobsView:
+		return len(d.pipelineJobs())
+	case ResourcesView:
+		return len(d.resources)
+	case VersionsView:
+		return len(d.versions)
+	}
+
+	return 0
+}
+
+func (d *Dashboard) pipelineJobs() []atc.Job {
+	var jobs []atc.Job
+	for _, job := range d.jobs {
+		if job.PipelineID == d.pipeline.ID {
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs
+}
+
+func (d *Dashboard) selectedPipeline() (atc.Pipeline, bool) {
+	cursor := d.cursors[PipelinesView]
+	if cursor >= len(d.pipelines) {
+		return atc.Pipeline{}, false
+	}
+
+	return d.pipelines[cursor], true
+}
+
+func (d *Dashboard) selectedJob() (atc.Job, bool) {
+	jobs := d.pipelineJobs()
+
+	cursor := d.cursors[JobsView]
+	if cursor >= len(jobs) {
+		return atc.Job{}, false
+	}
+
+	return jobs[cursor], true
+}
+
+func (d *Dashboard) selectedResource() (atc.Resource, bool) {
+	cursor := d.cursors[ResourcesView]
+	if cursor >= len(d.resources) {
+		return atc.Resource{}, false
+	}
+
+	return d.resources[cursor], true
+}
+
+func (d *Dashboard) selectedVersion() (atc.ResourceVersion, bool) {
+	cursor := d.cursors[VersionsView]
+	if cursor >= len(d.versions) {
+		return atc.ResourceVersion{}, false
+	}
+
+	return d.versions[cursor], true
+}
+
+func (d *Dashboard) refreshResources() {
+	resources, err := d.team.ListResources(d.pipeline.Ref())
+	if err != nil {
+		d.fail("failed to list resources", err)
+		return
+	}
+
+	d.resources = resources
+}
+
+func (d *Dashboard) refreshVersions() {
+	versions, _, _, err := d.team.ResourceVersions(d.pipeline.Ref(), d.resource.Name, concourse.Page{Limit: versionsLimit}, nil)
+	if err != nil {
+		d.fail("failed to list resource versions", err)
+		return
+	}
+
+	d.versions = versions
+
+	for _, resource := range d.resources {
+		if resource.Name == d.resource.Name {
+			d.resource = resource
+		}
+	}
+}
+
+func (d *Dashboard) openJobBuild(job atc.Job) {
+	build := job.NextBuild
+	if build == nil {
+		build = job.FinishedBuild
+	}
+
+	if build == nil {
+		d.status = "job has no builds"
+		return
+	}
+
+	d.openBuild(*build)
+}
+
+func (d *Dashboard) openBuild(build atc.Build) {
+	events, err := d.client.BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		d.fail("failed to stream build events", err)
+		return
+	}
+
+	d.closeLog()
+
+	d.build = build
+	d.log = newLogBuffer()
+	d.logEvents = events
+	d.setView(BuildView)
+
+	log := d.log
+	go func() {
+		eventstream.Render(log, events, eventstream.RenderOptions{})
+		d.send(d.Refresh)
+	}()
+}
+
+func (d *Dashboard) closeLog() {
+	if d.logEvents != nil {
+		d.logEvents.Close()
+		d.logEvents = nil
+	}
+}
+
+func (d *Dashboard) triggerJob(job atc.Job) {
+	build, err := d.team.CreateJobBuild(d.pipeline.Ref(), job.Name)
+	if err != nil {
+		d.fail("failed to trigger job", err)
+		return
+	}
+
+	d.status = fmt.Sprintf("started %s/%s #%s", d.pipeline.Ref(), job.Name, build.Name)
+	d.Refresh()
+}
+
+func (d *Dashboard) abortBuild(build atc.Build) {
+	if !build.Abortable() {
+		d.status = "build is not running"
+		return
+	}
+
+	err := d.client.AbortBuild(strconv.Itoa(build.ID))
+	if err != nil {
+		d.fail("failed to abort build", err)
+		return
+	}
+
+	d.status = fmt.Sprintf("aborted build %d", build.ID)
+	d.Refresh()
+}
+
+func (d *Dashboard) pin(version atc.ResourceVersion) {
+	found, err := d.team.PinResourceVersion(d.pipeline.Ref(), d.resource.Name, version.ID)
+	if err != nil {
+		d.fail("failed to pin version", err)
+		return
+	}
+
+	if !found {
+		d.status = "resource version not found"
+		return
+	}
+
+	d.status = fmt.Sprintf("pinned %s to version %d", d.resource.Name, version.ID)
+	d.refreshResources()
+	d.refreshVersions()
+}
+
+func (d *Dashboard) unpin(resource atc.Resource) {
+	found, err := d.team.UnpinResource(d.pipeline.Ref(), resource.Name)
+	if err != nil {
+		d.fail("failed to unpin resource", err)
+		return
+	}
+
+	if !found {
+		d.status = "resource not found"
+		return
+	}
+
+	d.status = fmt.Sprintf("unpinned %s", resource.Name)
+	d.refreshResources()
+	if d.view == VersionsView {
+		d.refreshVersions()
+	}
+}
+
+// watch follows the events of a running build and refreshes the dashboard
+// once it finishes.
+func (d *Dashboard) watch(buildID int) {
+	events, err := d.client.BuildEvents(strconv.Itoa(buildID))
+	if err == nil {
+		for {
+			ev, err := events.NextEvent()
+			if err != nil {
+				break
+			}
+
+			if status, ok := ev.(event.Status); ok && !(atc.Build{Status: status.Status}).IsRunning() {
+				break
+			}
+		}
+
+		events.Close()
+	}
+
+	d.send(func() {
+		delete(d.watching, buildID)
+		d.Refresh()
+	})
+}
+
+func (d *Dashboard) send(update func()) {
+	select {
+	case d.updates <- update:
+	case <-d.done:
+	}
+}
+
+func (d *Dashboard) fail(message string, err error) {
+	d.status = fmt.Sprintf("%s: %s", message, err)
+}
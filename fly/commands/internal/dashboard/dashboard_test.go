@@ -0,0 +1,221 @@
+package dashboard_test
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream/eventstreamfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Dashboard", func() {
+	var (
+		fakeClient *concoursefakes.FakeClient
+		fakeTeam   *concoursefakes.FakeTeam
+
+		d *dashboard.Dashboard
+	)
+
+	pipelineRef := atc.PipelineRef{Name: "some-pipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+	render := func() string {
+		buffer := gbytes.NewBuffer()
+		err := d.Render(buffer, 120, 20)
+		Expect(err).NotTo(HaveOccurred())
+		return string(buffer.Contents())
+	}
+
+	press := func(keys ...dashboard.Key) {
+		for _, key := range keys {
+			Expect(d.HandleKey(key)).To(BeFalse())
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = new(concoursefakes.FakeClient)
+		fakeTeam = new(concoursefakes.FakeTeam)
+
+		fakeClient.URLReturns("http://example.com")
+		fakeTeam.NameReturns("main")
+
+		fakeTeam.ListPipelinesReturns([]atc.Pipeline{
+			{ID: 1, Name: "other-pipeline", TeamName: "main"},
+			{ID: 2, Name: "some-pipeline", InstanceVars: atc.InstanceVars{"branch": "master"}, TeamName: "main", Paused: true},
+			{ID: 3, Name: "archived-pipeline", TeamName: "main", Archived: true},
+		}, nil)
+
+		fakeClient.ListAllJobsReturns([]atc.Job{
+			{
+				Name:          "other-job",
+				PipelineID:    1,
+				TeamName:      "main",
+				FinishedBuild: &atc.Build{ID: 10, Name: "1", Status: atc.StatusSucceeded},
+			},
+			{
+				Name:          "finished-job",
+				PipelineID:    2,
+				TeamName:      "main",
+				FinishedBuild: &atc.Build{ID: 20, Name: "4", JobName: "finished-job", Status: atc.StatusFailed},
+			},
+			{
+				Name:          "running-job",
+				PipelineID:    2,
+				TeamName:      "main",
+				FinishedBuild: &atc.Build{ID: 21, Name: "1", Status: atc.StatusSucceeded},
+				NextBuild:     &atc.Build{ID: 22, Name: "2", Status: atc.StatusStarted},
+			},
+			{
+				Name:       "other-team-job",
+				PipelineID: 4,
+				TeamName:   "other-team",
+			},
+		}, nil)
+
+		fakeClient.BuildEventsStub = func(buildID string) (concourse.Events, error) {
+			fakeEvents := new(eventstreamfakes.FakeEventStream)
+			if buildID == "20" {
+				fakeEvents.NextEventReturnsOnCall(0, event.Log{Payload: "hello from the build\n"}, nil)
+			}
+			fakeEvents.NextEventReturns(nil, io.EOF)
+			return fakeEvents, nil
+		}
+
+		d = dashboard.New(fakeClient, fakeTeam)
+		d.Refresh()
+	})
+
+	It("lists the team's pipelines with the status of their jobs", func() {
+		screen := render()
+		Expect(screen).To(ContainSubstring("http://example.com  team main"))
+		Expect(screen).To(MatchRegexp(`other-pipeline\s+succeeded`))
+		Expect(screen).To(MatchRegexp(`some-pipeline/branch:master\s+started\s+paused`))
+		Expect(screen).NotTo(ContainSubstring("archived-pipeline"))
+	})
+
+	It("follows the events of running builds", func() {
+		Eventually(fakeClient.BuildEventsCallCount).Should(Equal(1))
+		Expect(fakeClient.BuildEventsArgsForCall(0)).To(Equal("22"))
+	})
+
+	Context("when a pipeline is opened", func() {
+		BeforeEach(func() {
+			press("j", dashboard.KeyEnter)
+		})
+
+		It("lists the pipeline's jobs", func() {
+			Expect(d.View()).To(Equal(dashboard.JobsView))
+
+			screen := render()
+			Expect(screen).To(ContainSubstring("pipelines > some-pipeline/branch:master"))
+			Expect(screen).To(MatchRegexp(`finished-job\s+failed`))
+			Expect(screen).To(MatchRegexp(`running-job\s+succeeded\s+#2 started`))
+			Expect(screen).NotTo(ContainSubstring("other-job"))
+			Expect(screen).NotTo(ContainSubstring("other-team-job"))
+		})
+
+		It("goes back to the pipelines", func() {
+			press(dashboard.KeyEscape)
+			Expect(d.View()).To(Equal(dashboard.PipelinesView))
+		})
+
+		It("triggers the selected job", func() {
+			fakeTeam.CreateJobBuildReturns(atc.Build{ID: 23, Name: "3"}, nil)
+
+			press("j", "t")
+
+			Expect(fakeTeam.CreateJobBuildCallCount()).To(Equal(1))
+			ref, jobName := fakeTeam.CreateJobBuildArgsForCall(0)
+			Expect(ref).To(Equal(pipelineRef))
+			Expect(jobName).To(Equal("running-job"))
+
+			Expect(d.Status()).To(Equal("started some-pipeline/branch:master/running-job #3"))
+		})
+
+		It("aborts the running build of the selected job", func() {
+			press("j", "a")
+
+			Expect(fakeClient.AbortBuildCallCount()).To(Equal(1))
+			Expect(fakeClient.AbortBuildArgsForCall(0)).To(Equal("22"))
+		})
+
+		It("does not abort jobs without running builds", func() {
+			press("a")
+
+			Expect(fakeClient.AbortBuildCallCount()).To(Equal(0))
+			Expect(d.Status()).To(Equal("job has no running build"))
+		})
+
+		It("shows the log of the selected job's build", func() {
+			press(dashboard.KeyEnter)
+			Expect(d.View()).To(Equal(dashboard.BuildView))
+
+			Eventually(render).Should(ContainSubstring("hello from the build"))
+			Expect(render()).To(ContainSubstring("finished-job #4 > failed"))
+		})
+
+		Context("when the resources are opened", func() {
+			BeforeEach(func() {
+				fakeTeam.ListResourcesReturns([]atc.Resource{
+					{Name: "some-resource", Type: "git"},
+				}, nil)
+				fakeTeam.ResourceVersionsReturns([]atc.ResourceVersion{
+					{ID: 5, Version: atc.Version{"ref": "abc"}, Enabled: true},
+					{ID: 4, Version: atc.Version{"ref": "def"}, Enabled: false},
+				}, concourse.Pagination{}, true, nil)
+				fakeTeam.PinResourceVersionReturns(true, nil)
+				fakeTeam.UnpinResourceReturns(true, nil)
+
+				press("v")
+			})
+
+			It("lists the pipeline's resources", func() {
+				Expect(d.View()).To(Equal(dashboard.ResourcesView))
+				Expect(fakeTeam.ListResourcesArgsForCall(0)).To(Equal(pipelineRef))
+				Expect(render()).To(MatchRegexp(`some-resource\s+git`))
+			})
+
+			It("pins the selected version", func() {
+				press(dashboard.KeyEnter)
+				Expect(render()).To(MatchRegexp(`4\s+ref:def\s+disabled`))
+
+				press("j", "p")
+
+				Expect(fakeTeam.PinResourceVersionCallCount()).To(Equal(1))
+				ref, resourceName, versionID := fakeTeam.PinResourceVersionArgsForCall(0)
+				Expect(ref).To(Equal(pipelineRef))
+				Expect(resourceName).To(Equal("some-resource"))
+				Expect(versionID).To(Equal(4))
+				Expect(d.Status()).To(Equal("pinned some-resource to version 4"))
+			})
+
+			It("unpins the selected resource", func() {
+				press("u")
+
+				Expect(fakeTeam.UnpinResourceCallCount()).To(Equal(1))
+				ref, resourceName := fakeTeam.UnpinResourceArgsForCall(0)
+				Expect(ref).To(Equal(pipelineRef))
+				Expect(resourceName).To(Equal("some-resource"))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		It("draws the dashboard until told to quit", func() {
+			output := gbytes.NewBuffer()
+			err := d.Run(strings.NewReader("jq"), output, func() (int, int, error) {
+				return 20, 80, nil
+			}, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(gbytes.Say("other-pipeline"))
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package dashboard
+
+import "unicode/utf8"
+
+// Key is a key press read from a raw terminal. Printable keys are the
+// character itself; everything else is one of the named keys below.
+type Key string
+
+const (
+	KeyUp        Key = "up"
+	KeyDown      Key = "down"
+	KeyLeft      Key = "left"
+	KeyRight     Key = "right"
+	KeyEnter     Key = "enter"
+	KeyEscape    Key = "escape"
+	KeyBackspace Key = "backspace"
+	KeyCtrlC     Key = "ctrl-c"
+)
+
+const (
+	keyCtrlC     = 3
+	keyEscape    = 27
+	keyBackspace = 127
+)
+
+// ParseKeys splits a chunk of terminal input into key presses. Unknown
+// escape sequences are dropped.
+func ParseKeys(input []byte) []Key {
+	var keys []Key
+
+	for len(input) > 0 {
+		switch input[0] {
+		case keyEscape:
+			if len(input) >= 3 && (input[1] == '[' || input[1] == 'O') {
+				switch input[2] {
+				case 'A':
+					keys = append(keys, KeyUp)
+				case 'B':
+					keys = append(keys, KeyDown)
+				case 'C':
+					keys = append(keys, KeyRight)
+				case 'D':
+					keys = append(keys, KeyLeft)
+				}
+
+				input = input[3:]
+				continue
+			}
+
+			keys = append(keys, KeyEscape)
+			input = input[1:]
+
+		case '\r', '\n':
+			keys = append(keys, KeyEnter)
+			input = input[1:]
+
+		case '\b', keyBackspace:
+			keys = append(keys, KeyBackspace)
+			input = input[1:]
+
+		case keyCtrlC:
+			keys = append(keys, KeyCtrlC)
+			input = input[1:]
+
+		default:
+			r, size := utf8.DecodeRune(input)
+			if r >= 32 && r != utf8.RuneError {
+				keys = append(keys, Key(string(r)))
+			}
+
+			input = input[size:]
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,25 @@
+package dashboard_test
+
+import (
+	"github.com/concourse/concourse/fly/commands/internal/dashboard"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseKeys", func() {
+	DescribeTable("parsing terminal input",
+		func(input string, expected []dashboard.Key) {
+			Expect(dashboard.ParseKeys([]byte(input))).To(Equal(expected))
+		},
+		Entry("printable characters", "jk", []dashboard.Key{"j", "k"}),
+		Entry("arrow keys", "\x1b[A\x1b[B\x1b[C\x1b[D", []dashboard.Key{dashboard.KeyUp, dashboard.KeyDown, dashboard.KeyRight, dashboard.KeyLeft}),
+		Entry("application mode arrow keys", "\x1bOA", []dashboard.Key{dashboard.KeyUp}),
+		Entry("escape on its own", "\x1b", []dashboard.Key{dashboard.KeyEscape}),
+		Entry("enter", "\r", []dashboard.Key{dashboard.KeyEnter}),
+		Entry("backspace", "\x7f", []dashboard.Key{dashboard.KeyBackspace}),
+		Entry("ctrl-c", "\x03", []dashboard.Key{dashboard.KeyCtrlC}),
+		Entry("unknown control characters", "\x01q", []dashboard.Key{"q"}),
+	)
+})
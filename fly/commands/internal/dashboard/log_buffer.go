@@ -0,0 +1,60 @@
+package dashboard
+
+import (
+	"strings"
+	"sync"
+)
+
+const maxLogLines = 10000
+
+// logBuffer collects the rendered output of a build so that the tail of it
+// can be drawn while the build is still streaming.
+type logBuffer struct {
+	lock    sync.Mutex
+	lines   []string
+	partial string
+}
+
+func newLogBuffer() *logBuffer {
+	return &logBuffer{}
+}
+
+func (buffer *logBuffer) Write(p []byte) (int, error) {
+	buffer.lock.Lock()
+	defer buffer.lock.Unlock()
+
+	lines := strings.Split(buffer.partial+string(p), "\n")
+	buffer.partial = lines[len(lines)-1]
+
+	for _, line := range lines[:len(lines)-1] {
+		// only keep what a carriage return would leave on the screen
+		if i := strings.LastIndex(strings.TrimSuffix(line, "\r"), "\r"); i != -1 {
+			line = line[i+1:]
+		}
+
+		buffer.lines = append(buffer.lines, strings.TrimSuffix(line, "\r"))
+	}
+
+	if len(buffer.lines) > maxLogLines {
+		buffer.lines = buffer.lines[len(buffer.lines)-maxLogLines:]
+	}
+
+	return len(p), nil
+}
+
+// Tail returns up to the last n lines, including any unterminated line.
+func (buffer *logBuffer) Tail(n int) []string {
+	buffer.lock.Lock()
+	defer buffer.lock.Unlock()
+
+	lines := buffer.lines
+	if buffer.partial != "" {
+		lines = append(lines[:len(lines):len(lines)], buffer.partial)
+	}
+
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return append([]string(nil), lines...)
+}
@@ -0,0 +1,286 @@
+package dashboard
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+const (
+	clearToEndOfLine   = "\x1b[K"
+	clearToEndOfScreen = "\x1b[J"
+	cursorHome         = "\x1b[H"
+	reverseVideo       = "\x1b[7m"
+	resetAttributes    = "\x1b[0m"
+)
+
+var helpText = map[View]string{
+	PipelinesView: "enter: jobs  r: refresh  q: quit",
+	JobsView:      "enter: build log  t: trigger  a: abort  v: resources  esc: back  q: quit",
+	ResourcesView: "enter: versions  u: unpin  esc: back  q: quit",
+	VersionsView:  "p: pin  u: unpin  esc: back  q: quit",
+	BuildView:     "a: abort  esc: back  q: quit",
+}
+
+// Render draws the whole screen. The header, breadcrumb, status and help
+// lines take four rows; the rest are left for the current view.
+func (d *Dashboard) Render(w io.Writer, width int, height int) error {
+	rows := height - 4
+	if rows < 1 {
+		rows = 1
+	}
+
+	lines := []string{
+		ui.Embolden("%s  team %s", d.client.URL(), d.team.Name()),
+		d.breadcrumb(),
+	}
+
+	body := d.body(rows)
+	for len(body) < rows {
+		body = append(body, "")
+	}
+	lines = append(lines, body...)
+
+	lines = append(lines, d.status, helpText[d.view])
+
+	var screen strings.Builder
+	screen.WriteString(cursorHome)
+	for i, line := range lines {
+		if i > 0 {
+			screen.WriteString("\r\n")
+		}
+
+		screen.WriteString(truncate(line, width))
+		screen.WriteString(clearToEndOfLine)
+	}
+	screen.WriteString(clearToEndOfScreen)
+
+	_, err := io.WriteString(w, screen.String())
+	return err
+}
+
+func (d *Dashboard) breadcrumb() string {
+	crumbs := []string{"pipelines"}
+
+	if d.view != PipelinesView {
+		crumbs = append(crumbs, d.pipeline.Ref().String())
+	}
+
+	switch d.view {
+	case ResourcesView:
+		crumbs = append(crumbs, "resources")
+	case VersionsView:
+		crumbs = append(crumbs, "resources", d.resource.Name)
+	case BuildView:
+		crumbs = append(crumbs, fmt.Sprintf("%s #%s", d.build.JobName, d.build.Name), statusColor(d.build.Status).Sprint(d.build.Status))
+	}
+
+	return strings.Join(crumbs, " > ")
+}
+
+func (d *Dashboard) body(rows int) []string {
+	var table [][]string
+	var colors []*color.Color
+
+	switch d.view {
+	case PipelinesView:
+		for _, pipeline := range d.pipelines {
+			status := pipelineStatus(pipeline, d.jobs)
+			table = append(table, []string{pipeline.Ref().String(), status, pausedLabel(pipeline.Paused)})
+			colors = append(colors, statusColor(atc.BuildStatus(status)))
+		}
+
+	case JobsView:
+		for _, job := range d.pipelineJobs() {
+			status := "n/a"
+			if job.FinishedBuild != nil {
+				status = string(job.FinishedBuild.Status)
+			}
+
+			running := ""
+			if job.NextBuild != nil {
+				running = fmt.Sprintf("#%s %s", job.NextBuild.Name, job.NextBuild.Status)
+			}
+
+			table = append(table, []string{job.Name, status, running, pausedLabel(job.Paused)})
+			colors = append(colors, statusColor(atc.BuildStatus(status)))
+		}
+
+	case ResourcesView:
+		for _, resource := range d.resources {
+			pinned := ""
+			if resource.PinnedVersion != nil {
+				pinned = "pinned " + ui.PresentVersion(resource.PinnedVersion)
+			}
+
+			table = append(table, []string{resource.Name, resource.Type, pinned})
+			colors = append(colors, nil)
+		}
+
+	case VersionsView:
+		for _, version := range d.versions {
+			enabled := "enabled"
+			if !version.Enabled {
+				enabled = "disabled"
+			}
+
+			pinned := ""
+			if d.resource.PinnedVersion != nil && equalVersions(d.resource.PinnedVersion, version.Version) {
+				pinned = "pinned"
+			}
+
+			table = append(table, []string{fmt.Sprintf("%d", version.ID), ui.PresentVersion(version.Version), enabled, pinned})
+			colors = append(colors, nil)
+		}
+
+	case BuildView:
+		if d.log == nil {
+			return nil
+		}
+
+		return d.log.Tail(rows)
+	}
+
+	return renderTable(table, colors, d.cursors[d.view], rows)
+}
+
+// renderTable pads the columns of each row, colors the second column with
+// the row's color, highlights the row under the cursor and scrolls so that
+// it stays visible.
+func renderTable(table [][]string, colors []*color.Color, cursor int, rows int) []string {
+	var widths []int
+	for _, row := range table {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	offset := 0
+	if cursor >= rows {
+		offset = cursor - rows + 1
+	}
+
+	var lines []string
+	for i := offset; i < len(table) && i < offset+rows; i++ {
+		var cells []string
+		for j, cell := range table[i] {
+			cells = append(cells, cell+strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell)))
+		}
+
+		line := strings.TrimRight(strings.Join(cells, "  "), " ")
+
+		if i == cursor {
+			line = reverseVideo + line + resetAttributes
+		} else if colors[i] != nil && len(cells) > 1 {
+			cells[1] = colors[i].Sprint(cells[1])
+			line = strings.TrimRight(strings.Join(cells, "  "), " ")
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// pipelineStatus summarizes the jobs of a pipeline: running if any of them
+// are, otherwise the worst status of their finished builds.
+func pipelineStatus(pipeline atc.Pipeline, jobs []atc.Job) string {
+	severity := map[atc.BuildStatus]int{
+		atc.StatusSucceeded: 1,
+		atc.StatusAborted:   2,
+		atc.StatusFailed:    3,
+		atc.StatusErrored:   4,
+	}
+
+	status := "n/a"
+	worst := 0
+	for _, job := range jobs {
+		if job.PipelineID != pipeline.ID {
+			continue
+		}
+
+		if job.NextBuild != nil {
+			return string(atc.StatusStarted)
+		}
+
+		if job.FinishedBuild != nil && severity[job.FinishedBuild.Status] > worst {
+			worst = severity[job.FinishedBuild.Status]
+			status = string(job.FinishedBuild.Status)
+		}
+	}
+
+	return status
+}
+
+func statusColor(status atc.BuildStatus) *color.Color {
+	switch status {
+	case atc.StatusPending, atc.StatusStarted, atc.StatusAwaitingApproval,
+		atc.StatusSucceeded, atc.StatusFailed, atc.StatusErrored, atc.StatusAborted:
+		return ui.BuildStatusCell(status).Color
+	}
+
+	return ui.PendingColor
+}
+
+func pausedLabel(paused bool) string {
+	if paused {
+		return "paused"
+	}
+
+	return ""
+}
+
+func equalVersions(a atc.Version, b atc.Version) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// truncate cuts a line down to the terminal width without counting, or
+// cutting through, ANSI escape sequences.
+func truncate(line string, width int) string {
+	var result strings.Builder
+
+	visible := 0
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			result.WriteRune(r)
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+				escaped = false
+			}
+		case r == '\x1b':
+			escaped = true
+			result.WriteRune(r)
+		case visible < width:
+			visible++
+			result.WriteRune(r)
+		}
+	}
+
+	if strings.ContainsRune(line, '\x1b') {
+		result.WriteString(resetAttributes)
+	}
+
+	return result.String()
+}
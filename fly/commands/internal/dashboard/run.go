@@ -0,0 +1,90 @@
+package dashboard
+
+import (
+	"io"
+	"time"
+)
+
+const redrawInterval = 250 * time.Millisecond
+
+// SizeFunc returns the current size of the terminal in rows and columns.
+type SizeFunc func() (int, int, error)
+
+// Run draws the dashboard and processes key presses from input until the
+// user quits or input is closed. Everything is refreshed every interval,
+// and jobs are refreshed as soon as a running build finishes.
+func (d *Dashboard) Run(input io.Reader, output io.Writer, size SizeFunc, interval time.Duration) error {
+	keys := make(chan Key)
+	inputErrs := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := input.Read(buf)
+			for _, key := range ParseKeys(buf[:n]) {
+				select {
+				case keys <- key:
+				case <-d.done:
+					return
+				}
+			}
+
+			if err != nil {
+				inputErrs <- err
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// build logs are written by their event stream in the background, so
+	// they are redrawn periodically rather than on each update
+	redraw := time.NewTicker(redrawInterval)
+	defer redraw.Stop()
+
+	d.Refresh()
+
+	dirty := true
+	for {
+		if dirty {
+			rows, cols, err := size()
+			if err != nil {
+				return err
+			}
+
+			err = d.Render(output, cols, rows)
+			if err != nil {
+				return err
+			}
+		}
+
+		dirty = true
+
+		select {
+		case key := <-keys:
+			if d.HandleKey(key) {
+				return nil
+			}
+
+		case update := <-d.updates:
+			update()
+
+		case <-ticker.C:
+			d.Refresh()
+
+		case <-redraw.C:
+			dirty = d.view == BuildView
+
+		case err := <-inputErrs:
+			d.closeLog()
+			close(d.done)
+
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package displayhelpers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestDisplayHelpers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Display Helpers Suite")
+}
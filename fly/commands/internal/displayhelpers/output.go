@@ -0,0 +1,214 @@
+package displayhelpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+type OutputFormat string
+
+const (
+	OutputTable    OutputFormat = "table"
+	OutputJSON     OutputFormat = "json"
+	OutputYAML     OutputFormat = "yaml"
+	OutputTemplate OutputFormat = "template"
+)
+
+// OutputOptions are the flags shared by commands which list things. Filters
+// and templates refer to fields by their JSON names, so they line up with
+// the JSON output.
+type OutputOptions struct {
+	Json   bool         `long:"json"    description:"Print command result as JSON"`
+	Output OutputFlag   `long:"output"  value-name:"json|yaml|table|template=TEMPLATE"  description:"Print command result in the given format; templates are executed for each result"`
+	Filter []FilterFlag `long:"filter"  value-name:"KEY=VALUE"  description:"Only print results whose field has the given value; nested fields are separated by '.'"`
+}
+
+type OutputFlag struct {
+	Format   OutputFormat
+	Template *template.Template
+}
+
+func (flag *OutputFlag) UnmarshalFlag(value string) error {
+	format := OutputFormat(value)
+
+	switch format {
+	case OutputTable, OutputJSON, OutputYAML:
+		flag.Format = format
+		return nil
+	}
+
+	if strings.HasPrefix(value, string(OutputTemplate)+"=") {
+		tmpl, err := template.New("output").Option("missingkey=zero").Parse(strings.TrimPrefix(value, string(OutputTemplate)+"="))
+		if err != nil {
+			return fmt.Errorf("invalid output template: %s", err)
+		}
+
+		flag.Format = OutputTemplate
+		flag.Template = tmpl
+		return nil
+	}
+
+	return fmt.Errorf("invalid output format '%s' (must be json, yaml, table or template=TEMPLATE)", value)
+}
+
+type FilterFlag struct {
+	Key   string
+	Value string
+}
+
+func (flag *FilterFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, "=", 2)
+	if len(vs) != 2 || vs[0] == "" {
+		return fmt.Errorf("invalid filter '%s' (must be key=value)", value)
+	}
+
+	flag.Key = vs[0]
+	flag.Value = vs[1]
+	return nil
+}
+
+func (options OutputOptions) format() OutputFormat {
+	if options.Output.Format != "" {
+		return options.Output.Format
+	}
+
+	if options.Json {
+		return OutputJSON
+	}
+
+	return OutputTable
+}
+
+// IsTable returns true if the command should print its own table.
+func (options OutputOptions) IsTable() bool {
+	return options.format() == OutputTable
+}
+
+// FilterItems returns the elements of the items slice which match every
+// filter, as a slice of the same type.
+func (options OutputOptions) FilterItems(items interface{}) (interface{}, error) {
+	if len(options.Filter) == 0 {
+		return items, nil
+	}
+
+	value := reflect.ValueOf(items)
+	filtered := reflect.MakeSlice(value.Type(), 0, value.Len())
+
+	for i := 0; i < value.Len(); i++ {
+		item := value.Index(i)
+
+		fields, err := jsonValue(item.Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		matches := true
+		for _, filter := range options.Filter {
+			if !matchField(fields, strings.Split(filter.Key, "."), filter.Value) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			filtered = reflect.Append(filtered, item)
+		}
+	}
+
+	return filtered.Interface(), nil
+}
+
+// Print prints the items in the selected format other than table.
+func (options OutputOptions) Print(items interface{}) error {
+	switch options.format() {
+	case OutputJSON:
+		return JsonPrint(items)
+
+	case OutputYAML:
+		payload, err := yaml.Marshal(items)
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(payload)
+		return err
+
+	case OutputTemplate:
+		value := reflect.ValueOf(items)
+		for i := 0; i < value.Len(); i++ {
+			fields, err := jsonValue(value.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+
+			err = options.Output.Template.Execute(os.Stdout, fields)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println()
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot print output format '%s'", options.format())
+}
+
+func jsonValue(item interface{}) (interface{}, error) {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var value interface{}
+	err = decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// matchField compares the field at the path to the expected value. Missing
+// fields match the empty string, and a list matches if any of its elements
+// do.
+func matchField(value interface{}, path []string, expected string) bool {
+	if list, ok := value.([]interface{}); ok {
+		for _, element := range list {
+			if matchField(element, path, expected) {
+				return true
+			}
+		}
+
+		return len(list) == 0 && expected == ""
+	}
+
+	if len(path) == 0 {
+		switch v := value.(type) {
+		case nil:
+			return expected == ""
+		case string:
+			return v == expected
+		default:
+			return fmt.Sprintf("%v", v) == expected
+		}
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return expected == ""
+	}
+
+	return matchField(object[path[0]], path[1:], expected)
+}
@@ -0,0 +1,128 @@
+package displayhelpers_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+)
+
+var _ = Describe("OutputOptions", func() {
+	Describe("OutputFlag", func() {
+		It("accepts the builtin formats", func() {
+			for _, format := range []string{"table", "json", "yaml"} {
+				flag := displayhelpers.OutputFlag{}
+				Expect(flag.UnmarshalFlag(format)).To(Succeed())
+				Expect(flag.Format).To(Equal(displayhelpers.OutputFormat(format)))
+			}
+		})
+
+		It("parses templates", func() {
+			flag := displayhelpers.OutputFlag{}
+			Expect(flag.UnmarshalFlag("template={{.name}} {{.missing}}")).To(Succeed())
+			Expect(flag.Format).To(Equal(displayhelpers.OutputTemplate))
+
+			buf := new(bytes.Buffer)
+			Expect(flag.Template.Execute(buf, map[string]interface{}{"name": "main"})).To(Succeed())
+			Expect(buf.String()).To(Equal("main <no value>"))
+		})
+
+		It("errors on invalid templates", func() {
+			flag := displayhelpers.OutputFlag{}
+			Expect(flag.UnmarshalFlag("template={{.name")).To(MatchError(HavePrefix("invalid output template:")))
+		})
+
+		It("errors on unknown formats", func() {
+			flag := displayhelpers.OutputFlag{}
+			Expect(flag.UnmarshalFlag("xml")).To(MatchError("invalid output format 'xml' (must be json, yaml, table or template=TEMPLATE)"))
+		})
+	})
+
+	Describe("FilterFlag", func() {
+		It("splits on the first '='", func() {
+			flag := displayhelpers.FilterFlag{}
+			Expect(flag.UnmarshalFlag("name=a=b")).To(Succeed())
+			Expect(flag).To(Equal(displayhelpers.FilterFlag{Key: "name", Value: "a=b"}))
+		})
+
+		It("errors without a key", func() {
+			flag := displayhelpers.FilterFlag{}
+			Expect(flag.UnmarshalFlag("=b")).To(MatchError("invalid filter '=b' (must be key=value)"))
+			Expect(flag.UnmarshalFlag("name")).To(MatchError("invalid filter 'name' (must be key=value)"))
+		})
+	})
+
+	Describe("IsTable", func() {
+		It("is true by default", func() {
+			Expect(displayhelpers.OutputOptions{}.IsTable()).To(BeTrue())
+		})
+
+		It("is false with --json", func() {
+			Expect(displayhelpers.OutputOptions{Json: true}.IsTable()).To(BeFalse())
+		})
+
+		It("prefers --output over --json", func() {
+			options := displayhelpers.OutputOptions{
+				Json:   true,
+				Output: displayhelpers.OutputFlag{Format: displayhelpers.OutputTable},
+			}
+			Expect(options.IsTable()).To(BeTrue())
+		})
+	})
+
+	Describe("FilterItems", func() {
+		var workers []atc.Worker
+
+		BeforeEach(func() {
+			workers = []atc.Worker{
+				{Name: "worker-1", Platform: "linux", State: "running", ActiveContainers: 3, Tags: []string{"gpu", "large"}},
+				{Name: "worker-2", Platform: "linux", State: "stalled", ResourceTypes: []atc.WorkerResourceType{{Type: "git"}}},
+				{Name: "worker-3", Platform: "windows", State: "running", Team: "main"},
+			}
+		})
+
+		filter := func(filters ...displayhelpers.FilterFlag) []atc.Worker {
+			filtered, err := displayhelpers.OutputOptions{Filter: filters}.FilterItems(workers)
+			Expect(err).NotTo(HaveOccurred())
+			return filtered.([]atc.Worker)
+		}
+
+		names := func(workers []atc.Worker) []string {
+			var names []string
+			for _, worker := range workers {
+				names = append(names, worker.Name)
+			}
+			return names
+		}
+
+		It("returns everything without filters", func() {
+			Expect(filter()).To(Equal(workers))
+		})
+
+		It("matches every filter", func() {
+			Expect(names(filter(
+				displayhelpers.FilterFlag{Key: "platform", Value: "linux"},
+				displayhelpers.FilterFlag{Key: "state", Value: "running"},
+			))).To(Equal([]string{"worker-1"}))
+		})
+
+		It("matches numbers by their string form", func() {
+			Expect(names(filter(displayhelpers.FilterFlag{Key: "active_containers", Value: "3"}))).To(Equal([]string{"worker-1"}))
+		})
+
+		It("matches lists if any element matches", func() {
+			Expect(names(filter(displayhelpers.FilterFlag{Key: "tags", Value: "gpu"}))).To(Equal([]string{"worker-1"}))
+		})
+
+		It("follows nested fields", func() {
+			Expect(names(filter(displayhelpers.FilterFlag{Key: "resource_types.type", Value: "git"}))).To(Equal([]string{"worker-2"}))
+		})
+
+		It("treats missing fields as empty", func() {
+			Expect(names(filter(displayhelpers.FilterFlag{Key: "team", Value: ""}))).To(Equal([]string{"worker-1", "worker-2"}))
+		})
+	})
+})
@@ -0,0 +1,226 @@
+package executehelpers
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/go-archive/tgzfs"
+	"github.com/vbauerster/mpb/v4"
+)
+
+// ManifestEntry records enough about a file to tell whether it has changed.
+type ManifestEntry struct {
+	Size    int64
+	ModTime time.Time
+	Mode    os.FileMode
+}
+
+// Manifest maps the slash-separated path of each file in an input, relative
+// to the input's directory, to its entry.
+type Manifest map[string]ManifestEntry
+
+// BuildManifest lists the files that would be uploaded for the given
+// directory, respecting .gitignore unless includeIgnored is set.
+func BuildManifest(dir string, includeIgnored bool) (Manifest, error) {
+	manifest := Manifest{}
+
+	for _, file := range getFiles(dir, includeIgnored) {
+		err := filepath.Walk(filepath.Join(dir, file), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					// removed while we were walking; the next scan will notice
+					return nil
+				}
+
+				return err
+			}
+
+			if info.IsDir() {
+				return nil
+			}
+
+			relative, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			manifest[filepath.ToSlash(relative)] = ManifestEntry{
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+				Mode:    info.Mode(),
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// Diff returns the files that were added or modified in newer, and the files
+// that no longer exist in it.
+func (manifest Manifest) Diff(newer Manifest) ([]string, []string) {
+	changed := []string{}
+	for path, entry := range newer {
+		old, found := manifest[path]
+		if !found || !old.ModTime.Equal(entry.ModTime) || old.Size != entry.Size || old.Mode != entry.Mode {
+			changed = append(changed, path)
+		}
+	}
+
+	deleted := []string{}
+	for path := range manifest {
+		if _, found := newer[path]; !found {
+			deleted = append(deleted, path)
+		}
+	}
+
+	sort.Strings(changed)
+	sort.Strings(deleted)
+
+	return changed, deleted
+}
+
+// WatchedInput is a local input that is kept in sync with an artifact on
+// the ATC.
+//
+// Changes are uploaded as a patch on top of the artifact that was last
+// uploaded in full, so only files that differ from it are sent. Deleting a
+// file can't be expressed as a patch, so it results in a full upload which
+// becomes the new base.
+type WatchedInput struct {
+	Name       string
+	Path       string
+	ArtifactID int
+
+	includeIgnored bool
+	platform       string
+	tags           []string
+
+	baseArtifactID int
+	baseManifest   Manifest
+
+	manifest Manifest
+	pending  Manifest
+}
+
+// WatchInputs starts watching every input that was uploaded from a local
+// directory.
+func WatchInputs(inputs []Input, includeIgnored bool, platform string, tags []string) ([]*WatchedInput, error) {
+	watched := []*WatchedInput{}
+
+	for _, input := range inputs {
+		if input.Path == "" || input.Plan.ArtifactInput == nil {
+			continue
+		}
+
+		manifest, err := BuildManifest(input.Path, includeIgnored)
+		if err != nil {
+			return nil, err
+		}
+
+		watched = append(watched, &WatchedInput{
+			Name:       input.Name,
+			Path:       input.Path,
+			ArtifactID: input.Plan.ArtifactInput.ArtifactID,
+
+			includeIgnored: includeIgnored,
+			platform:       platform,
+			tags:           tags,
+
+			baseArtifactID: input.Plan.ArtifactInput.ArtifactID,
+			baseManifest:   manifest,
+
+			manifest: manifest,
+		})
+	}
+
+	return watched, nil
+}
+
+// Poll scans the input and reports whether it changed since the last sync.
+func (input *WatchedInput) Poll() (bool, error) {
+	manifest, err := BuildManifest(input.Path, input.includeIgnored)
+	if err != nil {
+		return false, err
+	}
+
+	changed, deleted := input.manifest.Diff(manifest)
+	if len(changed) == 0 && len(deleted) == 0 {
+		input.pending = nil
+		return false, nil
+	}
+
+	input.pending = manifest
+
+	return true, nil
+}
+
+// Sync uploads the changes found by the last Poll.
+func (input *WatchedInput) Sync(bar *mpb.Bar, team concourse.Team) error {
+	if input.pending == nil {
+		return nil
+	}
+
+	changed, deleted := input.baseManifest.Diff(input.pending)
+
+	if len(changed) == 0 && len(deleted) == 0 {
+		// changes were reverted; the base artifact is up to date
+		input.ArtifactID = input.baseArtifactID
+		input.manifest = input.pending
+		input.pending = nil
+		return nil
+	}
+
+	if len(deleted) == 0 {
+		archiveStream, archiveWriter := io.Pipe()
+
+		go func() {
+			archiveWriter.CloseWithError(tgzfs.Compress(archiveWriter, input.Path, changed...))
+		}()
+
+		artifact, found, err := team.PatchArtifact(input.baseArtifactID, bar.ProxyReader(archiveStream))
+		archiveStream.Close()
+		if err != nil {
+			return err
+		}
+
+		if found {
+			input.ArtifactID = artifact.ID
+			input.manifest = input.pending
+			input.pending = nil
+			return nil
+		}
+
+		// the base artifact was garbage collected; start over
+	}
+
+	artifact, err := Upload(bar, team, input.Path, input.includeIgnored, input.platform, input.tags)
+	if err != nil {
+		return err
+	}
+
+	input.ArtifactID = artifact.ID
+	input.baseArtifactID = artifact.ID
+	input.baseManifest = input.pending
+	input.manifest = input.pending
+	input.pending = nil
+
+	return nil
+}
+
+// Plan returns a plan for fetching the input's latest artifact.
+func (input *WatchedInput) Plan(fact atc.PlanFactory) atc.Plan {
+	return fact.NewPlan(atc.ArtifactInputPlan{
+		ArtifactID: input.ArtifactID,
+		Name:       input.Name,
+	})
+}
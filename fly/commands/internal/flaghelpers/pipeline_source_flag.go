@@ -0,0 +1,58 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// PipelineSourceFlag is either a local pipeline config, given as
+// 'file:PATH', or a pipeline's config, given as
+// 'PIPELINE[/INSTANCE_VARS][@VERSION]' where VERSION is one of the
+// pipeline's past config versions.
+type PipelineSourceFlag struct {
+	Path     atc.PathFlag
+	Pipeline PipelineFlag
+	Version  int
+}
+
+func (flag *PipelineSourceFlag) UnmarshalFlag(value string) error {
+	if strings.HasPrefix(value, "file:") {
+		flag.Path = atc.PathFlag(strings.TrimPrefix(value, "file:"))
+		return nil
+	}
+
+	if i := strings.LastIndex(value, "@"); i != -1 {
+		version, err := strconv.Atoi(value[i+1:])
+		if err == nil {
+			flag.Version = version
+			value = value[:i]
+		}
+	}
+
+	err := flag.Pipeline.UnmarshalFlag(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = flag.Pipeline.Validate()
+	return err
+}
+
+func (flag PipelineSourceFlag) IsLocal() bool {
+	return flag.Path != ""
+}
+
+func (flag PipelineSourceFlag) String() string {
+	if flag.IsLocal() {
+		return string(flag.Path)
+	}
+
+	if flag.Version != 0 {
+		return fmt.Sprintf("%s@%d", flag.Pipeline.Ref().String(), flag.Version)
+	}
+
+	return flag.Pipeline.Ref().String()
+}
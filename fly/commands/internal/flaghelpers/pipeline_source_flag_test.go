@@ -0,0 +1,91 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineSourceFlag", func() {
+	var flag *PipelineSourceFlag
+
+	BeforeEach(func() {
+		flag = &PipelineSourceFlag{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		for _, tt := range []struct {
+			desc        string
+			flag        string
+			path        atc.PathFlag
+			pipelineRef atc.PipelineRef
+			version     int
+			str         string
+			err         string
+		}{
+			{
+				desc:        "pipeline",
+				flag:        "some-pipeline",
+				pipelineRef: atc.PipelineRef{Name: "some-pipeline"},
+				str:         "some-pipeline",
+			},
+			{
+				desc: "instance",
+				flag: "some-pipeline/branch:master",
+				pipelineRef: atc.PipelineRef{
+					Name:         "some-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "master"},
+				},
+				str: "some-pipeline/branch:master",
+			},
+			{
+				desc:        "version",
+				flag:        "some-pipeline@42",
+				pipelineRef: atc.PipelineRef{Name: "some-pipeline"},
+				version:     42,
+				str:         "some-pipeline@42",
+			},
+			{
+				desc: "instance with version",
+				flag: "some-pipeline/branch:master@42",
+				pipelineRef: atc.PipelineRef{
+					Name:         "some-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "master"},
+				},
+				version: 42,
+				str:     "some-pipeline/branch:master@42",
+			},
+			{
+				desc: "file",
+				flag: "file:ci/pipeline.yml",
+				path: "ci/pipeline.yml",
+				str:  "ci/pipeline.yml",
+			},
+			{
+				desc: "missing pipeline name",
+				flag: "@42",
+				err:  "pipeline: identifier cannot be an empty string",
+			},
+		} {
+			tt := tt
+
+			It(tt.desc, func() {
+				err := flag.UnmarshalFlag(tt.flag)
+				if tt.err != "" {
+					Expect(err).To(MatchError(tt.err))
+					return
+				}
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(flag.Path).To(Equal(tt.path))
+				Expect(flag.IsLocal()).To(Equal(tt.path != ""))
+				Expect(flag.Version).To(Equal(tt.version))
+				Expect(flag.String()).To(Equal(tt.str))
+				if !flag.IsLocal() {
+					Expect(flag.Pipeline.Ref()).To(Equal(tt.pipelineRef))
+				}
+			})
+		}
+	})
+})
@@ -3,9 +3,11 @@ package validatepipelinehelpers
 import (
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/concourse/concourse/atc"
 
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
@@ -13,7 +15,24 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
-func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool, enableAcrossStep bool) error {
+// LintOptions configures checking the pipeline against lint rules once it's
+// been validated.
+type LintOptions struct {
+	Settings configlint.Settings
+
+	// Team is the team to lint the pipeline as, for rules that depend on it.
+	Team string
+
+	// Format is one of "text", "json", or "sarif". With json or sarif, the
+	// report is written to stdout in place of the usual output.
+	Format string
+
+	// ConfigPath is the path of the pipeline config, referenced by SARIF
+	// reports.
+	ConfigPath string
+}
+
+func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool, enableAcrossStep bool, lint *LintOptions) error {
 	evaluatedTemplate, err := yamlTemplate.Evaluate(true, strict)
 	if err != nil {
 		return err
@@ -50,10 +69,48 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 		displayhelpers.ShowErrors("Error loading existing config", errorMessages)
 	}
 
+	var lintErrors, lintWarnings []configlint.Violation
+	if lint != nil && len(errorMessages) == 0 {
+		linter, err := configlint.NewLinter(lint.Settings)
+		if err != nil {
+			return err
+		}
+
+		violations := linter.Lint(lint.Team, unmarshalledTemplate)
+		lintErrors = configlint.Errors(violations)
+		lintWarnings = configlint.Warnings(violations)
+
+		switch lint.Format {
+		case "json":
+			err = configlint.WriteJSON(os.Stdout, violations)
+		case "sarif":
+			err = configlint.WriteSARIF(os.Stdout, lint.ConfigPath, violations)
+		default:
+			if len(lintWarnings) > 0 {
+				displayhelpers.ShowErrors("Lint warnings", violationMessages(lintWarnings))
+			}
+
+			if len(lintErrors) > 0 {
+				displayhelpers.ShowErrors("Lint errors", violationMessages(lintErrors))
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(errorMessages) > 0 || (strict && len(warnings) > 0) {
 		return errors.New("configuration invalid")
 	}
 
+	if len(lintErrors) > 0 || (strict && len(lintWarnings) > 0) {
+		return errors.New("configuration failed lint rules")
+	}
+
+	if lint != nil && lint.Format != "text" {
+		return nil
+	}
+
 	if output {
 		fmt.Println(string(evaluatedTemplate))
 	} else {
@@ -62,3 +119,12 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 
 	return nil
 }
+
+func violationMessages(violations []configlint.Violation) []string {
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = violation.String()
+	}
+
+	return messages
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/validatepipelinehelpers"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -133,32 +134,63 @@ jobs:
 		})
 
 		It("validates a good pipeline", func() {
-			err := validatepipelinehelpers.Validate(goodPipeline, false, false, false)
+			err := validatepipelinehelpers.Validate(goodPipeline, false, false, false, nil)
 			Expect(err).To(BeNil())
 		})
 		It("validates a good pipeline with strict", func() {
-			err := validatepipelinehelpers.Validate(goodPipeline, true, false, false)
+			err := validatepipelinehelpers.Validate(goodPipeline, true, false, false, nil)
 			Expect(err).To(BeNil())
 		})
 		It("validates a good pipeline with output", func() {
-			err := validatepipelinehelpers.Validate(goodPipeline, true, true, false)
+			err := validatepipelinehelpers.Validate(goodPipeline, true, true, false, nil)
 			Expect(err).To(BeNil())
 		})
 		It("do not fail validating a pipeline with repeated resource types (probably should but for compat doesn't)", func() {
-			err := validatepipelinehelpers.Validate(dupkeyPipeline, false, false, false)
+			err := validatepipelinehelpers.Validate(dupkeyPipeline, false, false, false, nil)
 			Expect(err).To(BeNil())
 		})
 		It("fail validating a pipeline with repeated resource types with strict", func() {
-			err := validatepipelinehelpers.Validate(dupkeyPipeline, true, false, false)
+			err := validatepipelinehelpers.Validate(dupkeyPipeline, true, false, false, nil)
 			Expect(err).ToNot(BeNil())
 		})
 		It("fail validating a pipeline using experimental `across` without the command flag enabling it", func() {
-			err := validatepipelinehelpers.Validate(goodAcrossPipeline, false, false, false)
+			err := validatepipelinehelpers.Validate(goodAcrossPipeline, false, false, false, nil)
 			Expect(err).ToNot(BeNil())
 		})
 		It("validates a pipeline using experimental `across` when the command flag enabling it is present", func() {
-			err := validatepipelinehelpers.Validate(goodAcrossPipeline, false, false, true)
+			err := validatepipelinehelpers.Validate(goodAcrossPipeline, false, false, true, nil)
 			Expect(err).To(BeNil())
 		})
+		It("validates a good pipeline with the default lint rules", func() {
+			err := validatepipelinehelpers.Validate(goodPipeline, true, false, false, &validatepipelinehelpers.LintOptions{Format: "text"})
+			Expect(err).To(BeNil())
+		})
+		It("fail validating a good pipeline which fails a lint rule with error severity", func() {
+			err := validatepipelinehelpers.Validate(goodPipeline, false, false, false, &validatepipelinehelpers.LintOptions{
+				Settings: configlint.Settings{
+					Rules: map[string]configlint.RuleSettings{
+						"job-on-failure": {Severity: configlint.SeverityError},
+					},
+				},
+				Format: "text",
+			})
+			Expect(err).To(MatchError("configuration failed lint rules"))
+		})
+		It("fail validating a good pipeline which fails a lint rule with warning severity only with strict", func() {
+			lint := &validatepipelinehelpers.LintOptions{
+				Settings: configlint.Settings{
+					Rules: map[string]configlint.RuleSettings{
+						"task-timeout": {Severity: configlint.SeverityWarning},
+					},
+				},
+				Format: "json",
+			}
+
+			err := validatepipelinehelpers.Validate(goodPipeline, false, false, false, lint)
+			Expect(err).To(BeNil())
+
+			err = validatepipelinehelpers.Validate(goodPipeline, true, false, false, lint)
+			Expect(err).To(MatchError("configuration failed lint rules"))
+		})
 	})
 })
@@ -14,8 +14,9 @@ import (
 
 type JobsCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
 	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *JobsCommand) Execute([]string) error {
@@ -49,12 +50,15 @@ func (command *JobsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(jobs)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(jobs)
+	if err != nil {
+		return err
+	}
+
+	jobs = filtered.([]atc.Job)
+
+	if !command.IsTable() {
+		return command.Print(jobs)
 	}
 
 	headers = []string{"name", "paused", "status", "next"}
@@ -24,15 +24,16 @@ import (
 )
 
 type LoginCommand struct {
-	ATCURL         string       `short:"c" long:"concourse-url" description:"Concourse URL to authenticate with"`
-	Insecure       bool         `short:"k" long:"insecure" description:"Skip verification of the endpoint's SSL certificate"`
-	Username       string       `short:"u" long:"username" description:"Username for basic auth"`
-	Password       string       `short:"p" long:"password" description:"Password for basic auth"`
-	TeamName       string       `short:"n" long:"team-name" description:"Team to authenticate with"`
-	CACert         atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
-	ClientCertPath atc.PathFlag `long:"client-cert" description:"Path to a PEM-encoded client certificate file."`
-	ClientKeyPath  atc.PathFlag `long:"client-key" description:"Path to a PEM-encoded client key file."`
-	OpenBrowser    bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	ATCURL          string       `short:"c" long:"concourse-url" description:"Concourse URL to authenticate with"`
+	Insecure        bool         `short:"k" long:"insecure" description:"Skip verification of the endpoint's SSL certificate"`
+	Username        string       `short:"u" long:"username" description:"Username for basic auth"`
+	Password        string       `short:"p" long:"password" description:"Password for basic auth"`
+	TeamName        string       `short:"n" long:"team-name" description:"Team to authenticate with"`
+	CACert          atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
+	ClientCertPath  atc.PathFlag `long:"client-cert" description:"Path to a PEM-encoded client certificate file."`
+	ClientKeyPath   atc.PathFlag `long:"client-key" description:"Path to a PEM-encoded client key file."`
+	OpenBrowser     bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	CredentialStore string       `long:"credential-store" value-name:"plaintext|encrypted|HELPER" description:"Where to store the token: in plain text in the .flyrc, encrypted with a passphrase, or with a fly-credential-HELPER executable (default: the target's current store). Only the encrypted store and helpers keep a refresh token, which is only issued when logging in with -u and -p"`
 
 	BrowserOnly bool
 }
@@ -100,6 +101,7 @@ func (command *LoginCommand) Execute(args []string) error {
 
 	var tokenType string
 	var tokenValue string
+	var refreshToken string
 
 	version, err := target.Version()
 	if err != nil {
@@ -121,6 +123,20 @@ func (command *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
+	credentialStore, err := command.credentialStore()
+	if err != nil {
+		return err
+	}
+
+	// ask for the passphrase of encrypted tokens up front, before the terminal
+	// is put into raw mode
+	if credentialStore == rc.EncryptedCredentialStore {
+		_, err = rc.ReadPassphrase(true)
+		if err != nil {
+			return err
+		}
+	}
+
 	isRawMode := pty.IsTerminal() && !command.BrowserOnly
 	if isRawMode {
 		state, err := terminal.MakeRaw(int(os.Stdin.Fd()))
@@ -139,7 +155,7 @@ func (command *LoginCommand) Execute(args []string) error {
 		tokenType, tokenValue, err = command.legacyAuth(target, command.BrowserOnly, isRawMode)
 	} else {
 		if command.Username != "" && command.Password != "" {
-			tokenType, tokenValue, err = command.passwordGrant(client, command.Username, command.Password)
+			tokenType, tokenValue, refreshToken, err = command.passwordGrant(client, command.Username, command.Password, rc.StoresRefreshTokens(credentialStore))
 		} else {
 			tokenType, tokenValue, err = command.authCodeGrant(client.URL(), command.BrowserOnly, isRawMode)
 		}
@@ -168,8 +184,9 @@ func (command *LoginCommand) Execute(args []string) error {
 	return command.saveTarget(
 		client.URL(),
 		&rc.TargetToken{
-			Type:  tokenType,
-			Value: tokenValue,
+			Type:         tokenType,
+			Value:        tokenValue,
+			RefreshToken: refreshToken,
 		},
 		target.CACert(),
 		target.ClientCertPath(),
@@ -177,23 +194,44 @@ func (command *LoginCommand) Execute(args []string) error {
 	)
 }
 
-func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string) (string, string, error) {
+// passwordGrant logs in with a username and password. A refresh token is only
+// asked for with offlineAccess, as it grants access until it is revoked.
+func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string, offlineAccess bool) (string, string, string, error) {
+	scopes := []string{"openid", "profile", "email", "federated:id", "groups"}
+	if offlineAccess {
+		scopes = append(scopes, "offline_access")
+	}
 
 	oauth2Config := oauth2.Config{
-		ClientID:     "fly",
-		ClientSecret: "Zmx5",
+		ClientID:     rc.OAuthClientID,
+		ClientSecret: rc.OAuthClientSecret,
 		Endpoint:     oauth2.Endpoint{TokenURL: client.URL() + "/sky/issuer/token"},
-		Scopes:       []string{"openid", "profile", "email", "federated:id", "groups"},
+		Scopes:       scopes,
 	}
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client.HTTPClient())
 
 	token, err := oauth2Config.PasswordCredentialsToken(ctx, username, password)
 	if err != nil {
-		return "", "", err
+		return "", "", "", err
+	}
+
+	return token.TokenType, token.AccessToken, token.RefreshToken, nil
+}
+
+// credentialStore returns the store the token will be saved to: the one given
+// with --credential-store, or else the target's current one.
+func (command *LoginCommand) credentialStore() (string, error) {
+	if command.CredentialStore != "" {
+		return command.CredentialStore, nil
+	}
+
+	targets, err := rc.LoadTargets()
+	if err != nil {
+		return "", err
 	}
 
-	return token.TokenType, token.AccessToken, nil
+	return targets[Fly.Target].CredentialStore, nil
 }
 
 func (command *LoginCommand) authCodeGrant(targetUrl string, browserOnly bool, isRawMode bool) (string, string, error) {
@@ -322,12 +360,14 @@ func (command *LoginCommand) saveTarget(url string, token *rc.TargetToken, caCer
 		command.Insecure,
 		command.TeamName,
 		&rc.TargetToken{
-			Type:  token.Type,
-			Value: token.Value,
+			Type:         token.Type,
+			Value:        token.Value,
+			RefreshToken: token.RefreshToken,
 		},
 		caCert,
 		clientCertPath,
 		clientKeyPath,
+		command.CredentialStore,
 	)
 	if err != nil {
 		return err
@@ -2,8 +2,10 @@ package commands
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -16,6 +18,7 @@ type PinResourceCommand struct {
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
 	Version  *atc.Version             `short:"v" long:"version" description:"Version of the resource to pin. The given key value pair(s) has to be an exact match but not all fields are needed. In the case of multiple resource versions matched, it will pin the latest one."`
 	Comment  string                   `short:"c" long:"comment" description:"Message to be saved to the pinned resource. Resource has to be pinned otherwise --version should be specified to pin the resource first."`
+	For      time.Duration            `long:"for" value-name:"DURATION" description:"Automatically unpin the version once this much time has passed (e.g. 2h). Requires --version."`
 }
 
 func (command *PinResourceCommand) Execute([]string) error {
@@ -50,13 +53,28 @@ func (command *PinResourceCommand) Execute([]string) error {
 		}
 	}
 
+	if command.For < 0 {
+		return errors.New("--for must be a positive duration")
+	}
+
+	if command.For != 0 && command.Version == nil {
+		return errors.New("--for can only be used when pinning a --version")
+	}
+
 	if command.Version != nil {
 		latestResourceVersion, err := GetLatestResourceVersion(team, command.Resource, *command.Version)
 		if err != nil {
 			return err
 		}
 
-		pinned, err := team.PinResourceVersion(pipelineRef, command.Resource.ResourceName, latestResourceVersion.ID)
+		var pinned bool
+		var expiresAt time.Time
+		if command.For != 0 {
+			expiresAt = time.Now().Add(command.For)
+			pinned, err = team.PinResourceVersionUntil(pipelineRef, command.Resource.ResourceName, latestResourceVersion.ID, expiresAt)
+		} else {
+			pinned, err = team.PinResourceVersion(pipelineRef, command.Resource.ResourceName, latestResourceVersion.ID)
+		}
 
 		if err != nil {
 			return err
@@ -69,6 +87,10 @@ func (command *PinResourceCommand) Execute([]string) error {
 			}
 
 			fmt.Printf("pinned '%s/%s' with version %s\n", pipelineRef.String(), command.Resource.ResourceName, string(versionBytes))
+
+			if !expiresAt.IsZero() {
+				fmt.Printf("the pin expires at %s\n", expiresAt.Format(timeDateLayout))
+			}
 		} else {
 			displayhelpers.Failf("could not pin '%s/%s', make sure the resource exists\n", pipelineRef.String(), command.Resource.ResourceName)
 		}
@@ -15,7 +15,8 @@ import (
 type PipelinesCommand struct {
 	All             bool `short:"a"  long:"all" description:"Show pipelines across all teams"`
 	IncludeArchived bool `long:"include-archived" description:"Show archived pipelines"`
-	Json            bool `long:"json" description:"Print command result as JSON"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *PipelinesCommand) Execute([]string) error {
@@ -43,12 +44,15 @@ func (command *PipelinesCommand) Execute([]string) error {
 	headers := command.buildHeader()
 	pipelines := command.filterPipelines(unfilteredPipelines)
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(pipelines)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(pipelines)
+	if err != nil {
+		return err
+	}
+
+	pipelines = filtered.([]atc.Pipeline)
+
+	if !command.IsTable() {
+		return command.Print(pipelines)
 	}
 
 	table := ui.Table{Headers: ui.TableRow{}}
@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/skymarshal/token"
+	"github.com/fatih/color"
+)
+
+const PluginPrefix = "fly-"
+
+// Plugin is a fly-<name> executable which fly runs in place of commands it
+// does not know.
+type Plugin struct {
+	Name string
+	Path string
+}
+
+// FindPlugins returns the plugins in the plugins directory followed by the
+// ones on the PATH. A plugin shadows any later plugins with the same name.
+func FindPlugins() []Plugin {
+	dirs := append([]string{rc.PluginsDir()}, filepath.SplitList(os.Getenv("PATH"))...)
+
+	seen := map[string]bool{}
+
+	var plugins []Plugin
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := pluginName(entry)
+			if !ok || seen[name] {
+				continue
+			}
+
+			seen[name] = true
+			plugins = append(plugins, Plugin{
+				Name: name,
+				Path: filepath.Join(dir, entry.Name()),
+			})
+		}
+	}
+
+	return plugins
+}
+
+// FindPlugin returns the plugin which fly runs for the command name.
+func FindPlugin(name string) (Plugin, bool) {
+	for _, plugin := range FindPlugins() {
+		if plugin.Name == name {
+			return plugin, true
+		}
+	}
+
+	return Plugin{}, false
+}
+
+func pluginName(entry os.FileInfo) (string, bool) {
+	if !strings.HasPrefix(entry.Name(), PluginPrefix) || !entry.Mode().IsRegular() {
+		return "", false
+	}
+
+	name := strings.TrimPrefix(entry.Name(), PluginPrefix)
+
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".exe" && ext != ".bat" && ext != ".cmd" {
+			return "", false
+		}
+
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	} else if entry.Mode().Perm()&0111 == 0 {
+		return "", false
+	}
+
+	return name, name != ""
+}
+
+// RunPlugin runs the plugin with the arguments given after its name. If a
+// target is selected, its URL, team and token are passed to the plugin as
+// FLY_TARGET_* environment variables, so that it can talk to Concourse
+// without reading the .flyrc itself.
+//
+// The plugin's exit status becomes fly's.
+func RunPlugin(plugin Plugin, args []string) error {
+	env := os.Environ()
+
+	if flyPath, err := os.Executable(); err == nil {
+		env = append(env, "FLY_BIN="+flyPath)
+	}
+
+	if Fly.Target != "" {
+		targetEnv, err := pluginTargetEnv()
+		if err != nil {
+			return err
+		}
+
+		env = append(env, targetEnv...)
+	}
+
+	cmd := exec.Command(plugin.Path, args...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		os.Exit(exitErr.ExitCode())
+	}
+
+	return err
+}
+
+func pluginTargetEnv() ([]string, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	targetToken := target.Token()
+	if targetToken == nil || targetToken.Value == "" {
+		return nil, concourse.ErrUnauthorized
+	}
+
+	expiry, err := token.Factory{}.ParseExpiry(targetToken.Value)
+	if err == nil && expiry.Before(time.Now()) {
+		return nil, concourse.ErrUnauthorized
+	}
+
+	env := []string{
+		"FLY_TARGET=" + string(Fly.Target),
+		"FLY_TARGET_URL=" + target.URL(),
+		"FLY_TARGET_TEAM=" + target.Team().Name(),
+		"FLY_TARGET_TOKEN=" + targetToken.Value,
+		"FLY_TARGET_TOKEN_TYPE=" + targetToken.Type,
+	}
+
+	if target.CACert() != "" {
+		env = append(env, "FLY_TARGET_CA_CERT="+target.CACert())
+	}
+
+	if target.TLSConfig().InsecureSkipVerify {
+		env = append(env, "FLY_TARGET_INSECURE=true")
+	}
+
+	return env, nil
+}
+
+type PluginsCommand struct{}
+
+func (command *PluginsCommand) Execute([]string) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "path", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, plugin := range FindPlugins() {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: plugin.Name},
+			{Contents: plugin.Path},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
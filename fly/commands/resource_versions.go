@@ -18,7 +18,8 @@ import (
 type ResourceVersionsCommand struct {
 	Count    int                      `short:"c" long:"count" default:"50" description:"Number of versions you want to limit the return to"`
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
@@ -41,12 +42,15 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(versions)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(versions)
+	if err != nil {
+		return err
+	}
+
+	versions = filtered.([]atc.ResourceVersion)
+
+	if !command.IsTable() {
+		return command.Print(versions)
 	}
 
 	table := ui.Table{
@@ -54,6 +58,7 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 			{Contents: "id", Color: color.New(color.Bold)},
 			{Contents: "version", Color: color.New(color.Bold)},
 			{Contents: "enabled", Color: color.New(color.Bold)},
+			{Contents: "labels", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -80,10 +85,24 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 
 		sort.Strings(fields)
 
+		var labelsCell ui.TableCell
+		if len(version.Annotations) == 0 {
+			labelsCell.Contents = "none"
+			labelsCell.Color = color.New(color.Faint)
+		} else {
+			labels := []string{}
+			for _, annotation := range version.Annotations {
+				labels = append(labels, annotation.Label)
+			}
+
+			labelsCell.Contents = strings.Join(labels, ",")
+		}
+
 		table.Data = append(table.Data, []ui.TableCell{
 			{Contents: strconv.Itoa(version.ID)},
 			{Contents: strings.Join(fields, ",")},
 			enabledCell,
+			labelsCell,
 		})
 	}
 
@@ -13,7 +13,8 @@ import (
 
 type ResourcesCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get resources in this pipeline"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *ResourcesCommand) Execute([]string) error {
@@ -35,12 +36,15 @@ func (command *ResourcesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(resources)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(resources)
+	if err != nil {
+		return err
+	}
+
+	resources = filtered.([]atc.Resource)
+
+	if !command.IsTable() {
+		return command.Print(resources)
 	}
 
 	headers = []string{"name", "type", "pinned", "check status"}
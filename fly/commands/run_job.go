@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/vars"
+)
+
+type RunJobCommand struct {
+	Job            flaghelpers.JobFlag                `short:"j" long:"job"         required:"true" value-name:"PIPELINE/JOB" description:"Name of the job to run"`
+	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"local-inputs" value-name:"NAME=PATH"   description:"A local directory to use in place of one of the job's get steps (can be specified multiple times)"`
+	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
+	Tags           []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for the workers to upload local inputs to (can be specified multiple times)"`
+	Var            []flaghelpers.VariablePairFlag     `short:"v" long:"var"         value-name:"[NAME=STRING]" unquote:"false" description:"Specify a string value to set for a variable in the job"`
+	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y" long:"yaml-var"    value-name:"[NAME=YAML]"   unquote:"false" description:"Specify a YAML value to set for a variable in the job"`
+}
+
+func (command *RunJobCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = executehelpers.CheckForInputType(command.Inputs)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := executehelpers.GenerateLocalInputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		target.Team(),
+		command.Inputs,
+		command.IncludeIgnored,
+		"",
+		command.Tags,
+	)
+	if err != nil {
+		return err
+	}
+
+	request := atc.RunJobRequest{
+		Artifacts: map[string]int{},
+	}
+
+	for name, input := range inputs {
+		request.Artifacts[name] = input.Plan.ArtifactInput.ArtifactID
+	}
+
+	var kvPairs vars.KVPairs
+	for _, v := range command.Var {
+		kvPairs = append(kvPairs, vars.KVPair(v))
+	}
+	for _, v := range command.YAMLVar {
+		kvPairs = append(kvPairs, vars.KVPair(v))
+	}
+
+	if len(kvPairs) != 0 {
+		request.Vars = kvPairs.Expand()
+	}
+
+	build, err := target.Team().RunJob(command.Job.PipelineRef, command.Job.JobName, request)
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	clientURL, err := url.Parse(client.URL())
+	if err != nil {
+		return err
+	}
+
+	buildURL, err := url.Parse(fmt.Sprintf("/builds/%d", build.ID))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("running %s/%s as build %d at %s\n", command.Job.PipelineRef.String(), command.Job.JobName, build.ID, clientURL.ResolveReference(buildURL))
+
+	terminate := make(chan os.Signal, 1)
+
+	go abortOnSignal(client, terminate, build)
+
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	eventSource, err := client.BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	exitCode := eventstream.Render(os.Stdout, eventSource, eventstream.RenderOptions{})
+	eventSource.Close()
+
+	os.Exit(exitCode)
+
+	return nil
+}
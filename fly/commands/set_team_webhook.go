@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetTeamWebhookCommand struct {
+	Name          string   `short:"n" long:"name" required:"true" description:"Name of the webhook"`
+	Type          string   `long:"type" required:"true" choice:"github" choice:"gitlab" choice:"bitbucket" description:"Service sending the payloads, which determines how they are verified"`
+	Secret        string   `long:"secret" required:"true" description:"Secret shared with the service to sign or authenticate payloads"`
+	ResourceTypes []string `long:"resource-type" value-name:"TYPE" description:"Only check resources of this type (can be specified multiple times)"`
+	Match         []string `long:"match" value-name:"PAYLOAD_FIELD=SOURCE_FIELD" description:"Check resources whose source field contains the payload field (can be specified multiple times)"`
+}
+
+func (command *SetTeamWebhookCommand) Execute([]string) error {
+	webhook := atc.TeamWebhook{
+		Name:          command.Name,
+		Type:          command.Type,
+		Secret:        command.Secret,
+		ResourceTypes: command.ResourceTypes,
+	}
+
+	for _, match := range command.Match {
+		vs := strings.SplitN(match, "=", 2)
+		if len(vs) != 2 || vs[0] == "" || vs[1] == "" {
+			return fmt.Errorf("invalid match '%s' (must be PAYLOAD_FIELD=SOURCE_FIELD)", match)
+		}
+
+		webhook.Match = append(webhook.Match, atc.WebhookMatch{
+			PayloadField: vs[0],
+			SourceField:  vs[1],
+		})
+	}
+
+	err := webhook.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	created, err := target.Team().SetWebhook(webhook)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		fmt.Printf("webhook `%s` created\n", webhook.Name)
+	} else {
+		fmt.Printf("webhook `%s` updated\n", webhook.Name)
+	}
+
+	fmt.Println()
+	fmt.Println("configure the service to send payloads to:")
+	fmt.Println()
+	fmt.Printf("  %s/api/v1/teams/%s/webhooks/%s\n",
+		target.URL(),
+		url.PathEscape(target.Team().Name()),
+		url.PathEscape(webhook.Name),
+	)
+
+	return nil
+}
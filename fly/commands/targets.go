@@ -29,7 +29,14 @@ func (command *TargetsCommand) Execute([]string) error {
 	}
 
 	for targetName, targetValues := range targets {
-		expirationTime := getExpirationFromString(targetValues.Token)
+		var expirationTime string
+
+		targetToken, err := rc.LoadToken(targetName, targetValues)
+		if err != nil {
+			expirationTime = "n/a: could not load token"
+		} else {
+			expirationTime = getExpirationFromString(targetToken)
+		}
 
 		row := ui.TableRow{
 			{Contents: string(targetName)},
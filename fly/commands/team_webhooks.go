@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TeamWebhooksCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TeamWebhooksCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := target.Team().ListWebhooks()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(webhooks)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "type", Color: color.New(color.Bold)},
+			{Contents: "resource types", Color: color.New(color.Bold)},
+			{Contents: "match", Color: color.New(color.Bold)},
+		},
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].Name < webhooks[j].Name
+	})
+
+	for _, webhook := range webhooks {
+		resourceTypesCell := ui.TableCell{Contents: strings.Join(webhook.ResourceTypes, ",")}
+		if len(webhook.ResourceTypes) == 0 {
+			resourceTypesCell = ui.TableCell{Contents: "all", Color: color.New(color.Faint)}
+		}
+
+		var matches []string
+		for _, match := range webhook.MatchRules() {
+			matches = append(matches, fmt.Sprintf("%s=%s", match.PayloadField, match.SourceField))
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: webhook.Name},
+			{Contents: webhook.Type},
+			resourceTypesCell,
+			{Contents: strings.Join(matches, ",")},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
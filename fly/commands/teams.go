@@ -7,6 +7,7 @@ import (
 
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -14,8 +15,9 @@ import (
 )
 
 type TeamsCommand struct {
-	Json    bool `long:"json" description:"Print command result as JSON"`
 	Details bool `short:"d" long:"details" description:"Print authentication configuration"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *TeamsCommand) Execute([]string) error {
@@ -34,12 +36,15 @@ func (command *TeamsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(teams)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(teams)
+	if err != nil {
+		return err
+	}
+
+	teams = filtered.([]atc.Team)
+
+	if !command.IsTable() {
+		return command.Print(teams)
 	}
 
 	var headers ui.TableRow
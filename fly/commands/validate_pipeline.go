@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"errors"
+
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configlint"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/validatepipelinehelpers"
@@ -26,9 +29,36 @@ type ValidatePipelineCommand struct {
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  unquote:"false"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
 	VarsFrom []atc.PathFlag `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Lint       bool         `long:"lint"                                            description:"Check the pipeline against lint rules"`
+	LintRules  atc.PathFlag `long:"lint-rules"                                      description:"File configuring the severity and options of lint rules (implies --lint)"`
+	LintTeam   string       `long:"lint-team"                                       description:"Team to lint the pipeline as, for rules that depend on the team"`
+	LintFormat string       `long:"lint-format" default:"text" choice:"text" choice:"json" choice:"sarif" description:"Format to report lint violations in"`
 }
 
 func (command *ValidatePipelineCommand) Execute(args []string) error {
+	var lint *validatepipelinehelpers.LintOptions
+	if command.Lint || command.LintRules != "" {
+		if command.Output && command.LintFormat != "text" {
+			return errors.New("--output cannot be used with a --lint-format of json or sarif")
+		}
+
+		lint = &validatepipelinehelpers.LintOptions{
+			Team:       command.LintTeam,
+			Format:     command.LintFormat,
+			ConfigPath: string(command.Config),
+		}
+
+		if command.LintRules != "" {
+			settings, err := configlint.LoadSettings(string(command.LintRules))
+			if err != nil {
+				return err
+			}
+
+			lint.Settings = settings
+		}
+	}
+
 	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar, nil)
-	return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output, command.EnableAcrossStep)
+	return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output, command.EnableAcrossStep, lint)
 }
@@ -17,7 +17,8 @@ import (
 
 type VolumesCommand struct {
 	Details bool `short:"d" long:"details" description:"Print additional information for each volume"`
-	Json    bool `long:"json" description:"Print command result as JSON"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *VolumesCommand) Execute([]string) error {
@@ -36,12 +37,15 @@ func (command *VolumesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(volumes)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(volumes)
+	if err != nil {
+		return err
+	}
+
+	volumes = filtered.([]atc.Volume)
+
+	if !command.IsTable() {
+		return command.Print(volumes)
 	}
 
 	table := ui.Table{
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhookDeliveriesCommand struct {
+	Name  string `short:"n" long:"name" required:"true" description:"Name of the webhook"`
+	Count int    `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json  bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WebhookDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deliveries, found, err := target.Team().WebhookDeliveries(command.Name, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("webhook `%s` does not exist", command.Name)
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(deliveries)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "received", Color: color.New(color.Bold)},
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+			{Contents: "checked resources", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		statusCell := ui.TableCell{Contents: strconv.Itoa(delivery.StatusCode)}
+		if delivery.StatusCode >= 400 {
+			statusCell.Color = ui.ErroredColor
+		} else {
+			statusCell.Color = ui.SucceededColor
+		}
+
+		eventCell := ui.TableCell{Contents: delivery.Event}
+		if delivery.Event == "" {
+			eventCell = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		errorCell := ui.TableCell{Contents: delivery.Error}
+		if delivery.Error == "" {
+			errorCell = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: time.Unix(delivery.ReceivedAt, 0).Format(timeDateLayout)},
+			eventCell,
+			statusCell,
+			errorCell,
+			presentWebhookResources(delivery.CheckedResources),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// presentWebhookResources lists the resources checked by a delivery, noting
+// the ones whose check could not be created.
+func presentWebhookResources(resources []atc.WebhookDeliveryResource) ui.TableCell {
+	if len(resources) == 0 {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	var names []string
+	for _, resource := range resources {
+		ref := atc.PipelineRef{Name: resource.PipelineName, InstanceVars: resource.PipelineInstanceVars}
+		name := fmt.Sprintf("%s/%s", ref.String(), resource.Resource)
+		if resource.Error != "" {
+			name += " (errored)"
+		}
+
+		names = append(names, name)
+	}
+
+	return ui.TableCell{Contents: strings.Join(names, ",")}
+}
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+// how often to check whether the worker has reported its diagnostics yet
+var workerDiagnosticsPollInterval = time.Second
+
+type WorkerDiagnosticsCommand struct {
+	Worker  flaghelpers.WorkerFlag `short:"w" long:"worker" required:"true" description:"Worker to collect diagnostics from"`
+	Timeout time.Duration          `long:"timeout" default:"2m" description:"How long to wait for the worker to report its diagnostics"`
+	JSON    bool                   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WorkerDiagnosticsCommand) Execute(args []string) error {
+	workerName := command.Worker.Name()
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	err = client.RequestWorkerDiagnostics(workerName)
+	if err != nil {
+		return err
+	}
+
+	if !command.JSON {
+		fmt.Fprintf(ui.Stderr, "waiting for '%s' to report diagnostics...\n", workerName)
+	}
+
+	deadline := time.Now().Add(command.Timeout)
+
+	var diagnostics atc.WorkerDiagnostics
+	for {
+		var ready bool
+		diagnostics, ready, err = client.WorkerDiagnostics(workerName)
+		if err != nil {
+			return err
+		}
+
+		if ready {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for the worker to report diagnostics; is it running?")
+		}
+
+		time.Sleep(workerDiagnosticsPollInterval)
+	}
+
+	if command.JSON {
+		return displayhelpers.JsonPrint(diagnostics)
+	}
+
+	return command.render(workerName, diagnostics)
+}
+
+func (command *WorkerDiagnosticsCommand) render(workerName string, diagnostics atc.WorkerDiagnostics) error {
+	runtime := diagnostics.Runtime
+	if diagnostics.RuntimeVersion != "" {
+		runtime += " (" + diagnostics.RuntimeVersion + ")"
+	}
+
+	fmt.Printf("worker:       %s\n", workerName)
+	fmt.Printf("collected at: %s\n", time.Unix(diagnostics.CollectedAt, 0).Format(time.RFC1123))
+	fmt.Printf("version:      %s\n", diagnostics.Version)
+	fmt.Printf("runtime:      %s\n", runtime)
+
+	if diagnostics.Disk != nil {
+		fmt.Printf("disk:         %s free of %s (%s)\n",
+			formatBytes(diagnostics.Disk.FreeBytes),
+			formatBytes(diagnostics.Disk.TotalBytes),
+			diagnostics.Disk.Path,
+		)
+	}
+
+	fmt.Println()
+
+	containers := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "container", Color: color.New(color.Bold)},
+			{Contents: "state", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, c := range diagnostics.Containers {
+		containers.Data = append(containers.Data, ui.TableRow{
+			{Contents: c.Handle},
+			{Contents: c.State},
+		})
+	}
+
+	err := containers.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	volumes := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "volume", Color: color.New(color.Bold)},
+			{Contents: "path", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, v := range diagnostics.Volumes {
+		volumes.Data = append(volumes.Data, ui.TableRow{
+			{Contents: v.Handle},
+			{Contents: v.Path},
+		})
+	}
+
+	err = volumes.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if len(diagnostics.Errors) > 0 {
+		fmt.Println()
+		fmt.Println(ui.WarningColor("errors:"))
+
+		for _, e := range diagnostics.Errors {
+			fmt.Println("  " + e)
+		}
+	}
+
+	if len(diagnostics.Logs) > 0 {
+		fmt.Println()
+		fmt.Println("recent logs:")
+
+		for _, line := range diagnostics.Logs {
+			fmt.Println(line)
+		}
+	}
+
+	return nil
+}
@@ -17,7 +17,8 @@ import (
 
 type WorkersCommand struct {
 	Details bool `short:"d" long:"details" description:"Print additional information for each worker"`
-	Json    bool `long:"json" description:"Print command result as JSON"`
+
+	displayhelpers.OutputOptions
 }
 
 func (command *WorkersCommand) Execute([]string) error {
@@ -36,12 +37,15 @@ func (command *WorkersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(workers)
-		if err != nil {
-			return err
-		}
-		return nil
+	filtered, err := command.FilterItems(workers)
+	if err != nil {
+		return err
+	}
+
+	workers = filtered.([]atc.Worker)
+
+	if !command.IsTable() {
+		return command.Print(workers)
 	}
 
 	sort.Sort(byWorkerName(workers))
@@ -132,6 +136,11 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			ui.TableCell{Contents: "baggageclaim url", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "active tasks", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "resource types", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "cpu load", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "memory", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "free disk", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "open files", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "stalled reason", Color: color.New(color.Bold)},
 		)
 	}
 
@@ -159,6 +168,8 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			row = append(row, stringOrDefault(w.BaggageclaimURL))
 			row = append(row, stringOrDefault(strconv.Itoa(w.ActiveTasks)))
 			row = append(row, stringOrDefault(strings.Join(resourceTypes, ", ")))
+			row = append(row, w.resourcePressureCells()...)
+			row = append(row, stringOrDefault(w.StalledReason))
 		}
 
 		table.Data = append(table.Data, row)
@@ -195,6 +206,40 @@ func (w *worker) versionCell() ui.TableCell {
 	return column
 }
 
+func (w *worker) resourcePressureCells() []ui.TableCell {
+	pressure := w.ResourcePressure
+	if pressure == nil {
+		return []ui.TableCell{
+			stringOrDefault(""),
+			stringOrDefault(""),
+			stringOrDefault(""),
+			stringOrDefault(""),
+		}
+	}
+
+	return []ui.TableCell{
+		{Contents: strconv.FormatFloat(pressure.CPULoad, 'f', 2, 64)},
+		{Contents: fmt.Sprintf("%.0f%%", pressure.MemoryPressure*100)},
+		{Contents: formatBytes(pressure.FreeDiskBytes)},
+		{Contents: strconv.Itoa(pressure.OpenFiles)},
+	}
+}
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
 func (w *worker) ageCell() ui.TableCell {
 	var column ui.TableCell
 
@@ -0,0 +1,160 @@
+package eventstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
+)
+
+// stepTypes are the keys of public build plans whose steps have a name.
+var stepTypes = []string{
+	"get",
+	"dependent_get",
+	"put",
+	"check",
+	"task",
+	"set_pipeline",
+	"load_var",
+	"approval",
+}
+
+// StepPlanIDs returns the IDs of every step in the build plan with the given
+// name, which are the origin IDs of the events the steps emit.
+func StepPlanIDs(plan atc.PublicBuildPlan, step string) (map[event.OriginID]bool, error) {
+	if plan.Plan == nil {
+		return nil, fmt.Errorf("step '%s' not found in build plan", step)
+	}
+
+	var tree interface{}
+	err := json.Unmarshal(*plan.Plan, &tree)
+	if err != nil {
+		return nil, fmt.Errorf("malformed build plan: %s", err)
+	}
+
+	ids := map[event.OriginID]bool{}
+	collectStepPlanIDs(tree, step, ids)
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("step '%s' not found in build plan", step)
+	}
+
+	return ids, nil
+}
+
+func collectStepPlanIDs(tree interface{}, step string, ids map[event.OriginID]bool) {
+	switch node := tree.(type) {
+	case map[string]interface{}:
+		if id, ok := node["id"].(string); ok {
+			for _, stepType := range stepTypes {
+				config, ok := node[stepType].(map[string]interface{})
+				if ok && config["name"] == step {
+					ids[event.OriginID(id)] = true
+				}
+			}
+		}
+
+		for _, child := range node {
+			collectStepPlanIDs(child, step, ids)
+		}
+
+	case []interface{}:
+		for _, child := range node {
+			collectStepPlanIDs(child, step, ids)
+		}
+	}
+}
+
+// FilterOrigins wraps an event stream so that it only yields events emitted
+// by one of the given origins.
+func FilterOrigins(src eventstream.EventStream, ids map[event.OriginID]bool) eventstream.EventStream {
+	return &originFilter{EventStream: src, ids: ids}
+}
+
+type originFilter struct {
+	eventstream.EventStream
+
+	ids map[event.OriginID]bool
+}
+
+func (filter *originFilter) NextEvent() (atc.Event, error) {
+	for {
+		ev, err := filter.EventStream.NextEvent()
+		if err != nil {
+			return nil, err
+		}
+
+		origin, err := eventOrigin(ev)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.ids[origin.ID] {
+			return ev, nil
+		}
+	}
+}
+
+// eventOrigin extracts the origin shared by most event types, returning the
+// zero value for events such as status changes which do not have one.
+func eventOrigin(ev atc.Event) (event.Origin, error) {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return event.Origin{}, err
+	}
+
+	var withOrigin struct {
+		Origin event.Origin `json:"origin"`
+	}
+
+	err = json.Unmarshal(payload, &withOrigin)
+	if err != nil {
+		return event.Origin{}, err
+	}
+
+	return withOrigin.Origin, nil
+}
+
+// WriteNDJSON writes each event as a JSON envelope on its own line.
+func WriteNDJSON(dst io.Writer, src eventstream.EventStream) error {
+	encoder := json.NewEncoder(dst)
+
+	for {
+		ev, err := src.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(event.Message{Event: ev})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// WriteJSON writes all of the events as a JSON array of envelopes.
+func WriteJSON(dst io.Writer, src eventstream.EventStream) error {
+	messages := []event.Message{}
+
+	for {
+		ev, err := src.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		messages = append(messages, event.Message{Event: ev})
+	}
+
+	return json.NewEncoder(dst).Encode(messages)
+}
@@ -0,0 +1,108 @@
+package eventstream_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream/eventstreamfakes"
+)
+
+var _ = Describe("Exporting", func() {
+	var stream *eventstreamfakes.FakeEventStream
+
+	BeforeEach(func() {
+		stream = new(eventstreamfakes.FakeEventStream)
+		stream.NextEventReturnsOnCall(0, event.Status{Status: atc.StatusStarted, Time: 1}, nil)
+		stream.NextEventReturnsOnCall(1, event.Log{Origin: event.Origin{ID: "a"}, Payload: "from a\n", Time: 2}, nil)
+		stream.NextEventReturnsOnCall(2, event.Log{Origin: event.Origin{ID: "b"}, Payload: "from b\n", Time: 3}, nil)
+		stream.NextEventReturnsOnCall(3, event.FinishTask{Origin: event.Origin{ID: "b"}, ExitStatus: 1, Time: 4}, nil)
+		stream.NextEventReturns(nil, io.EOF)
+	})
+
+	Describe("StepPlanIDs", func() {
+		plan := func(payload string) atc.PublicBuildPlan {
+			raw := json.RawMessage(payload)
+			return atc.PublicBuildPlan{Schema: "exec.v2", Plan: &raw}
+		}
+
+		It("finds every step with the name", func() {
+			ids, err := eventstream.StepPlanIDs(plan(`{
+				"id": "1",
+				"do": [
+					{"id": "2", "get": {"name": "repo", "type": "git"}},
+					{"id": "3", "on_failure": {
+						"step": {"id": "4", "task": {"name": "unit"}},
+						"on_failure": {"id": "5", "task": {"name": "notify"}}
+					}},
+					{"id": "6", "retry": [{"id": "7", "task": {"name": "unit"}}]}
+				]
+			}`), "unit")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal(map[event.OriginID]bool{"4": true, "7": true}))
+		})
+
+		It("errors when no step has the name", func() {
+			_, err := eventstream.StepPlanIDs(plan(`{"id": "1", "task": {"name": "unit"}}`), "lint")
+			Expect(err).To(MatchError("step 'lint' not found in build plan"))
+		})
+	})
+
+	Describe("FilterOrigins", func() {
+		It("only yields events from the origins", func() {
+			filtered := eventstream.FilterOrigins(stream, map[event.OriginID]bool{"b": true})
+
+			ev, err := filtered.NextEvent()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev).To(Equal(event.Log{Origin: event.Origin{ID: "b"}, Payload: "from b\n", Time: 3}))
+
+			ev, err = filtered.NextEvent()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev).To(Equal(event.FinishTask{Origin: event.Origin{ID: "b"}, ExitStatus: 1, Time: 4}))
+
+			_, err = filtered.NextEvent()
+			Expect(err).To(Equal(io.EOF))
+		})
+	})
+
+	Describe("WriteNDJSON", func() {
+		It("writes one envelope per line", func() {
+			out := gbytes.NewBuffer()
+			err := eventstream.WriteNDJSON(out, stream)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(out).To(gbytes.Say(`{"data":{"status":"started","time":1},"event":"status","version":"1.0"}\n`))
+			Expect(out).To(gbytes.Say(`{"data":{"time":2,"origin":{"id":"a"},"payload":"from a\\n"},"event":"log","version":"5.1"}\n`))
+		})
+
+		It("returns errors from the stream", func() {
+			stream.NextEventReturnsOnCall(1, nil, errors.New("nope"))
+
+			err := eventstream.WriteNDJSON(gbytes.NewBuffer(), stream)
+			Expect(err).To(MatchError("nope"))
+		})
+	})
+
+	Describe("WriteJSON", func() {
+		It("writes an array of envelopes", func() {
+			out := gbytes.NewBuffer()
+			err := eventstream.WriteJSON(out, stream)
+			Expect(err).NotTo(HaveOccurred())
+
+			var envelopes []event.Envelope
+			err = json.Unmarshal(out.Contents(), &envelopes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelopes).To(HaveLen(4))
+			Expect(envelopes[0].Event).To(Equal(event.EventTypeStatus))
+			Expect(envelopes[3].Event).To(Equal(event.EventTypeFinishTask))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("annotate-version", func() {
+		var (
+			listVersionsPath = "/api/v1/teams/main/pipelines/pipeline/resources/resource/versions"
+			annotationPath   = "/api/v1/teams/main/pipelines/pipeline/resources/resource/versions/42/annotations/"
+			expectedQuery    = "vars.branch=%22master%22"
+			pipelineResource = "pipeline/branch:master/resource"
+			versionToLabel   = atc.ResourceVersion{
+				ID:      42,
+				Version: atc.Version{"some": "value"},
+			}
+		)
+
+		It("is a subcommand", func() {
+			flyCmd := exec.Command(flyPath, "annotate-version")
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).ToNot(HaveOccurred())
+
+			Consistently(sess.Err).ShouldNot(gbytes.Say("error: Unknown command"))
+
+			<-sess.Exited
+		})
+
+		It("rejects invalid labels without contacting the server", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "annotate-version", "-r", pipelineResource, "-v", "some:value", "-l", "QA approved")
+
+			Expect(func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("'QA approved' is not a valid version label"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(0))
+		})
+
+		Context("when the version exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", listVersionsPath, expectedQuery+"&filter=some:value"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ResourceVersion{versionToLabel}),
+					),
+				)
+			})
+
+			It("labels the version with each label", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", annotationPath+"qa-approved", expectedQuery),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", annotationPath+"canary-ok", expectedQuery),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "annotate-version", "-r", pipelineResource, "-v", "some:value", "-l", "qa-approved", "-l", "canary-ok")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`labelled 'pipeline/branch:master/resource' version {"some":"value"} with 'qa-approved'`))
+				Expect(sess.Out).To(gbytes.Say(`labelled 'pipeline/branch:master/resource' version {"some":"value"} with 'canary-ok'`))
+			})
+
+			It("removes labels with --remove", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", annotationPath+"qa-approved", expectedQuery),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "annotate-version", "-r", pipelineResource, "-v", "some:value", "-l", "qa-approved", "--remove")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`removed label 'qa-approved' from 'pipeline/branch:master/resource' version {"some":"value"}`))
+			})
+
+			It("fails when the version disappears before labelling", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", annotationPath+"qa-approved", expectedQuery),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "annotate-version", "-r", pipelineResource, "-v", "some:value", "-l", "qa-approved")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("could not annotate 'pipeline/branch:master/resource', make sure the resource version exists"))
+			})
+		})
+	})
+})
@@ -0,0 +1,174 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("apply", func() {
+		var (
+			tmpdir       string
+			manifestPath string
+			config       atc.Config
+
+			saved    []string
+			archived []string
+			ordering []string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-apply")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(tmpdir, "pipeline.yml"), []byte(`
+resources:
+- name: some-resource
+  type: git
+  source:
+    branch: ((branch))
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifestPath = filepath.Join(tmpdir, "manifest.yml")
+			err = ioutil.WriteFile(manifestPath, []byte(`
+pipelines:
+- name: existing-pipeline
+  config: pipeline.yml
+  vars: {branch: master}
+- name: new-pipeline
+  config: pipeline.yml
+  vars: {branch: develop}
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-resource",
+						Type:   "git",
+						Source: atc.Source{"branch": "master"},
+					},
+				},
+			}
+
+			saved = nil
+			archived = nil
+			ordering = nil
+
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Pipeline{
+					{Name: "old-pipeline", TeamName: "main"},
+					{Name: "existing-pipeline", TeamName: "main"},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/existing-pipeline/config",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: config}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+			)
+			atcServer.RouteToHandler("PUT", "/api/v1/teams/main/pipelines/new-pipeline/config",
+				func(w http.ResponseWriter, r *http.Request) {
+					saved = append(saved, "new-pipeline")
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{}`))
+				},
+			)
+			atcServer.RouteToHandler("PUT", "/api/v1/teams/main/pipelines/old-pipeline/archive",
+				func(w http.ResponseWriter, r *http.Request) {
+					archived = append(archived, "old-pipeline")
+				},
+			)
+			atcServer.RouteToHandler("PUT", "/api/v1/teams/main/pipelines/ordering",
+				func(w http.ResponseWriter, r *http.Request) {
+					err := json.NewDecoder(r.Body).Decode(&ordering)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		It("shows the plan without applying it on a dry run", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "apply", "-f", manifestPath, "--dry-run")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out).To(gbytes.Say("create pipeline new-pipeline"))
+			Expect(sess.Out).To(gbytes.Say("archive pipeline old-pipeline"))
+			Expect(sess.Out).To(gbytes.Say("order pipelines existing-pipeline, new-pipeline"))
+			Expect(sess.Out).To(gbytes.Say("plan: 1 to create, 0 to update, 1 to archive"))
+
+			Expect(saved).To(BeEmpty())
+			Expect(archived).To(BeEmpty())
+			Expect(ordering).To(BeEmpty())
+		})
+
+		It("applies the plan", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "apply", "-f", manifestPath, "-n")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out).To(gbytes.Say("created pipeline new-pipeline"))
+			Expect(sess.Out).To(gbytes.Say("archived pipeline old-pipeline"))
+			Expect(sess.Out).To(gbytes.Say("ordered pipelines"))
+
+			Expect(saved).To(Equal([]string{"new-pipeline"}))
+			Expect(archived).To(Equal([]string{"old-pipeline"}))
+			Expect(ordering).To(Equal([]string{"existing-pipeline", "new-pipeline"}))
+		})
+
+		It("does nothing when the team matches the manifest", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "apply", "-f", manifestPath, "-n", "--skip-archive")
+
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Pipeline{
+					{Name: "existing-pipeline", TeamName: "main"},
+					{Name: "new-pipeline", TeamName: "main"},
+					{Name: "old-pipeline", TeamName: "main"},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/new-pipeline/config",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-resource",
+							Type:   "git",
+							Source: atc.Source{"branch": "develop"},
+						},
+					},
+				}}, http.Header{atc.ConfigVersionHeader: {"1"}}),
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+			Expect(saved).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("ApproveBuild", func() {
+	var expectedApprovalURL = "/api/v1/builds/23/approval"
+
+	var expectedBuild = atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "awaiting_approval",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/23",
+	}
+
+	Context("when the build id is specified", func() {
+		var (
+			approvalStatus   int
+			expectedApproval atc.BuildApproval
+		)
+
+		BeforeEach(func() {
+			approvalStatus = http.StatusNoContent
+			expectedApproval = atc.BuildApproval{Approved: true}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedApprovalURL),
+					ghttp.VerifyJSONRepresenting(expectedApproval),
+					ghttp.RespondWith(approvalStatus, ""),
+				),
+			)
+		})
+
+		It("approves the build", func() {
+			Expect(func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build approved"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(3))
+		})
+
+		Context("when rejecting with a comment", func() {
+			BeforeEach(func() {
+				expectedApproval = atc.BuildApproval{Approved: false, Comment: "not today"}
+			})
+
+			It("rejects the build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23", "--reject", "-m", "not today")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("build rejected"))
+			})
+		})
+
+		Context("when the build is not awaiting approval", func() {
+			BeforeEach(func() {
+				approvalStatus = http.StatusConflict
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: build is not awaiting approval"))
+			})
+		})
+	})
+
+	Context("when the job and build name are specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedApprovalURL),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("approves the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-j", "my-pipeline/my-job", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("build approved"))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/42"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: build does not exist"))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("build-logs", func() {
+		var buildEvents []atc.Event
+
+		BeforeEach(func() {
+			buildEvents = []atc.Event{
+				event.Log{Origin: event.Origin{ID: "2"}, Payload: "cloning\n"},
+				event.Log{Origin: event.Origin{ID: "3"}, Payload: "running tests\n"},
+				event.Status{Status: atc.StatusSucceeded},
+			}
+
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 3, Name: "3", Status: "succeeded"}),
+			)
+
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					for id, e := range buildEvents {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+					}
+
+					err := sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+
+			plan := json.RawMessage(`{"id": "1", "do": [
+				{"id": "2", "get": {"name": "repo"}},
+				{"id": "3", "task": {"name": "unit"}}
+			]}`)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3/plan",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PublicBuildPlan{Schema: "exec.v2", Plan: &plan}),
+			)
+		})
+
+		It("prints the build's logs", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "build-logs", "-b", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("cloning"))
+			Expect(sess.Out).To(gbytes.Say("running tests"))
+			Expect(sess.Out).To(gbytes.Say("succeeded"))
+		})
+
+		It("errors when the build is not found", func() {
+			atcServer.RouteToHandler("GET", "/api/v1/builds/nope",
+				ghttp.RespondWith(http.StatusNotFound, ""),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "build-logs", "-b", "nope")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("build not found"))
+		})
+
+		It("prints the events of a step as ndjson", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "build-logs", "-b", "3", "--step", "unit", "--format", "ndjson")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			var envelope event.Envelope
+			err = json.Unmarshal(sess.Out.Contents(), &envelope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelope.Event).To(Equal(event.EventTypeLog))
+			Expect(string(*envelope.Data)).To(ContainSubstring("running tests"))
+		})
+
+		It("prints every event as a json array", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "build-logs", "-b", "3", "--format", "json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			var envelopes []event.Envelope
+			err = json.Unmarshal(sess.Out.Contents(), &envelopes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(envelopes).To(HaveLen(3))
+		})
+
+		It("errors when the step is not in the build plan", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "build-logs", "-b", "3", "--step", "lint")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("step 'lint' not found in build plan"))
+		})
+	})
+})
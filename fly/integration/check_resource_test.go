@@ -269,6 +269,85 @@ var _ = Describe("CheckResource", func() {
 		})
 	})
 
+	Context("when previewing a version filter", func() {
+		var streaming chan struct{}
+		var events chan atc.Event
+
+		BeforeEach(func() {
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL, expectedQueryParams),
+					ghttp.VerifyJSON(`{"from":null}`),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, build),
+				),
+				BuildEventsHandler(123, streaming, events),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/versions", "limit=2&vars.branch=%22master%22"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ResourceVersion{
+						{
+							ID:      3,
+							Version: atc.Version{"tag": "2.1.0"},
+						},
+						{
+							ID:       2,
+							Version:  atc.Version{"tag": "1.9.0"},
+							Metadata: []atc.MetadataField{{Name: "branch", Value: "main"}},
+						},
+					}),
+				),
+			)
+		})
+
+		It("shows which versions match the filter", func() {
+			Expect(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/branch:master/myresource", "--shallow", "--preview-filter", `tag =~ "^2\." or metadata.branch == "main" and tag == "nope"`, "--preview-count", "2")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess.Out).Should(gbytes.Say("checking mypipeline/branch:master/myresource in build 123"))
+
+				AssertEvents(sess, streaming, events)
+				Expect(sess.Out).To(gbytes.Say(`3\s+tag:2\.1\.0\s+yes`))
+				Expect(sess.Out).To(gbytes.Say(`2\s+tag:1\.9\.0\s+no`))
+				Expect(sess.Out).To(gbytes.Say("1 of 2 versions match the filter"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(4))
+		})
+	})
+
+	Context("when previewing a version filter with --async", func() {
+		It("fails before checking", func() {
+			Expect(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/branch:master/myresource", "--shallow", "-a", "--preview-filter", `tag == "1"`)
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--preview-filter cannot be used with --async"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(1))
+		})
+	})
+
+	Context("when the preview filter is invalid", func() {
+		It("fails before checking", func() {
+			Expect(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/branch:master/myresource", "--preview-filter", `tag = "1"`)
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("invalid filter: unknown operator at position 5"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(1))
+		})
+	})
+
 	Context("when pipeline or resource is not found", func() {
 		BeforeEach(func() {
 			atcServer.AppendHandlers(
@@ -0,0 +1,112 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("credential stores", func() {
+		var (
+			accessToken     string
+			requestedScopes []string
+		)
+
+		BeforeEach(func() {
+			accessToken = validAccessToken(date(2030, 1, 1))
+			requestedScopes = nil
+
+			atcServer.RouteToHandler("GET", "/api/v1/info", infoHandler())
+			atcServer.RouteToHandler("GET", "/api/v1/user", userInfoHandler())
+			atcServer.RouteToHandler("POST", "/sky/issuer/token",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, r *http.Request) {
+						requestedScopes = append(requestedScopes, r.FormValue("scope"))
+					},
+					ghttp.RespondWithJSONEncoded(200, map[string]string{
+						"token_type":    "Bearer",
+						"access_token":  accessToken,
+						"refresh_token": "some-refresh-token",
+					}),
+				),
+			)
+		})
+
+		run := func(env []string, args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, args...)
+			flyCmd.Env = append(os.Environ(), env...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			return sess
+		}
+
+		flyrc := func() string {
+			contents, err := ioutil.ReadFile(filepath.Join(homeDir, ".flyrc"))
+			Expect(err).NotTo(HaveOccurred())
+			return string(contents)
+		}
+
+		It("does not ask for or save a refresh token in plain text", func() {
+			sess := run(nil, "-t", targetName, "login", "-u", "user", "-p", "pass")
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(requestedScopes).To(ConsistOf("openid profile email federated:id groups"))
+			Expect(flyrc()).To(ContainSubstring(accessToken))
+			Expect(flyrc()).NotTo(ContainSubstring("some-refresh-token"))
+		})
+
+		Context("when logging in with an encrypted token", func() {
+			BeforeEach(func() {
+				sess := run([]string{"FLY_TOKEN_PASSPHRASE=some-passphrase"}, "-t", targetName, "login", "-u", "user", "-p", "pass", "--credential-store", "encrypted")
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+
+			It("asks for a refresh token", func() {
+				Expect(requestedScopes).To(ConsistOf("openid profile email federated:id groups offline_access"))
+			})
+
+			It("does not save the token in plain text", func() {
+				Expect(flyrc()).To(ContainSubstring("credential_store: encrypted"))
+				Expect(flyrc()).NotTo(ContainSubstring(accessToken))
+				Expect(flyrc()).NotTo(ContainSubstring("some-refresh-token"))
+			})
+
+			It("uses the token with the passphrase", func() {
+				sess := run([]string{"FLY_TOKEN_PASSPHRASE=some-passphrase"}, "-t", targetName, "status")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("logged in successfully"))
+
+				Expect(atcServer.ReceivedRequests()[len(atcServer.ReceivedRequests())-1].Header.Get("Authorization")).To(Equal("Bearer " + accessToken))
+			})
+
+			It("errors without the passphrase", func() {
+				sess := run(nil, "-t", targetName, "status")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("a passphrase is required for encrypted tokens"))
+			})
+
+			It("shows the token's expiry in targets with the passphrase", func() {
+				sess := run([]string{"FLY_TOKEN_PASSPHRASE=some-passphrase"}, "targets")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say(date(2030, 1, 1).Format(time.RFC1123)))
+
+				sess = run(nil, "targets")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("n/a: could not load token"))
+			})
+		})
+	})
+})
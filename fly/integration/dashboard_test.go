@@ -0,0 +1,27 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("dashboard", func() {
+		Context("when not run in a terminal", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "dashboard")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("dashboard must be run in a terminal"))
+			})
+		})
+	})
+})
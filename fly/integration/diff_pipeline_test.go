@@ -0,0 +1,197 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("diff-pipeline", func() {
+		var (
+			tmpdir     string
+			configPath string
+			config     atc.Config
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-diff-pipeline")
+			Expect(err).NotTo(HaveOccurred())
+
+			configPath = filepath.Join(tmpdir, "pipeline.yml")
+
+			err = ioutil.WriteFile(configPath, []byte(`
+resources:
+- name: some-resource
+  type: git
+  source:
+    branch: ((branch))
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+`), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name:   "some-resource",
+						Type:   "git",
+						Source: atc.Source{"branch": "master"},
+					},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{Config: &atc.GetStep{Name: "some-resource"}},
+						},
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		Context("when comparing a pipeline to a local config", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/config",
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config", "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: config}, http.Header{atc.ConfigVersionHeader: {"1"}}),
+					),
+				)
+			})
+
+			It("exits 0 when there is no drift", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "diff-pipeline",
+					"some-pipeline/branch:master", "file:"+configPath,
+					"-v", "branch=master",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("no differences"))
+			})
+
+			It("prints the diff and exits 1 when there is drift", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "diff-pipeline",
+					"some-pipeline/branch:master", "file:"+configPath,
+					"-v", "branch=develop",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Out).To(gbytes.Say("resource some-resource has changed:"))
+				Expect(sess.Out).To(gbytes.Say("branch: master"))
+				Expect(sess.Out).To(gbytes.Say("branch: develop"))
+			})
+
+			It("prints the changes as json", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "diff-pipeline",
+					"some-pipeline/branch:master", "file:"+configPath,
+					"-v", "branch=develop",
+					"--json",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				var output struct {
+					From    string `json:"from"`
+					To      string `json:"to"`
+					Changes []struct {
+						Kind   string `json:"kind"`
+						Name   string `json:"name"`
+						Change string `json:"change"`
+					} `json:"changes"`
+				}
+				err = json.Unmarshal(sess.Out.Contents(), &output)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(output.From).To(Equal("some-pipeline/branch:master"))
+				Expect(output.To).To(Equal(configPath))
+				Expect(output.Changes).To(HaveLen(1))
+				Expect(output.Changes[0].Kind).To(Equal("resource"))
+				Expect(output.Changes[0].Name).To(Equal("some-resource"))
+				Expect(output.Changes[0].Change).To(Equal("changed"))
+			})
+		})
+
+		Context("when comparing a past version of a pipeline", func() {
+			BeforeEach(func() {
+				pastConfig := config
+				pastConfig.Jobs = nil
+
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/config",
+					func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("version") == "3" {
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: pastConfig})(w, r)
+						} else {
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: config})(w, r)
+						}
+					},
+				)
+			})
+
+			It("compares against the config of that version", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "some-pipeline@3", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Out).To(gbytes.Say("job some-job has been added:"))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/config",
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				)
+			})
+
+			It("prints an error and exits 2", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "diff-pipeline", "some-pipeline", "file:"+configPath)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(2))
+				Expect(sess.Err).To(gbytes.Say("pipeline not found: some-pipeline"))
+			})
+		})
+	})
+})
@@ -0,0 +1,226 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --watch", func() {
+		var (
+			tmpdir         string
+			buildDir       string
+			taskConfigPath string
+
+			lock          sync.Mutex
+			uploads       int
+			patches       [][]string
+			plannedInputs []int
+		)
+
+		tarNames := func(r io.Reader) []string {
+			gr, err := gzip.NewReader(r)
+			Expect(err).NotTo(HaveOccurred())
+
+			names := []string{}
+
+			tr := tar.NewReader(gr)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred())
+
+				names = append(names, hdr.Name)
+			}
+
+			return names
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-build-dir")
+			Expect(err).NotTo(HaveOccurred())
+
+			buildDir = filepath.Join(tmpdir, "fixture")
+
+			err = os.Mkdir(buildDir, 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskConfigPath = filepath.Join(buildDir, "task.yml")
+
+			err = ioutil.WriteFile(
+				taskConfigPath,
+				[]byte(`---
+platform: some-platform
+
+image_resource:
+  type: registry-image
+  source:
+    repository: ubuntu
+
+inputs:
+- name: fixture
+
+run:
+  path: find
+  args: [.]
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(buildDir, "some-file"), []byte("some-contents"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			uploads = 0
+			patches = nil
+			plannedInputs = nil
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				func(w http.ResponseWriter, r *http.Request) {
+					lock.Lock()
+					uploads++
+					id := 100 + uploads
+					lock.Unlock()
+
+					ghttp.RespondWith(201, fmt.Sprintf(`{"id":%d}`, id))(w, r)
+				},
+			)
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts/101/patch",
+				func(w http.ResponseWriter, r *http.Request) {
+					names := tarNames(r.Body)
+
+					lock.Lock()
+					patches = append(patches, names)
+					id := 200 + len(patches)
+					lock.Unlock()
+
+					ghttp.RespondWith(201, fmt.Sprintf(`{"id":%d}`, id))(w, r)
+				},
+			)
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/builds",
+				func(w http.ResponseWriter, r *http.Request) {
+					var plan atc.Plan
+					err := json.NewDecoder(r.Body).Decode(&plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					input := (*plan.Do)[0].InParallel.Steps[0].ArtifactInput
+					Expect(input).ToNot(BeNil())
+
+					lock.Lock()
+					plannedInputs = append(plannedInputs, input.ArtifactID)
+					id := 127 + len(plannedInputs)
+					lock.Unlock()
+
+					ghttp.RespondWith(201, fmt.Sprintf(`{"id":%d}`, id))(w, r)
+				},
+			)
+
+			atcServer.RouteToHandler("GET", regexp.MustCompile(`^/api/v1/builds/\d+/events$`),
+				func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					payload, err := json.Marshal(event.Message{Event: event.Log{Payload: "sup"}})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{ID: "0", Name: "event", Data: payload}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+
+			atcServer.RouteToHandler("GET", regexp.MustCompile(`^/api/v1/builds/\d+/artifacts$`),
+				ghttp.RespondWithJSONEncoded(200, []atc.WorkerArtifact{}),
+			)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		start := func() *gexec.Session {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "e", "-c", taskConfigPath, "--watch")
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out).Should(gbytes.Say("executing build 128"))
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+			Eventually(sess.Out).Should(gbytes.Say("waiting for changes to inputs..."))
+
+			return sess
+		}
+
+		It("uploads only the changed files and runs the task again", func() {
+			sess := start()
+
+			err := ioutil.WriteFile(filepath.Join(buildDir, "some-file"), []byte("some-other-contents"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out, 5).Should(gbytes.Say("executing build 129"))
+			Eventually(sess.Out).Should(gbytes.Say("waiting for changes to inputs..."))
+
+			lock.Lock()
+			Expect(uploads).To(Equal(1))
+			Expect(patches).To(Equal([][]string{{"some-file"}}))
+			Expect(plannedInputs).To(Equal([]int{101, 201}))
+			lock.Unlock()
+
+			if runtime.GOOS != "windows" {
+				sess.Signal(os.Interrupt)
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			} else {
+				sess.Kill()
+				<-sess.Exited
+			}
+		})
+
+		It("uploads everything again when a file is deleted", func() {
+			sess := start()
+
+			err := os.Remove(filepath.Join(buildDir, "some-file"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Out, 5).Should(gbytes.Say("executing build 129"))
+
+			lock.Lock()
+			Expect(uploads).To(Equal(2))
+			Expect(patches).To(BeEmpty())
+			Expect(plannedInputs).To(Equal([]int{101, 102}))
+			lock.Unlock()
+
+			sess.Kill()
+			<-sess.Exited
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("get-artifact", func() {
+		var outputDir string
+
+		BeforeEach(func() {
+			var err error
+			outputDir, err = ioutil.TempDir("", "fly-get-artifact")
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 3, TeamName: "other-team"}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3/artifacts",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WorkerArtifact{
+					{ID: 10, Name: "binaries", BuildID: 3},
+					{ID: 11, Name: "reports", BuildID: 3},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/other-team/artifacts/10",
+				ghttp.RespondWith(http.StatusOK, "binaries-tarball"),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/other-team/artifacts/11",
+				ghttp.RespondWith(http.StatusOK, "reports-tarball"),
+			)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(outputDir)
+		})
+
+		It("downloads every artifact of the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-artifact", "-b", "3", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("downloaded binaries to " + filepath.Join(outputDir, "binaries.tgz")))
+			Expect(sess.Out).To(gbytes.Say("downloaded reports to " + filepath.Join(outputDir, "reports.tgz")))
+
+			Expect(ioutil.ReadFile(filepath.Join(outputDir, "binaries.tgz"))).To(Equal([]byte("binaries-tarball")))
+			Expect(ioutil.ReadFile(filepath.Join(outputDir, "reports.tgz"))).To(Equal([]byte("reports-tarball")))
+		})
+
+		It("downloads a single artifact", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-artifact", "-b", "3", "-a", "reports", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(filepath.Join(outputDir, "binaries.tgz")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(outputDir, "reports.tgz")).To(BeAnExistingFile())
+		})
+
+		It("errors when an artifact's name would escape the output directory", func() {
+			atcServer.RouteToHandler("GET", "/api/v1/builds/3/artifacts",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WorkerArtifact{
+					{ID: 12, Name: "../escaped", BuildID: 3},
+				}),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-artifact", "-b", "3", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say(regexp.QuoteMeta("refusing to download artifact with invalid name '../escaped'")))
+			Expect(filepath.Join(filepath.Dir(outputDir), "escaped.tgz")).NotTo(BeAnExistingFile())
+		})
+
+		It("errors when the artifact does not exist", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-artifact", "-b", "3", "-a", "coverage", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("artifact 'coverage' not found in build 3"))
+		})
+	})
+})
@@ -1,10 +1,12 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo"
@@ -155,6 +157,55 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when a version is pinned for a duration", func() {
+			var requestedExpiry time.Time
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", listVersionsPath, strings.Join(expectedQueryParams, "&")),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ResourceVersion{versionToPin}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", pinVersionPath, "vars.branch=%22master%22"),
+						func(w http.ResponseWriter, r *http.Request) {
+							var body atc.PinVersionRequestBody
+							err := json.NewDecoder(r.Body).Decode(&body)
+							Expect(err).NotTo(HaveOccurred())
+
+							requestedExpiry = time.Unix(body.ExpiresAt, 0)
+						},
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("pins the resource version until the duration has passed", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", pipelineResource, "-v", pinVersion, "--for", "2h")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(fmt.Sprintf("pinned '%s' with version {\"some\":\"value\"}\n", pipelineResource)))
+				Eventually(sess.Out).Should(gbytes.Say("the pin expires at "))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(requestedExpiry).To(BeTemporally("~", time.Now().Add(2*time.Hour), time.Minute))
+			})
+		})
+
+		It("errors when a duration is given without a version", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", pipelineResource, "-c", "some pin message", "--for", "2h")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("--for can only be used when pinning a --version"))
+		})
+
 		Context("when version and comment are provided", func() {
 			var sess *gexec.Session
 			JustBeforeEach(func() {
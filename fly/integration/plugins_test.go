@@ -0,0 +1,122 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("plugins", func() {
+		var (
+			pluginsDir  string
+			accessToken string
+		)
+
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("plugins are shell scripts in these tests")
+			}
+
+			var err error
+			pluginsDir, err = ioutil.TempDir("", "fly-plugins")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(pluginsDir, "fly-promote"), []byte(`#!/bin/sh
+echo "args: $@"
+echo "target: $FLY_TARGET"
+echo "url: $FLY_TARGET_URL"
+echo "team: $FLY_TARGET_TEAM"
+echo "token: $FLY_TARGET_TOKEN_TYPE $FLY_TARGET_TOKEN"
+exit 3
+`), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(pluginsDir, "fly-not-executable"), []byte("#!/bin/sh\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			accessToken = validAccessToken(time.Now().Add(time.Hour))
+
+			createFlyRc(rc.Targets{
+				targetName: {
+					API:      atcServer.URL(),
+					TeamName: "other-team",
+					Token:    &rc.TargetToken{Type: "Bearer", Value: accessToken},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(pluginsDir)
+		})
+
+		run := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, args...)
+			flyCmd.Env = append(os.Environ(), "FLY_PLUGINS_DIR="+pluginsDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			return sess
+		}
+
+		It("runs unknown commands as plugins with the target's credentials", func() {
+			sess := run("-t", targetName, "promote", "-p", "some-pipeline", "--verbose")
+			Expect(sess.ExitCode()).To(Equal(3))
+
+			Expect(sess.Out).To(gbytes.Say("args: -p some-pipeline --verbose"))
+			Expect(sess.Out).To(gbytes.Say("target: " + targetName))
+			Expect(sess.Out).To(gbytes.Say("url: " + atcServer.URL()))
+			Expect(sess.Out).To(gbytes.Say("team: other-team"))
+			Expect(sess.Out).To(gbytes.Say("token: Bearer " + regexp.QuoteMeta(accessToken)))
+		})
+
+		It("runs plugins without a target", func() {
+			sess := run("promote")
+			Expect(sess.ExitCode()).To(Equal(3))
+
+			Expect(sess.Out).To(gbytes.Say("args: \n"))
+			Expect(sess.Out).To(gbytes.Say("target: \n"))
+		})
+
+		It("asks to log in when the target's token has expired", func() {
+			createFlyRc(rc.Targets{
+				targetName: {
+					API:      atcServer.URL(),
+					TeamName: "main",
+					Token:    &rc.TargetToken{Type: "Bearer", Value: validAccessToken(time.Now().Add(-time.Hour))},
+				},
+			})
+
+			sess := run("-t", targetName, "promote")
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("not authorized"))
+		})
+
+		It("still errors for commands which are not plugins", func() {
+			sess := run("-t", targetName, "not-executable")
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("Unknown command `not-executable'"))
+		})
+
+		It("lists the plugins", func() {
+			sess := run("plugins")
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(sess.Out).To(gbytes.Say(`promote\s+` + regexp.QuoteMeta(filepath.Join(pluginsDir, "fly-promote"))))
+			Expect(sess.Out).NotTo(gbytes.Say("not-executable"))
+		})
+	})
+})
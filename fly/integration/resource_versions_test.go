@@ -32,7 +32,7 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.CombineHandlers(
 							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", strings.Join(queryParams, "&")),
 							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
-								{ID: 3, Version: atc.Version{"version": "3", "another": "field"}, Enabled: true},
+								{ID: 3, Version: atc.Version{"version": "3", "another": "field"}, Enabled: true, Annotations: []atc.VersionAnnotation{{Label: "qa-approved"}, {Label: "canary-ok"}}},
 								{ID: 2, Version: atc.Version{"version": "2", "another": "field"}, Enabled: false},
 								{ID: 1, Version: atc.Version{"version": "1", "another": "field"}, Enabled: true},
 							}),
@@ -54,7 +54,8 @@ var _ = Describe("Fly CLI", func() {
                 {
                   "id": 3,
 									"version": {"version":"3","another":"field"},
-									"enabled": true
+									"enabled": true,
+									"annotations": [{"label":"qa-approved","created_at":0},{"label":"canary-ok","created_at":0}]
                 },
                 {
                   "id": 2,
@@ -80,11 +81,12 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "id", Color: color.New(color.Bold)},
 							{Contents: "version", Color: color.New(color.Bold)},
 							{Contents: "enabled", Color: color.New(color.Bold)},
+							{Contents: "labels", Color: color.New(color.Bold)},
 						},
 						Data: []ui.TableRow{
-							{{Contents: "3"}, {Contents: "another:field,version:3"}, {Contents: "yes"}},
-							{{Contents: "2"}, {Contents: "another:field,version:2"}, {Contents: "no"}},
-							{{Contents: "1"}, {Contents: "another:field,version:1"}, {Contents: "yes"}},
+							{{Contents: "3"}, {Contents: "another:field,version:3"}, {Contents: "yes"}, {Contents: "qa-approved,canary-ok"}},
+							{{Contents: "2"}, {Contents: "another:field,version:2"}, {Contents: "no"}, {Contents: "none", Color: color.New(color.Faint)}},
+							{{Contents: "1"}, {Contents: "another:field,version:1"}, {Contents: "yes"}, {Contents: "none", Color: color.New(color.Faint)}},
 						},
 					}))
 				})
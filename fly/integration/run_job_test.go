@@ -0,0 +1,156 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("run-job", func() {
+		var (
+			tmpdir   string
+			inputDir string
+			uploaded bool
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-run-job")
+			Expect(err).NotTo(HaveOccurred())
+
+			inputDir = filepath.Join(tmpdir, "some-input")
+
+			err = os.Mkdir(inputDir, 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(inputDir, "some-file"), []byte("some-contents"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			uploaded = false
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, r *http.Request) {
+						uploaded = true
+					},
+					ghttp.RespondWith(201, `{"id":125}`),
+				),
+			)
+
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+
+					payload, err := json.Marshal(event.Message{Event: event.Log{Payload: "sup"}})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{ID: "0", Name: "event", Data: payload}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+
+					payload, err = json.Marshal(event.Message{Event: event.Status{Status: atc.StatusSucceeded}})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{ID: "1", Name: "event", Data: payload}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		Context("when the job can be run", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/run",
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/run", "vars.branch=%22master%22"),
+						ghttp.VerifyJSONRepresenting(atc.RunJobRequest{
+							Artifacts: map[string]int{"some-input": 125},
+							Vars:      map[string]interface{}{"foo": "bar", "baz": []interface{}{1}},
+						}),
+						ghttp.RespondWith(201, `{"id":128}`),
+					),
+				)
+			})
+
+			It("uploads the local inputs, runs the job, and streams the output", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "run-job",
+					"-j", "some-pipeline/branch:master/some-job",
+					"-i", "some-input="+inputDir,
+					"-v", "foo=bar",
+					"-y", "baz=[1]",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("running some-pipeline/branch:master/some-job as build 128"))
+				Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(uploaded).To(BeTrue())
+			})
+		})
+
+		Context("when the job can't be planned", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/run",
+					ghttp.RespondWith(400, "failed to plan job: version for input some-input not provided"),
+				)
+			})
+
+			It("prints the error and exits 1", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "run-job", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("version for input some-input not provided"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(uploaded).To(BeFalse())
+			})
+		})
+
+		Context("when a local input is not a directory", func() {
+			It("prints an error and exits 1", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "run-job",
+					"-j", "some-pipeline/some-job",
+					"-i", "some-input="+filepath.Join(inputDir, "some-file"),
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("not a folder"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,275 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("team-webhooks", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/webhooks"),
+					ghttp.RespondWithJSONEncoded(200, []atc.TeamWebhook{
+						{
+							Name:          "some-webhook",
+							Type:          atc.WebhookTypeGitLab,
+							ResourceTypes: []string{"git", "mock"},
+							Match:         []atc.WebhookMatch{{PayloadField: "ref", SourceField: "branch"}},
+						},
+						{
+							Name: "another-webhook",
+							Type: atc.WebhookTypeGitHub,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them sorted by name", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "team-webhooks")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "type", Color: color.New(color.Bold)},
+					{Contents: "resource types", Color: color.New(color.Bold)},
+					{Contents: "match", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "another-webhook"},
+						{Contents: "github"},
+						{Contents: "all", Color: color.New(color.Faint)},
+						{Contents: "repository.full_name=uri"},
+					},
+					{
+						{Contents: "some-webhook"},
+						{Contents: "gitlab"},
+						{Contents: "git,mock"},
+						{Contents: "ref=branch"},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("set-team-webhook", func() {
+		var (
+			expectedWebhook atc.TeamWebhook
+			status          int
+		)
+
+		BeforeEach(func() {
+			expectedWebhook = atc.TeamWebhook{
+				Name:          "some-webhook",
+				Type:          atc.WebhookTypeGitHub,
+				Secret:        "some-secret",
+				ResourceTypes: []string{"git"},
+				Match: []atc.WebhookMatch{
+					{PayloadField: "repository.full_name", SourceField: "uri"},
+					{PayloadField: "ref", SourceField: "branch"},
+				},
+			}
+
+			status = http.StatusCreated
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/webhooks/some-webhook"),
+					ghttp.VerifyJSONRepresenting(expectedWebhook),
+					ghttp.RespondWithJSONEncoded(status, atc.TeamWebhook{Name: "some-webhook", Type: atc.WebhookTypeGitHub}),
+				),
+			)
+		})
+
+		run := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{
+				"-t", targetName, "set-team-webhook",
+				"-n", "some-webhook",
+				"--type", "github",
+				"--secret", "some-secret",
+				"--resource-type", "git",
+				"--match", "repository.full_name=uri",
+				"--match", "ref=branch",
+			}, args...)...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			return sess
+		}
+
+		It("creates the webhook and prints its url", func() {
+			sess := run()
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("webhook `some-webhook` created"))
+			Expect(sess.Out).To(gbytes.Say(atcServer.URL() + "/api/v1/teams/main/webhooks/some-webhook"))
+		})
+
+		Context("when the webhook already exists", func() {
+			BeforeEach(func() {
+				status = http.StatusOK
+			})
+
+			It("updates it", func() {
+				sess := run()
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("webhook `some-webhook` updated"))
+			})
+		})
+
+		Context("when a match rule is malformed", func() {
+			It("errors without calling the api", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-webhook",
+					"-n", "some-webhook",
+					"--type", "github",
+					"--secret", "some-secret",
+					"--match", "repository.full_name",
+				)
+
+				Expect(func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("invalid match 'repository.full_name'"))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(0))
+			})
+		})
+	})
+
+	Describe("destroy-team-webhook", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/webhooks/some-webhook"),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+		})
+
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("destroys it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-team-webhook", "-n", "some-webhook")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("webhook `some-webhook` destroyed"))
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-team-webhook", "-n", "some-webhook")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("webhook `some-webhook` does not exist"))
+			})
+		})
+	})
+
+	Describe("webhook-deliveries", func() {
+		var receivedAt = time.Date(2021, 1, 22, 10, 0, 0, 0, time.Local)
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/webhooks/some-webhook/deliveries", "limit=2"),
+					ghttp.RespondWithJSONEncoded(200, []atc.WebhookDelivery{
+						{
+							ID:         2,
+							Webhook:    "some-webhook",
+							Event:      "push",
+							ReceivedAt: receivedAt.Unix(),
+							StatusCode: http.StatusOK,
+							CheckedResources: []atc.WebhookDeliveryResource{
+								{PipelineName: "some-pipeline", Resource: "some-resource", BuildID: 10},
+								{PipelineName: "other-pipeline", Resource: "other-resource", Error: "check not created"},
+							},
+						},
+						{
+							ID:         1,
+							Webhook:    "some-webhook",
+							ReceivedAt: receivedAt.Unix(),
+							StatusCode: http.StatusUnauthorized,
+							Error:      "invalid signature",
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "webhook-deliveries", "-n", "some-webhook", "-c", "2")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "received", Color: color.New(color.Bold)},
+					{Contents: "event", Color: color.New(color.Bold)},
+					{Contents: "status", Color: color.New(color.Bold)},
+					{Contents: "error", Color: color.New(color.Bold)},
+					{Contents: "checked resources", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "2"},
+						{Contents: receivedAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "push"},
+						{Contents: "200", Color: color.New(color.FgGreen)},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "some-pipeline/some-resource,other-pipeline/other-resource (errored)"},
+					},
+					{
+						{Contents: "1"},
+						{Contents: receivedAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "401", Color: color.New(color.FgRed, color.Bold)},
+						{Contents: "invalid signature"},
+						{Contents: "none", Color: color.New(color.Faint)},
+					},
+				},
+			}))
+		})
+	})
+})
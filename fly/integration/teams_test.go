@@ -158,6 +158,70 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when --output yaml is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--output", "yaml")
+				})
+
+				It("prints response in yaml as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say(`- auth:\n    owner:\n      groups: \[\]\n      users: \[\]\n  id: 1\n  name: main\n`))
+				})
+			})
+
+			Context("when --output template is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--output", "template={{.id}}:{{.name}}")
+				})
+
+				It("prints the template for each team", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(Equal("1:main\n2:a-team\n3:b-team\n4:c-team\n"))
+				})
+			})
+
+			Context("when --filter is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--filter", "auth.owner.groups=github:github-org")
+				})
+
+				It("only lists the matching teams", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "a-team"}},
+							{{Contents: "c-team"}},
+						},
+					}))
+				})
+			})
+
+			Context("when an invalid --output is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--output", "xml")
+				})
+
+				It("errors", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("invalid output format 'xml'"))
+				})
+			})
+
 			Context("when the details flag is specified", func() {
 				BeforeEach(func() {
 					flyCmd.Args = append(flyCmd.Args, "--details")
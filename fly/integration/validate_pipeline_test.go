@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"os/exec"
 
 	. "github.com/onsi/ginkgo"
@@ -116,5 +117,57 @@ var _ = Describe("Fly CLI", func() {
 			<-sess.Exited
 			Expect(sess.ExitCode()).To(Equal(0))
 		})
+
+		It("returns invalid when the pipeline fails a lint rule", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--lint-rules", "fixtures/lint-rules.yml",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("Lint errors:"))
+			Eventually(sess.Err).Should(gbytes.Say(`  - jobs.job: job has no on_failure hook \(job-on-failure\)`))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			Expect(sess.Err).To(gbytes.Say("configuration failed lint rules"))
+		})
+
+		It("reports lint violations as SARIF", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--lint-rules", "fixtures/lint-rules.yml",
+				"--lint-format", "sarif",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+
+			var log struct {
+				Runs []struct {
+					Results []struct {
+						RuleID string `json:"ruleId"`
+						Level  string `json:"level"`
+					} `json:"results"`
+				} `json:"runs"`
+			}
+			err = json.Unmarshal(sess.Out.Contents(), &log)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(log.Runs).To(HaveLen(1))
+			Expect(log.Runs[0].Results).To(HaveLen(1))
+			Expect(log.Runs[0].Results[0].RuleID).To(Equal("job-on-failure"))
+			Expect(log.Runs[0].Results[0].Level).To(Equal("error"))
+		})
 	})
 })
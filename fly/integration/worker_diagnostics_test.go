@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("worker-diagnostics", func() {
+		var (
+			flyCmd      *exec.Cmd
+			diagnostics atc.WorkerDiagnostics
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "worker-diagnostics", "-w", "some-worker")
+
+			diagnostics = atc.WorkerDiagnostics{
+				CollectedAt:    1610727503,
+				Version:        "1.2.3",
+				Runtime:        "containerd",
+				RuntimeVersion: "containerd v1.4.3",
+				Containers: []atc.WorkerDiagnosticsContainer{
+					{Handle: "container-a", State: "active"},
+					{Handle: "container-b", State: "stopped"},
+				},
+				Volumes: []atc.WorkerDiagnosticsVolume{
+					{Handle: "volume-a", Path: "/volumes/live/volume-a/volume"},
+				},
+				Logs:   []string{"some log line"},
+				Errors: []string{"disk: no such file or directory"},
+			}
+		})
+
+		Context("when the worker reports its diagnostics", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusAccepted, nil),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, diagnostics),
+					),
+				)
+			})
+
+			It("prints the diagnostics once they are ready", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(7))
+
+				Expect(sess.Err).To(gbytes.Say("waiting for 'some-worker' to report diagnostics"))
+
+				Expect(sess.Out).To(gbytes.Say(`version:\s+1.2.3`))
+				Expect(sess.Out).To(gbytes.Say(`runtime:\s+containerd \(containerd v1.4.3\)`))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "container", Color: color.New(color.Bold)},
+						{Contents: "state", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "container-a"}, {Contents: "active"}},
+						{{Contents: "container-b"}, {Contents: "stopped"}},
+					},
+				}))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "volume", Color: color.New(color.Bold)},
+						{Contents: "path", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "volume-a"}, {Contents: "/volumes/live/volume-a/volume"}},
+					},
+				}))
+
+				Expect(sess.Out).To(gbytes.Say("disk: no such file or directory"))
+				Expect(sess.Out).To(gbytes.Say("some log line"))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the diagnostics as json", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"collected_at": 1610727503,
+						"version": "1.2.3",
+						"runtime": "containerd",
+						"runtime_version": "containerd v1.4.3",
+						"containers": [
+							{"handle": "container-a", "state": "active"},
+							{"handle": "container-b", "state": "stopped"}
+						],
+						"volumes": [
+							{"handle": "volume-a", "path": "/volumes/live/volume-a/volume"}
+						],
+						"logs": ["some log line"],
+						"errors": ["disk: no such file or directory"]
+					}`))
+				})
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
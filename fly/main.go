@@ -1,9 +1,12 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"reflect"
+	"strings"
 
 	"github.com/concourse/concourse/fly/commands"
 	"github.com/concourse/concourse/fly/rc"
@@ -21,22 +24,78 @@ func main() {
 
 	commands.WireTeamConnectors(parser.Find("set-team"))
 
-	_, err := parser.Parse()
+	err := parse(parser)
 	err = loginAndRetry(parser, err)
 	handleError(helpParser, err)
 }
 
+func parse(parser *flags.Parser) error {
+	_, err := parser.Parse()
+
+	if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrUnknownCommand {
+		name, args, found := pluginArgs(parser, os.Args[1:])
+		if !found {
+			return err
+		}
+
+		plugin, found := commands.FindPlugin(name)
+		if !found {
+			return err
+		}
+
+		return commands.RunPlugin(plugin, args)
+	}
+
+	return err
+}
+
+// pluginArgs finds the command go-flags did not know, which is the first
+// argument that isn't a global flag or its value, and the arguments after it.
+func pluginArgs(parser *flags.Parser, args []string) (string, []string, bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--" {
+			return "", nil, false
+		}
+
+		if !strings.HasPrefix(arg, "-") {
+			return arg, args[i+1:], true
+		}
+
+		if strings.Contains(arg, "=") {
+			continue
+		}
+
+		var option *flags.Option
+		if strings.HasPrefix(arg, "--") {
+			option = parser.FindOptionByLongName(strings.TrimPrefix(arg, "--"))
+		} else if len(arg) == 2 {
+			option = parser.FindOptionByShortName(rune(arg[1]))
+		}
+
+		if option != nil {
+			kind := option.Field().Type.Kind()
+			if kind != reflect.Bool && kind != reflect.Func {
+				i++
+			}
+		}
+	}
+
+	return "", nil, false
+}
+
 func loginAndRetry(parser *flags.Parser, err error) error {
 	_, stdoutIsTTY := ui.ForTTY(os.Stdout)
 	_, stdinIsTTY := ui.ForTTY(os.Stdin)
-	if err == concourse.ErrUnauthorized && stdoutIsTTY && stdinIsTTY {
+	if errors.Is(err, concourse.ErrUnauthorized) && stdoutIsTTY && stdinIsTTY {
 		fmt.Fprintln(ui.Stderr, "could not find a valid token.")
 
 		login := &commands.LoginCommand{BrowserOnly: true}
 		err = login.Execute([]string{})
 
 		if err == nil {
-			_, err = parser.Parse()
+			err = parse(parser)
 		}
 	}
 	return err
@@ -44,6 +103,14 @@ func loginAndRetry(parser *flags.Parser, err error) error {
 
 func handleError(helpParser *flags.Parser, err error) {
 	if err != nil {
+		exitCode := 1
+
+		var exitCodeErr commands.ExitCodeError
+		if errors.As(err, &exitCodeErr) {
+			err = exitCodeErr.Err
+			exitCode = exitCodeErr.ExitCode
+		}
+
 		if err == concourse.ErrUnauthorized {
 			fmt.Fprintln(ui.Stderr, "not authorized. run the following to log in:")
 			fmt.Fprintln(ui.Stderr, "")
@@ -74,7 +141,7 @@ func handleError(helpParser *flags.Parser, err error) {
 			fmt.Fprintf(ui.Stderr, "error: %s\n", err)
 		}
 
-		os.Exit(1)
+		os.Exit(exitCode)
 	}
 }
 
@@ -0,0 +1,384 @@
+package rc
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/fly/pty"
+	"github.com/vito/go-interact/interact"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	PlaintextCredentialStore = "plaintext"
+	EncryptedCredentialStore = "encrypted"
+
+	// CredentialHelperPrefix is prepended to the name of any other credential
+	// store to find the executable which stores its tokens.
+	CredentialHelperPrefix = "fly-credential-"
+)
+
+var ErrPassphraseMismatch = errors.New("passphrases do not match")
+
+// CredentialStore keeps the tokens of targets. Stores may keep the token in
+// the target's props, which are saved to the .flyrc afterwards.
+type CredentialStore interface {
+	Get(TargetName, TargetProps) (*TargetToken, error)
+	Store(TargetName, *TargetProps, *TargetToken) error
+	Erase(TargetName, *TargetProps) error
+	Rename(from TargetName, to TargetName, props *TargetProps) error
+}
+
+// NewCredentialStore returns the store with the name a target's
+// credential_store is set to. Any name other than plaintext or encrypted
+// refers to a fly-credential-<name> helper executable.
+func NewCredentialStore(name string) CredentialStore {
+	switch name {
+	case "", PlaintextCredentialStore:
+		return plaintextCredentialStore{}
+	case EncryptedCredentialStore:
+		return encryptedCredentialStore{}
+	default:
+		return credentialHelper{name: name}
+	}
+}
+
+// StoresRefreshTokens returns whether the named store keeps refresh tokens.
+// They grant access until they are revoked, so they are never written to the
+// .flyrc in plain text.
+func StoresRefreshTokens(name string) bool {
+	return name != "" && name != PlaintextCredentialStore
+}
+
+type plaintextCredentialStore struct{}
+
+func (plaintextCredentialStore) Get(_ TargetName, props TargetProps) (*TargetToken, error) {
+	return props.Token, nil
+}
+
+func (plaintextCredentialStore) Store(_ TargetName, props *TargetProps, token *TargetToken) error {
+	if token != nil && token.RefreshToken != "" {
+		withoutRefreshToken := *token
+		withoutRefreshToken.RefreshToken = ""
+		token = &withoutRefreshToken
+	}
+
+	props.Token = token
+	return nil
+}
+
+func (plaintextCredentialStore) Erase(_ TargetName, props *TargetProps) error {
+	if props.Token != nil {
+		*props.Token = TargetToken{}
+	}
+
+	return nil
+}
+
+func (plaintextCredentialStore) Rename(TargetName, TargetName, *TargetProps) error {
+	return nil
+}
+
+// encryptedCredentialStore keeps tokens in the .flyrc, encrypted with a key
+// derived from a passphrase. Every token has its own salt, which is stored
+// along with the nonce in front of the ciphertext.
+type encryptedCredentialStore struct{}
+
+const (
+	passphraseSaltSize = 16
+	passphraseKeySize  = 32
+)
+
+func (encryptedCredentialStore) Get(_ TargetName, props TargetProps) (*TargetToken, error) {
+	if props.EncryptedToken == "" {
+		return nil, nil
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(props.EncryptedToken)
+	if err != nil {
+		return nil, fmt.Errorf("malformed encrypted token: %s", err)
+	}
+
+	if len(payload) < passphraseSaltSize {
+		return nil, errors.New("malformed encrypted token")
+	}
+
+	passphrase, err := ReadPassphrase(false)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := passphraseCipher(passphrase, payload[:passphraseSaltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	payload = payload[passphraseSaltSize:]
+	if len(payload) < aead.NonceSize() {
+		return nil, errors.New("malformed encrypted token")
+	}
+
+	plaintext, err := aead.Open(nil, payload[:aead.NonceSize()], payload[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt token: wrong passphrase?")
+	}
+
+	var token TargetToken
+	err = json.Unmarshal(plaintext, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (encryptedCredentialStore) Store(_ TargetName, props *TargetProps, token *TargetToken) error {
+	props.Token = nil
+	props.EncryptedToken = ""
+
+	if token == nil {
+		return nil
+	}
+
+	passphrase, err := ReadPassphrase(true)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, passphraseSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+
+	aead, err := passphraseCipher(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	payload := append(salt, nonce...)
+	payload = aead.Seal(payload, nonce, plaintext, nil)
+
+	props.EncryptedToken = base64.StdEncoding.EncodeToString(payload)
+	return nil
+}
+
+func (encryptedCredentialStore) Erase(_ TargetName, props *TargetProps) error {
+	props.EncryptedToken = ""
+	return nil
+}
+
+func (encryptedCredentialStore) Rename(TargetName, TargetName, *TargetProps) error {
+	return nil
+}
+
+func passphraseCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, passphraseKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+var cachedPassphrase string
+
+// ReadPassphrase returns the passphrase for encrypted tokens, from
+// $FLY_TOKEN_PASSPHRASE or by asking for it on the terminal. It is only asked
+// for once per run; set confirm to have it entered twice.
+func ReadPassphrase(confirm bool) (string, error) {
+	if passphrase := os.Getenv("FLY_TOKEN_PASSPHRASE"); passphrase != "" {
+		return passphrase, nil
+	}
+
+	if cachedPassphrase != "" {
+		return cachedPassphrase, nil
+	}
+
+	if !pty.IsTerminal() {
+		return "", errors.New("a passphrase is required for encrypted tokens: set FLY_TOKEN_PASSPHRASE or run fly in a terminal")
+	}
+
+	var passphrase interact.Password
+	err := promptPassphrase("token passphrase", &passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	if confirm {
+		var confirmation interact.Password
+		err := promptPassphrase("confirm token passphrase", &confirmation)
+		if err != nil {
+			return "", err
+		}
+
+		if confirmation != passphrase {
+			return "", ErrPassphraseMismatch
+		}
+	}
+
+	cachedPassphrase = string(passphrase)
+	return cachedPassphrase, nil
+}
+
+func promptPassphrase(prompt string, dst *interact.Password) error {
+	interaction := interact.NewInteraction(prompt)
+	interaction.Output = os.Stderr
+	return interaction.Resolve(interact.Required(dst))
+}
+
+// CredentialHelperRequest is written to the stdin of credential helpers. Token
+// is only set when storing.
+type CredentialHelperRequest struct {
+	Target TargetName   `json:"target"`
+	API    string       `json:"api"`
+	Team   string       `json:"team"`
+	Token  *TargetToken `json:"token,omitempty"`
+}
+
+// credentialHelper runs fly-credential-<name> with get, store or erase as its
+// argument and a CredentialHelperRequest on stdin. For get, it prints the
+// token as JSON, or nothing if it has none.
+type credentialHelper struct {
+	name string
+}
+
+func (helper credentialHelper) Get(name TargetName, props TargetProps) (*TargetToken, error) {
+	output, err := helper.run("get", CredentialHelperRequest{
+		Target: name,
+		API:    props.API,
+		Team:   props.TeamName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(output)) == 0 {
+		return nil, nil
+	}
+
+	var token TargetToken
+	err = json.Unmarshal(output, &token)
+	if err != nil {
+		return nil, fmt.Errorf("credential helper '%s' returned a malformed token: %s", helper.name, err)
+	}
+
+	if token.Value == "" {
+		return nil, nil
+	}
+
+	return &token, nil
+}
+
+func (helper credentialHelper) Store(name TargetName, props *TargetProps, token *TargetToken) error {
+	props.Token = nil
+
+	if token == nil {
+		return helper.Erase(name, props)
+	}
+
+	_, err := helper.run("store", CredentialHelperRequest{
+		Target: name,
+		API:    props.API,
+		Team:   props.TeamName,
+		Token:  token,
+	})
+	return err
+}
+
+func (helper credentialHelper) Erase(name TargetName, props *TargetProps) error {
+	_, err := helper.run("erase", CredentialHelperRequest{
+		Target: name,
+		API:    props.API,
+		Team:   props.TeamName,
+	})
+	return err
+}
+
+func (helper credentialHelper) Rename(from TargetName, to TargetName, props *TargetProps) error {
+	token, err := helper.Get(from, *props)
+	if err != nil {
+		return err
+	}
+
+	if token != nil {
+		err = helper.Store(to, props, token)
+		if err != nil {
+			return err
+		}
+	}
+
+	return helper.Erase(from, props)
+}
+
+func (helper credentialHelper) run(action string, request CredentialHelperRequest) ([]byte, error) {
+	path, err := helper.path()
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	cmd := exec.Command(path, action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			err = errors.New(message)
+		}
+
+		return nil, fmt.Errorf("credential helper '%s' failed to %s token: %s", helper.name, action, err)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func (helper credentialHelper) path() (string, error) {
+	executable := CredentialHelperPrefix + helper.name
+
+	path, err := exec.LookPath(filepath.Join(PluginsDir(), executable))
+	if err == nil {
+		return path, nil
+	}
+
+	path, err = exec.LookPath(executable)
+	if err != nil {
+		return "", fmt.Errorf("credential helper '%s' not found: %s", helper.name, err)
+	}
+
+	return path, nil
+}
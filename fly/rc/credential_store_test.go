@@ -0,0 +1,294 @@
+package rc_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+const credentialHelper = `#!/bin/sh
+input=$(cat)
+target=$(echo "$input" | sed 's/^{"target":"\([^"]*\)".*/\1/')
+file="$(dirname "$0")/$target.token"
+
+case "$1" in
+get)
+  if [ -f "$file" ]; then cat "$file"; fi
+  ;;
+store)
+  echo "$input" | sed 's/.*"token":\({.*}\)}$/\1/' > "$file"
+  ;;
+erase)
+  rm -f "$file"
+  ;;
+*)
+  echo "unknown action $1" >&2
+  exit 1
+  ;;
+esac
+`
+
+func accessToken(expiry time.Time) string {
+	accessToken, err := token.Factory{}.GenerateAccessToken(db.Claims{
+		Claims: jwt.Claims{Expiry: jwt.NewNumericDate(expiry)},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return accessToken
+}
+
+var _ = Describe("Credential stores", func() {
+	var (
+		tmpDir     string
+		pluginsDir string
+		flyrc      string
+
+		someToken *rc.TargetToken
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "fly-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		os.Setenv("HOME", tmpDir)
+		flyrc = filepath.Join(userHomeDir(), ".flyrc")
+
+		pluginsDir = filepath.Join(tmpDir, "plugins")
+		err = os.Mkdir(pluginsDir, 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		os.Setenv("FLY_PLUGINS_DIR", pluginsDir)
+		os.Setenv("FLY_TOKEN_PASSPHRASE", "some-passphrase")
+
+		someToken = &rc.TargetToken{Type: "Bearer", Value: "some-token", RefreshToken: "some-refresh-token"}
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("FLY_PLUGINS_DIR")
+		os.Unsetenv("FLY_TOKEN_PASSPHRASE")
+		os.RemoveAll(tmpDir)
+	})
+
+	flyrcContents := func() string {
+		contents, err := ioutil.ReadFile(flyrc)
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	loadToken := func(name rc.TargetName) *rc.TargetToken {
+		target, err := rc.LoadTarget(name, false)
+		Expect(err).ToNot(HaveOccurred())
+		return target.Token()
+	}
+
+	Describe("plaintext", func() {
+		It("stores the token in the flyrc without its refresh token", func() {
+			err := rc.SaveTarget("foo", "some-api", false, "main", someToken, "", "", "", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(flyrcContents()).To(ContainSubstring("some-token"))
+			Expect(flyrcContents()).ToNot(ContainSubstring("some-refresh-token"))
+			Expect(loadToken("foo")).To(Equal(&rc.TargetToken{Type: "Bearer", Value: "some-token"}))
+		})
+	})
+
+	Describe("encrypted", func() {
+		BeforeEach(func() {
+			err := rc.SaveTarget("foo", "some-api", false, "main", someToken, "", "", "", rc.EncryptedCredentialStore)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not store the token in plain text", func() {
+			Expect(flyrcContents()).To(ContainSubstring("credential_store: encrypted"))
+			Expect(flyrcContents()).To(ContainSubstring("encrypted_token:"))
+			Expect(flyrcContents()).ToNot(ContainSubstring("some-token"))
+		})
+
+		It("decrypts the token with the passphrase", func() {
+			Expect(loadToken("foo")).To(Equal(someToken))
+		})
+
+		It("errors with the wrong passphrase", func() {
+			os.Setenv("FLY_TOKEN_PASSPHRASE", "wrong-passphrase")
+
+			_, err := rc.LoadTarget("foo", false)
+			Expect(err).To(MatchError("failed to decrypt token: wrong passphrase?"))
+		})
+
+		It("keeps using the store when logging in again", func() {
+			err := rc.SaveTarget("foo", "some-api", false, "main", &rc.TargetToken{Type: "Bearer", Value: "new-token"}, "", "", "", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(flyrcContents()).ToNot(ContainSubstring("new-token"))
+			Expect(loadToken("foo").Value).To(Equal("new-token"))
+		})
+
+		It("can be switched back to plain text", func() {
+			err := rc.SaveTarget("foo", "some-api", false, "main", someToken, "", "", "", rc.PlaintextCredentialStore)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(flyrcContents()).ToNot(ContainSubstring("encrypted"))
+			Expect(loadToken("foo")).To(Equal(&rc.TargetToken{Type: "Bearer", Value: "some-token"}))
+		})
+
+		It("forgets the token on logout", func() {
+			err := rc.LogoutTarget("foo")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(flyrcContents()).ToNot(ContainSubstring("encrypted_token"))
+			Expect(loadToken("foo")).To(BeNil())
+		})
+	})
+
+	Describe("credential helpers", func() {
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("the credential helper is a shell script")
+			}
+
+			err := ioutil.WriteFile(filepath.Join(pluginsDir, "fly-credential-test"), []byte(credentialHelper), 0755)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = rc.SaveTarget("foo", "some-api", false, "main", someToken, "", "", "", "test")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the token with the helper", func() {
+			Expect(flyrcContents()).To(ContainSubstring("credential_store: test"))
+			Expect(flyrcContents()).ToNot(ContainSubstring("some-token"))
+			Expect(filepath.Join(pluginsDir, "foo.token")).To(BeAnExistingFile())
+
+			Expect(loadToken("foo")).To(Equal(someToken))
+		})
+
+		It("moves the token when the target is renamed", func() {
+			err := rc.UpdateTargetName("foo", "bar")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(filepath.Join(pluginsDir, "foo.token")).ToNot(BeAnExistingFile())
+			Expect(loadToken("bar")).To(Equal(someToken))
+		})
+
+		It("erases the token on logout", func() {
+			err := rc.LogoutTarget("foo")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(filepath.Join(pluginsDir, "foo.token")).ToNot(BeAnExistingFile())
+			Expect(loadToken("foo")).To(BeNil())
+		})
+
+		It("erases the token when the target is deleted", func() {
+			err := rc.DeleteTarget("foo")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(filepath.Join(pluginsDir, "foo.token")).ToNot(BeAnExistingFile())
+		})
+
+		It("returns the helper's errors", func() {
+			err := ioutil.WriteFile(filepath.Join(pluginsDir, "fly-credential-test"), []byte("#!/bin/sh\necho locked >&2\nexit 1\n"), 0755)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = rc.LoadTarget("foo", false)
+			Expect(err).To(MatchError("credential helper 'test' failed to get token: locked"))
+		})
+
+		It("errors when the helper does not exist", func() {
+			err := rc.SaveTarget("foo", "some-api", false, "main", someToken, "", "", "", "missing")
+			Expect(err).To(MatchError(HavePrefix("credential helper 'missing' not found")))
+		})
+	})
+
+	Describe("refreshing expired tokens", func() {
+		var (
+			atcServer    *ghttp.Server
+			expiredToken string
+			newToken     string
+		)
+
+		BeforeEach(func() {
+			atcServer = ghttp.NewServer()
+
+			expiredToken = accessToken(time.Now().Add(-time.Hour))
+			newToken = accessToken(time.Now().Add(time.Hour))
+		})
+
+		AfterEach(func() {
+			atcServer.Close()
+		})
+
+		saveTarget := func(value string) {
+			err := rc.SaveTarget(
+				"foo",
+				atcServer.URL(),
+				false,
+				"main",
+				&rc.TargetToken{Type: "Bearer", Value: value, RefreshToken: "some-refresh-token"},
+				"",
+				"",
+				"",
+				rc.EncryptedCredentialStore,
+			)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Context("when the ATC issues a new token", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/sky/issuer/token"),
+						ghttp.VerifyFormKV("grant_type", "refresh_token"),
+						ghttp.VerifyFormKV("refresh_token", "some-refresh-token"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{
+							"token_type":    "Bearer",
+							"access_token":  newToken,
+							"refresh_token": "new-refresh-token",
+						}),
+					),
+				)
+			})
+
+			It("uses and saves the new token", func() {
+				saveTarget(expiredToken)
+
+				Expect(loadToken("foo")).To(Equal(&rc.TargetToken{Type: "Bearer", Value: newToken, RefreshToken: "new-refresh-token"}))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+
+				Expect(loadToken("foo").Value).To(Equal(newToken))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+
+			It("does not refresh tokens which are still valid", func() {
+				saveTarget(newToken)
+
+				Expect(loadToken("foo").Value).To(Equal(newToken))
+				Expect(atcServer.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when the ATC does not refresh the token", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("POST", "/sky/issuer/token",
+					ghttp.RespondWith(http.StatusBadRequest, `{"error":"unsupported_grant_type"}`),
+				)
+			})
+
+			It("keeps the expired token", func() {
+				saveTarget(expiredToken)
+
+				Expect(loadToken("foo").Value).To(Equal(expiredToken))
+			})
+		})
+	})
+})
@@ -151,14 +151,29 @@ func LoadTarget(selectedTarget TargetName, tracing bool) (Target, error) {
 		return nil, err
 	}
 
-	httpClient := defaultHttpClient(targetProps.Token, targetProps.Insecure, caCertPool, clientCertificate)
+	token, err := LoadToken(selectedTarget, targetProps)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err = refreshExpiredToken(
+		selectedTarget,
+		targetProps.API,
+		token,
+		transport(targetProps.Insecure, caCertPool, clientCertificate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := defaultHttpClient(token, targetProps.Insecure, caCertPool, clientCertificate)
 	client := concourse.NewClient(targetProps.API, httpClient, tracing)
 
 	return NewTarget(
 		selectedTarget,
 		targetProps.TeamName,
 		targetProps.API,
-		targetProps.Token,
+		token,
 		targetProps.CACert,
 		caCertPool,
 		targetProps.ClientCertPath,
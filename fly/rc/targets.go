@@ -40,17 +40,31 @@ type TargetProps struct {
 	CACert         string       `json:"ca_cert,omitempty"`
 	ClientCertPath string       `json:"client_cert_path,omitempty"`
 	ClientKeyPath  string       `json:"client_key_path,omitempty"`
+
+	CredentialStore string `json:"credential_store,omitempty"`
+	EncryptedToken  string `json:"encrypted_token,omitempty"`
 }
 
 type TargetToken struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
+	Type         string `json:"type"`
+	Value        string `json:"value"`
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
 
 func flyrcPath() string {
 	return filepath.Join(userHomeDir(), ".flyrc")
 }
 
+// PluginsDir is searched for fly-<name> plugins before the PATH. It can be
+// overridden with $FLY_PLUGINS_DIR.
+func PluginsDir() string {
+	if dir := os.Getenv("FLY_PLUGINS_DIR"); dir != "" {
+		return dir
+	}
+
+	return filepath.Join(userHomeDir(), ".fly", "plugins")
+}
+
 func LogoutTarget(targetName TargetName) error {
 	flyTargets, err := LoadTargets()
 	if err != nil {
@@ -58,9 +72,12 @@ func LogoutTarget(targetName TargetName) error {
 	}
 
 	if target, ok := flyTargets[targetName]; ok {
-		if target.Token != nil {
-			*target.Token = TargetToken{}
+		err = NewCredentialStore(target.CredentialStore).Erase(targetName, &target)
+		if err != nil {
+			return err
 		}
+
+		flyTargets[targetName] = target
 	}
 
 	return writeTargets(flyrcPath(), flyTargets)
@@ -72,12 +89,31 @@ func DeleteTarget(targetName TargetName) error {
 		return err
 	}
 
+	if target, ok := flyTargets[targetName]; ok {
+		err = NewCredentialStore(target.CredentialStore).Erase(targetName, &target)
+		if err != nil {
+			return err
+		}
+	}
+
 	delete(flyTargets, targetName)
 
 	return writeTargets(flyrcPath(), flyTargets)
 }
 
 func DeleteAllTargets() error {
+	flyTargets, err := LoadTargets()
+	if err != nil {
+		return err
+	}
+
+	for targetName, target := range flyTargets {
+		err = NewCredentialStore(target.CredentialStore).Erase(targetName, &target)
+		if err != nil {
+			return err
+		}
+	}
+
 	return writeTargets(flyrcPath(), Targets{})
 }
 
@@ -109,13 +145,22 @@ func UpdateTargetName(targetName TargetName, newTargetName TargetName) error {
 	}
 
 	if newTargetName != "" {
-		flyTargets[newTargetName] = flyTargets[targetName]
+		target := flyTargets[targetName]
+
+		err = NewCredentialStore(target.CredentialStore).Rename(targetName, newTargetName, &target)
+		if err != nil {
+			return err
+		}
+
+		flyTargets[newTargetName] = target
 		delete(flyTargets, targetName)
 	}
 
 	return writeTargets(flyrcPath(), flyTargets)
 }
 
+// SaveTarget saves the target, with its token in the credential store. If no
+// credential store is given, the target keeps using its current one.
 func SaveTarget(
 	targetName TargetName,
 	api string,
@@ -125,6 +170,7 @@ func SaveTarget(
 	caCert string,
 	clientCertPath string,
 	clientKeyPath string,
+	credentialStore string,
 ) error {
 	flyTargets, err := LoadTargets()
 	if err != nil {
@@ -133,18 +179,62 @@ func SaveTarget(
 
 	flyrc := flyrcPath()
 	newInfo := flyTargets[targetName]
+
+	if credentialStore == PlaintextCredentialStore {
+		credentialStore = ""
+	} else if credentialStore == "" {
+		credentialStore = newInfo.CredentialStore
+	}
+
+	if credentialStore != newInfo.CredentialStore {
+		err = NewCredentialStore(newInfo.CredentialStore).Erase(targetName, &newInfo)
+		if err != nil {
+			return err
+		}
+	}
+
 	newInfo.API = api
 	newInfo.Insecure = insecure
-	newInfo.Token = token
 	newInfo.TeamName = teamName
 	newInfo.CACert = caCert
 	newInfo.ClientCertPath = clientCertPath
 	newInfo.ClientKeyPath = clientKeyPath
+	newInfo.CredentialStore = credentialStore
+
+	err = NewCredentialStore(credentialStore).Store(targetName, &newInfo, token)
+	if err != nil {
+		return err
+	}
 
 	flyTargets[targetName] = newInfo
 	return writeTargets(flyrc, flyTargets)
 }
 
+// LoadToken returns the target's token from its credential store.
+func LoadToken(targetName TargetName, targetProps TargetProps) (*TargetToken, error) {
+	return NewCredentialStore(targetProps.CredentialStore).Get(targetName, targetProps)
+}
+
+func saveToken(targetName TargetName, token *TargetToken) error {
+	flyTargets, err := LoadTargets()
+	if err != nil {
+		return err
+	}
+
+	target, ok := flyTargets[targetName]
+	if !ok {
+		return UnknownTargetError{targetName}
+	}
+
+	err = NewCredentialStore(target.CredentialStore).Store(targetName, &target, token)
+	if err != nil {
+		return err
+	}
+
+	flyTargets[targetName] = target
+	return writeTargets(flyrcPath(), flyTargets)
+}
+
 func selectTarget(selectedTarget TargetName) (TargetProps, error) {
 	if selectedTarget == "" {
 		return TargetProps{}, ErrNoTargetSpecified
@@ -177,7 +177,7 @@ var _ = Describe("Targets", func() {
 			})
 
 			It("creates any new file with 0600 permissions", func() {
-				err := rc.SaveTarget("foo", "url", false, "main", nil, "", "", "")
+				err := rc.SaveTarget("foo", "url", false, "main", nil, "", "", "", "")
 				Expect(err).ToNot(HaveOccurred())
 				fi, statErr := os.Stat(flyrc)
 				Expect(statErr).To(BeNil())
@@ -191,7 +191,7 @@ var _ = Describe("Targets", func() {
 				})
 
 				It("preserves those permissions", func() {
-					err := rc.SaveTarget("foo", "url", false, "main", nil, "", "", "")
+					err := rc.SaveTarget("foo", "url", false, "main", nil, "", "", "", "")
 					Expect(err).ToNot(HaveOccurred())
 					fi, statErr := os.Stat(flyrc)
 					Expect(statErr).To(BeNil())
@@ -214,6 +214,7 @@ var _ = Describe("Targets", func() {
 						"",
 						"",
 						"",
+						"",
 					)
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -239,6 +240,7 @@ var _ = Describe("Targets", func() {
 						rsaCertPEM,
 						"",
 						"",
+						"",
 					)
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -266,6 +268,7 @@ var _ = Describe("Targets", func() {
 						"",
 						"",
 						"",
+						"",
 					)
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -291,6 +294,7 @@ var _ = Describe("Targets", func() {
 						"",
 						"",
 						"",
+						"",
 					)
 					Expect(err).ToNot(HaveOccurred())
 				})
@@ -0,0 +1,65 @@
+package rc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/skymarshal/token"
+	"golang.org/x/oauth2"
+)
+
+const (
+	OAuthClientID     = "fly"
+	OAuthClientSecret = "Zmx5"
+)
+
+// tokenRefreshLeeway refreshes tokens a little before they expire, so that
+// they don't expire in the middle of a command.
+const tokenRefreshLeeway = time.Minute
+
+// refreshExpiredToken exchanges the token's refresh token for a new token if
+// it has expired, and saves the new one. If the ATC does not issue refresh
+// tokens, or no longer accepts this one, the expired token is returned as-is
+// so that the usual login prompt kicks in.
+//
+// Refresh tokens are only issued to password logins (fly login -u -p) whose
+// token is kept in the encrypted store or a credential helper. Browser logins
+// receive their token through the ATC's login page, which doesn't pass a
+// refresh token along, so those still have to log in again once it expires.
+func refreshExpiredToken(targetName TargetName, api string, targetToken *TargetToken, base http.RoundTripper) (*TargetToken, error) {
+	if targetToken == nil || targetToken.RefreshToken == "" {
+		return targetToken, nil
+	}
+
+	expiry, err := token.Factory{}.ParseExpiry(targetToken.Value)
+	if err != nil || time.Now().Add(tokenRefreshLeeway).Before(expiry) {
+		return targetToken, nil
+	}
+
+	oauth2Config := oauth2.Config{
+		ClientID:     OAuthClientID,
+		ClientSecret: OAuthClientSecret,
+		Endpoint:     oauth2.Endpoint{TokenURL: api + "/sky/issuer/token"},
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: base})
+
+	refreshed, err := oauth2Config.TokenSource(ctx, &oauth2.Token{RefreshToken: targetToken.RefreshToken}).Token()
+	if err != nil {
+		return targetToken, nil
+	}
+
+	newToken := &TargetToken{
+		Type:         refreshed.TokenType,
+		Value:        refreshed.AccessToken,
+		RefreshToken: refreshed.RefreshToken,
+	}
+
+	err = saveToken(targetName, newToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return newToken, nil
+}
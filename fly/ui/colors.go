@@ -4,6 +4,7 @@ import "github.com/fatih/color"
 
 var PendingColor = color.New(color.FgWhite)
 var StartedColor = color.New(color.FgYellow)
+var AwaitingApprovalColor = color.New(color.FgYellow, color.Bold)
 var SucceededColor = color.New(color.FgGreen)
 var FailedColor = color.New(color.FgRed)
 var ErroredColor = color.New(color.FgRed, color.Bold)
@@ -50,3 +50,30 @@ func (team *team) GetArtifact(artifactID int) (io.ReadCloser, error) {
 
 	return response.Result.(io.ReadCloser), nil
 }
+
+func (team *team) PatchArtifact(artifactID int, src io.Reader) (atc.WorkerArtifact, bool, error) {
+	var artifact atc.WorkerArtifact
+
+	params := rata.Params{
+		"team_name":   team.Name(),
+		"artifact_id": strconv.Itoa(artifactID),
+	}
+
+	err := team.connection.Send(internal.Request{
+		Header:      http.Header{"Content-Type": {"application/octet-stream"}},
+		RequestName: atc.PatchArtifact,
+		Params:      params,
+		Body:        src,
+	}, &internal.Response{
+		Result: &artifact,
+	})
+
+	switch err.(type) {
+	case nil:
+		return artifact, true, nil
+	case internal.ResourceNotFoundError:
+		return artifact, false, nil
+	default:
+		return artifact, false, err
+	}
+}
@@ -86,4 +86,59 @@ var _ = Describe("ArtifactRepository", func() {
 			})
 		})
 	})
+
+	Describe("PatchArtifact", func() {
+		Context("when patching the artifact fails", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts/17/patch"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				_, _, err := team.PatchArtifact(17, bytes.NewBufferString("some-delta"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the artifact does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts/17/patch"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.PatchArtifact(17, bytes.NewBufferString("some-delta"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when patching the artifact succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts/17/patch"),
+						ghttp.VerifyHeader(http.Header{"Content-Type": {"application/octet-stream"}}),
+						ghttp.VerifyBody([]byte("some-delta")),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.WorkerArtifact{ID: 18}),
+					),
+				)
+			})
+
+			It("returns the new artifact", func() {
+				artifact, found, err := team.PatchArtifact(17, bytes.NewBufferString("some-delta"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(artifact.ID).To(Equal(18))
+			})
+		})
+	})
 })
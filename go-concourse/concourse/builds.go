@@ -3,6 +3,7 @@ package concourse
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -74,6 +75,35 @@ func (team *team) RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, bui
 	return build, err
 }
 
+func (team *team) RunJob(pipelineRef atc.PipelineRef, jobName string, request atc.RunJobRequest) (atc.Build, error) {
+	params := rata.Params{
+		"job_name":      jobName,
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(request)
+	if err != nil {
+		return atc.Build{}, fmt.Errorf("Unable to marshal run job request: %s", err)
+	}
+
+	var build atc.Build
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.RunJob,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Params: params,
+		Query:  pipelineRef.QueryParams(),
+		Body:   buffer,
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
 func (team *team) JobBuild(pipelineRef atc.PipelineRef, jobName, buildName string) (atc.Build, bool, error) {
 	params := rata.Params{
 		"job_name":      jobName,
@@ -160,6 +190,39 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+// ErrBuildNotAwaitingApproval is returned when approving or rejecting a build
+// which is not paused on an approval step.
+var ErrBuildNotAwaitingApproval = errors.New("build is not awaiting approval")
+
+func (client *client) ApproveBuild(buildID string, approval atc.BuildApproval) error {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	jsonBytes, err := json.Marshal(approval)
+	if err != nil {
+		return err
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.ApproveBuild,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch e := err.(type) {
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return ErrBuildNotAwaitingApproval
+		}
+
+		return err
+	default:
+		return err
+	}
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
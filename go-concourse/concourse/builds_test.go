@@ -137,6 +137,43 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("RunJob", func() {
+		var (
+			pipelineRef   atc.PipelineRef
+			expectedBuild atc.Build
+		)
+
+		BeforeEach(func() {
+			pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+			expectedBuild = atc.Build{
+				ID:     123,
+				Name:   "1",
+				Status: "started",
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/run", "vars.branch=%22master%22"),
+					ghttp.VerifyJSONRepresenting(atc.RunJobRequest{
+						Artifacts: map[string]int{"some-input": 42},
+						Vars:      map[string]interface{}{"some": "var"},
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("sends the artifacts and vars and returns the build", func() {
+			build, err := team.RunJob(pipelineRef, "myjob", atc.RunJobRequest{
+				Artifacts: map[string]int{"some-input": 42},
+				Vars:      map[string]interface{}{"some": "var"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
@@ -468,6 +505,55 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("ApproveBuild", func() {
+		var (
+			expectedURL = "/api/v1/builds/123/approval"
+			status      int
+			approveErr  error
+		)
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSONRepresenting(atc.BuildApproval{Approved: true, Comment: "lgtm"}),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+
+			approveErr = client.ApproveBuild("123", atc.BuildApproval{Approved: true, Comment: "lgtm"})
+		})
+
+		It("sends the approval to ATC", func() {
+			Expect(approveErr).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when the build is not awaiting approval", func() {
+			BeforeEach(func() {
+				status = http.StatusConflict
+			})
+
+			It("returns ErrBuildNotAwaitingApproval", func() {
+				Expect(approveErr).To(Equal(concourse.ErrBuildNotAwaitingApproval))
+			})
+		})
+
+		Context("when the user is not allowed to approve", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("returns the forbidden error", func() {
+				Expect(approveErr).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
@@ -20,11 +20,14 @@ type Client interface {
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
+	ApproveBuild(buildID string, approval atc.BuildApproval) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	RequestWorkerDiagnostics(workerName string) error
+	WorkerDiagnostics(workerName string) (atc.WorkerDiagnostics, bool, error)
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
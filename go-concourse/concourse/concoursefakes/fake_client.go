@@ -23,6 +23,18 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ApproveBuildStub        func(string, atc.BuildApproval) error
+	approveBuildMutex       sync.RWMutex
+	approveBuildArgsForCall []struct {
+		arg1 string
+		arg2 atc.BuildApproval
+	}
+	approveBuildReturns struct {
+		result1 error
+	}
+	approveBuildReturnsOnCall map[int]struct {
+		result1 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -243,6 +255,17 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RequestWorkerDiagnosticsStub        func(string) error
+	requestWorkerDiagnosticsMutex       sync.RWMutex
+	requestWorkerDiagnosticsArgsForCall []struct {
+		arg1 string
+	}
+	requestWorkerDiagnosticsReturns struct {
+		result1 error
+	}
+	requestWorkerDiagnosticsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -290,6 +313,21 @@ type FakeClient struct {
 		result1 atc.UserInfo
 		result2 error
 	}
+	WorkerDiagnosticsStub        func(string) (atc.WorkerDiagnostics, bool, error)
+	workerDiagnosticsMutex       sync.RWMutex
+	workerDiagnosticsArgsForCall []struct {
+		arg1 string
+	}
+	workerDiagnosticsReturns struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}
+	workerDiagnosticsReturnsOnCall map[int]struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -354,6 +392,67 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ApproveBuild(arg1 string, arg2 atc.BuildApproval) error {
+	fake.approveBuildMutex.Lock()
+	ret, specificReturn := fake.approveBuildReturnsOnCall[len(fake.approveBuildArgsForCall)]
+	fake.approveBuildArgsForCall = append(fake.approveBuildArgsForCall, struct {
+		arg1 string
+		arg2 atc.BuildApproval
+	}{arg1, arg2})
+	fake.recordInvocation("ApproveBuild", []interface{}{arg1, arg2})
+	fake.approveBuildMutex.Unlock()
+	if fake.ApproveBuildStub != nil {
+		return fake.ApproveBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approveBuildReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ApproveBuildCallCount() int {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	return len(fake.approveBuildArgsForCall)
+}
+
+func (fake *FakeClient) ApproveBuildCalls(stub func(string, atc.BuildApproval) error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = stub
+}
+
+func (fake *FakeClient) ApproveBuildArgsForCall(i int) (string, atc.BuildApproval) {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	argsForCall := fake.approveBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ApproveBuildReturns(result1 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	fake.approveBuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ApproveBuildReturnsOnCall(i int, result1 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	if fake.approveBuildReturnsOnCall == nil {
+		fake.approveBuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveBuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -1384,6 +1483,66 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RequestWorkerDiagnostics(arg1 string) error {
+	fake.requestWorkerDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.requestWorkerDiagnosticsReturnsOnCall[len(fake.requestWorkerDiagnosticsArgsForCall)]
+	fake.requestWorkerDiagnosticsArgsForCall = append(fake.requestWorkerDiagnosticsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RequestWorkerDiagnostics", []interface{}{arg1})
+	fake.requestWorkerDiagnosticsMutex.Unlock()
+	if fake.RequestWorkerDiagnosticsStub != nil {
+		return fake.RequestWorkerDiagnosticsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestWorkerDiagnosticsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RequestWorkerDiagnosticsCallCount() int {
+	fake.requestWorkerDiagnosticsMutex.RLock()
+	defer fake.requestWorkerDiagnosticsMutex.RUnlock()
+	return len(fake.requestWorkerDiagnosticsArgsForCall)
+}
+
+func (fake *FakeClient) RequestWorkerDiagnosticsCalls(stub func(string) error) {
+	fake.requestWorkerDiagnosticsMutex.Lock()
+	defer fake.requestWorkerDiagnosticsMutex.Unlock()
+	fake.RequestWorkerDiagnosticsStub = stub
+}
+
+func (fake *FakeClient) RequestWorkerDiagnosticsArgsForCall(i int) string {
+	fake.requestWorkerDiagnosticsMutex.RLock()
+	defer fake.requestWorkerDiagnosticsMutex.RUnlock()
+	argsForCall := fake.requestWorkerDiagnosticsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RequestWorkerDiagnosticsReturns(result1 error) {
+	fake.requestWorkerDiagnosticsMutex.Lock()
+	defer fake.requestWorkerDiagnosticsMutex.Unlock()
+	fake.RequestWorkerDiagnosticsStub = nil
+	fake.requestWorkerDiagnosticsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RequestWorkerDiagnosticsReturnsOnCall(i int, result1 error) {
+	fake.requestWorkerDiagnosticsMutex.Lock()
+	defer fake.requestWorkerDiagnosticsMutex.Unlock()
+	fake.RequestWorkerDiagnosticsStub = nil
+	if fake.requestWorkerDiagnosticsReturnsOnCall == nil {
+		fake.requestWorkerDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestWorkerDiagnosticsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1615,11 +1774,79 @@ func (fake *FakeClient) UserInfoReturnsOnCall(i int, result1 atc.UserInfo, resul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) WorkerDiagnostics(arg1 string) (atc.WorkerDiagnostics, bool, error) {
+	fake.workerDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.workerDiagnosticsReturnsOnCall[len(fake.workerDiagnosticsArgsForCall)]
+	fake.workerDiagnosticsArgsForCall = append(fake.workerDiagnosticsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("WorkerDiagnostics", []interface{}{arg1})
+	fake.workerDiagnosticsMutex.Unlock()
+	if fake.WorkerDiagnosticsStub != nil {
+		return fake.WorkerDiagnosticsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.workerDiagnosticsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) WorkerDiagnosticsCallCount() int {
+	fake.workerDiagnosticsMutex.RLock()
+	defer fake.workerDiagnosticsMutex.RUnlock()
+	return len(fake.workerDiagnosticsArgsForCall)
+}
+
+func (fake *FakeClient) WorkerDiagnosticsCalls(stub func(string) (atc.WorkerDiagnostics, bool, error)) {
+	fake.workerDiagnosticsMutex.Lock()
+	defer fake.workerDiagnosticsMutex.Unlock()
+	fake.WorkerDiagnosticsStub = stub
+}
+
+func (fake *FakeClient) WorkerDiagnosticsArgsForCall(i int) string {
+	fake.workerDiagnosticsMutex.RLock()
+	defer fake.workerDiagnosticsMutex.RUnlock()
+	argsForCall := fake.workerDiagnosticsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) WorkerDiagnosticsReturns(result1 atc.WorkerDiagnostics, result2 bool, result3 error) {
+	fake.workerDiagnosticsMutex.Lock()
+	defer fake.workerDiagnosticsMutex.Unlock()
+	fake.WorkerDiagnosticsStub = nil
+	fake.workerDiagnosticsReturns = struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) WorkerDiagnosticsReturnsOnCall(i int, result1 atc.WorkerDiagnostics, result2 bool, result3 error) {
+	fake.workerDiagnosticsMutex.Lock()
+	defer fake.workerDiagnosticsMutex.Unlock()
+	fake.WorkerDiagnosticsStub = nil
+	if fake.workerDiagnosticsReturnsOnCall == nil {
+		fake.workerDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerDiagnostics
+			result2 bool
+			result3 error
+		})
+	}
+	fake.workerDiagnosticsReturnsOnCall[i] = struct {
+		result1 atc.WorkerDiagnostics
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
@@ -1654,6 +1881,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.requestWorkerDiagnosticsMutex.RLock()
+	defer fake.requestWorkerDiagnosticsMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.teamMutex.RLock()
@@ -1662,6 +1891,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.uRLMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
+	fake.workerDiagnosticsMutex.RLock()
+	defer fake.workerDiagnosticsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -4,6 +4,7 @@ package concoursefakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -20,6 +21,22 @@ type FakeTeam struct {
 	aTCTeamReturnsOnCall map[int]struct {
 		result1 atc.Team
 	}
+	AnnotateResourceVersionStub        func(atc.PipelineRef, string, int, string) (bool, error)
+	annotateResourceVersionMutex       sync.RWMutex
+	annotateResourceVersionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	annotateResourceVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	annotateResourceVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ArchivePipelineStub        func(atc.PipelineRef) (bool, error)
 	archivePipelineMutex       sync.RWMutex
 	archivePipelineArgsForCall []struct {
@@ -277,6 +294,19 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DestroyWebhookStub        func(string) (bool, error)
+	destroyWebhookMutex       sync.RWMutex
+	destroyWebhookArgsForCall []struct {
+		arg1 string
+	}
+	destroyWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DisableResourceVersionStub        func(atc.PipelineRef, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
@@ -484,6 +514,18 @@ type FakeTeam struct {
 		result1 []atc.Volume
 		result2 error
 	}
+	ListWebhooksStub        func() ([]atc.TeamWebhook, error)
+	listWebhooksMutex       sync.RWMutex
+	listWebhooksArgsForCall []struct {
+	}
+	listWebhooksReturns struct {
+		result1 []atc.TeamWebhook
+		result2 error
+	}
+	listWebhooksReturnsOnCall map[int]struct {
+		result1 []atc.TeamWebhook
+		result2 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -505,6 +547,22 @@ type FakeTeam struct {
 	orderingPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PatchArtifactStub        func(int, io.Reader) (atc.WorkerArtifact, bool, error)
+	patchArtifactMutex       sync.RWMutex
+	patchArtifactArgsForCall []struct {
+		arg1 int
+		arg2 io.Reader
+	}
+	patchArtifactReturns struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}
+	patchArtifactReturnsOnCall map[int]struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}
 	PauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	pauseJobMutex       sync.RWMutex
 	pauseJobArgsForCall []struct {
@@ -547,6 +605,22 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	PinResourceVersionUntilStub        func(atc.PipelineRef, string, int, time.Time) (bool, error)
+	pinResourceVersionUntilMutex       sync.RWMutex
+	pinResourceVersionUntilArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 time.Time
+	}
+	pinResourceVersionUntilReturns struct {
+		result1 bool
+		result2 error
+	}
+	pinResourceVersionUntilReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PipelineStub        func(atc.PipelineRef) (atc.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -597,6 +671,38 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineConfigRevisionStub        func(atc.PipelineRef, int) (atc.Config, bool, error)
+	pipelineConfigRevisionMutex       sync.RWMutex
+	pipelineConfigRevisionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigRevisionReturns struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
+	pipelineConfigRevisionReturnsOnCall map[int]struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}
+	RemoveVersionAnnotationStub        func(atc.PipelineRef, string, int, string) (bool, error)
+	removeVersionAnnotationMutex       sync.RWMutex
+	removeVersionAnnotationArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	removeVersionAnnotationReturns struct {
+		result1 bool
+		result2 error
+	}
+	removeVersionAnnotationReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -680,6 +786,21 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RunJobStub        func(atc.PipelineRef, string, atc.RunJobRequest) (atc.Build, error)
+	runJobMutex       sync.RWMutex
+	runJobArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 atc.RunJobRequest
+	}
+	runJobReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	runJobReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	ScheduleJobStub        func(atc.PipelineRef, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -709,6 +830,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetWebhookStub        func(atc.TeamWebhook) (bool, error)
+	setWebhookMutex       sync.RWMutex
+	setWebhookArgsForCall []struct {
+		arg1 atc.TeamWebhook
+	}
+	setWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	setWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UnpauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -765,6 +899,22 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	WebhookDeliveriesStub        func(string, int) ([]atc.WebhookDelivery, bool, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -821,6 +971,72 @@ func (fake *FakeTeam) ATCTeamReturnsOnCall(i int, result1 atc.Team) {
 	}{result1}
 }
 
+func (fake *FakeTeam) AnnotateResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int, arg4 string) (bool, error) {
+	fake.annotateResourceVersionMutex.Lock()
+	ret, specificReturn := fake.annotateResourceVersionReturnsOnCall[len(fake.annotateResourceVersionArgsForCall)]
+	fake.annotateResourceVersionArgsForCall = append(fake.annotateResourceVersionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("AnnotateResourceVersion", []interface{}{arg1, arg2, arg3, arg4})
+	fake.annotateResourceVersionMutex.Unlock()
+	if fake.AnnotateResourceVersionStub != nil {
+		return fake.AnnotateResourceVersionStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.annotateResourceVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) AnnotateResourceVersionCallCount() int {
+	fake.annotateResourceVersionMutex.RLock()
+	defer fake.annotateResourceVersionMutex.RUnlock()
+	return len(fake.annotateResourceVersionArgsForCall)
+}
+
+func (fake *FakeTeam) AnnotateResourceVersionCalls(stub func(atc.PipelineRef, string, int, string) (bool, error)) {
+	fake.annotateResourceVersionMutex.Lock()
+	defer fake.annotateResourceVersionMutex.Unlock()
+	fake.AnnotateResourceVersionStub = stub
+}
+
+func (fake *FakeTeam) AnnotateResourceVersionArgsForCall(i int) (atc.PipelineRef, string, int, string) {
+	fake.annotateResourceVersionMutex.RLock()
+	defer fake.annotateResourceVersionMutex.RUnlock()
+	argsForCall := fake.annotateResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) AnnotateResourceVersionReturns(result1 bool, result2 error) {
+	fake.annotateResourceVersionMutex.Lock()
+	defer fake.annotateResourceVersionMutex.Unlock()
+	fake.AnnotateResourceVersionStub = nil
+	fake.annotateResourceVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) AnnotateResourceVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.annotateResourceVersionMutex.Lock()
+	defer fake.annotateResourceVersionMutex.Unlock()
+	fake.AnnotateResourceVersionStub = nil
+	if fake.annotateResourceVersionReturnsOnCall == nil {
+		fake.annotateResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.annotateResourceVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ArchivePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.archivePipelineMutex.Lock()
 	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
@@ -1940,6 +2156,69 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DestroyWebhook(arg1 string) (bool, error) {
+	fake.destroyWebhookMutex.Lock()
+	ret, specificReturn := fake.destroyWebhookReturnsOnCall[len(fake.destroyWebhookArgsForCall)]
+	fake.destroyWebhookArgsForCall = append(fake.destroyWebhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DestroyWebhook", []interface{}{arg1})
+	fake.destroyWebhookMutex.Unlock()
+	if fake.DestroyWebhookStub != nil {
+		return fake.DestroyWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyWebhookCallCount() int {
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
+	return len(fake.destroyWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyWebhookCalls(stub func(string) (bool, error)) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = stub
+}
+
+func (fake *FakeTeam) DestroyWebhookArgsForCall(i int) string {
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
+	argsForCall := fake.destroyWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyWebhookReturns(result1 bool, result2 error) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = nil
+	fake.destroyWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = nil
+	if fake.destroyWebhookReturnsOnCall == nil {
+		fake.destroyWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DisableResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
@@ -2879,6 +3158,61 @@ func (fake *FakeTeam) ListVolumesReturnsOnCall(i int, result1 []atc.Volume, resu
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListWebhooks() ([]atc.TeamWebhook, error) {
+	fake.listWebhooksMutex.Lock()
+	ret, specificReturn := fake.listWebhooksReturnsOnCall[len(fake.listWebhooksArgsForCall)]
+	fake.listWebhooksArgsForCall = append(fake.listWebhooksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListWebhooks", []interface{}{})
+	fake.listWebhooksMutex.Unlock()
+	if fake.ListWebhooksStub != nil {
+		return fake.ListWebhooksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listWebhooksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListWebhooksCallCount() int {
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
+	return len(fake.listWebhooksArgsForCall)
+}
+
+func (fake *FakeTeam) ListWebhooksCalls(stub func() ([]atc.TeamWebhook, error)) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = stub
+}
+
+func (fake *FakeTeam) ListWebhooksReturns(result1 []atc.TeamWebhook, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	fake.listWebhooksReturns = struct {
+		result1 []atc.TeamWebhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListWebhooksReturnsOnCall(i int, result1 []atc.TeamWebhook, result2 error) {
+	fake.listWebhooksMutex.Lock()
+	defer fake.listWebhooksMutex.Unlock()
+	fake.ListWebhooksStub = nil
+	if fake.listWebhooksReturnsOnCall == nil {
+		fake.listWebhooksReturnsOnCall = make(map[int]struct {
+			result1 []atc.TeamWebhook
+			result2 error
+		})
+	}
+	fake.listWebhooksReturnsOnCall[i] = struct {
+		result1 []atc.TeamWebhook
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2996,6 +3330,73 @@ func (fake *FakeTeam) OrderingPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) PatchArtifact(arg1 int, arg2 io.Reader) (atc.WorkerArtifact, bool, error) {
+	fake.patchArtifactMutex.Lock()
+	ret, specificReturn := fake.patchArtifactReturnsOnCall[len(fake.patchArtifactArgsForCall)]
+	fake.patchArtifactArgsForCall = append(fake.patchArtifactArgsForCall, struct {
+		arg1 int
+		arg2 io.Reader
+	}{arg1, arg2})
+	fake.recordInvocation("PatchArtifact", []interface{}{arg1, arg2})
+	fake.patchArtifactMutex.Unlock()
+	if fake.PatchArtifactStub != nil {
+		return fake.PatchArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.patchArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PatchArtifactCallCount() int {
+	fake.patchArtifactMutex.RLock()
+	defer fake.patchArtifactMutex.RUnlock()
+	return len(fake.patchArtifactArgsForCall)
+}
+
+func (fake *FakeTeam) PatchArtifactCalls(stub func(int, io.Reader) (atc.WorkerArtifact, bool, error)) {
+	fake.patchArtifactMutex.Lock()
+	defer fake.patchArtifactMutex.Unlock()
+	fake.PatchArtifactStub = stub
+}
+
+func (fake *FakeTeam) PatchArtifactArgsForCall(i int) (int, io.Reader) {
+	fake.patchArtifactMutex.RLock()
+	defer fake.patchArtifactMutex.RUnlock()
+	argsForCall := fake.patchArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PatchArtifactReturns(result1 atc.WorkerArtifact, result2 bool, result3 error) {
+	fake.patchArtifactMutex.Lock()
+	defer fake.patchArtifactMutex.Unlock()
+	fake.PatchArtifactStub = nil
+	fake.patchArtifactReturns = struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PatchArtifactReturnsOnCall(i int, result1 atc.WorkerArtifact, result2 bool, result3 error) {
+	fake.patchArtifactMutex.Lock()
+	defer fake.patchArtifactMutex.Unlock()
+	fake.PatchArtifactStub = nil
+	if fake.patchArtifactReturnsOnCall == nil {
+		fake.patchArtifactReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerArtifact
+			result2 bool
+			result3 error
+		})
+	}
+	fake.patchArtifactReturnsOnCall[i] = struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) PauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.pauseJobMutex.Lock()
 	ret, specificReturn := fake.pauseJobReturnsOnCall[len(fake.pauseJobArgsForCall)]
@@ -3188,6 +3589,72 @@ func (fake *FakeTeam) PinResourceVersionReturnsOnCall(i int, result1 bool, resul
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) PinResourceVersionUntil(arg1 atc.PipelineRef, arg2 string, arg3 int, arg4 time.Time) (bool, error) {
+	fake.pinResourceVersionUntilMutex.Lock()
+	ret, specificReturn := fake.pinResourceVersionUntilReturnsOnCall[len(fake.pinResourceVersionUntilArgsForCall)]
+	fake.pinResourceVersionUntilArgsForCall = append(fake.pinResourceVersionUntilArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("PinResourceVersionUntil", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pinResourceVersionUntilMutex.Unlock()
+	if fake.PinResourceVersionUntilStub != nil {
+		return fake.PinResourceVersionUntilStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pinResourceVersionUntilReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PinResourceVersionUntilCallCount() int {
+	fake.pinResourceVersionUntilMutex.RLock()
+	defer fake.pinResourceVersionUntilMutex.RUnlock()
+	return len(fake.pinResourceVersionUntilArgsForCall)
+}
+
+func (fake *FakeTeam) PinResourceVersionUntilCalls(stub func(atc.PipelineRef, string, int, time.Time) (bool, error)) {
+	fake.pinResourceVersionUntilMutex.Lock()
+	defer fake.pinResourceVersionUntilMutex.Unlock()
+	fake.PinResourceVersionUntilStub = stub
+}
+
+func (fake *FakeTeam) PinResourceVersionUntilArgsForCall(i int) (atc.PipelineRef, string, int, time.Time) {
+	fake.pinResourceVersionUntilMutex.RLock()
+	defer fake.pinResourceVersionUntilMutex.RUnlock()
+	argsForCall := fake.pinResourceVersionUntilArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) PinResourceVersionUntilReturns(result1 bool, result2 error) {
+	fake.pinResourceVersionUntilMutex.Lock()
+	defer fake.pinResourceVersionUntilMutex.Unlock()
+	fake.PinResourceVersionUntilStub = nil
+	fake.pinResourceVersionUntilReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PinResourceVersionUntilReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pinResourceVersionUntilMutex.Lock()
+	defer fake.pinResourceVersionUntilMutex.Unlock()
+	fake.PinResourceVersionUntilStub = nil
+	if fake.pinResourceVersionUntilReturnsOnCall == nil {
+		fake.pinResourceVersionUntilReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pinResourceVersionUntilReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (atc.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -3393,6 +3860,139 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineConfigRevision(arg1 atc.PipelineRef, arg2 int) (atc.Config, bool, error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigRevisionReturnsOnCall[len(fake.pipelineConfigRevisionArgsForCall)]
+	fake.pipelineConfigRevisionArgsForCall = append(fake.pipelineConfigRevisionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineConfigRevision", []interface{}{arg1, arg2})
+	fake.pipelineConfigRevisionMutex.Unlock()
+	if fake.PipelineConfigRevisionStub != nil {
+		return fake.PipelineConfigRevisionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigRevisionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionCallCount() int {
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	return len(fake.pipelineConfigRevisionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionCalls(stub func(atc.PipelineRef, int) (atc.Config, bool, error)) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionReturns(result1 atc.Config, result2 bool, result3 error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = nil
+	fake.pipelineConfigRevisionReturns = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionReturnsOnCall(i int, result1 atc.Config, result2 bool, result3 error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = nil
+	if fake.pipelineConfigRevisionReturnsOnCall == nil {
+		fake.pipelineConfigRevisionReturnsOnCall = make(map[int]struct {
+			result1 atc.Config
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigRevisionReturnsOnCall[i] = struct {
+		result1 atc.Config
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) RemoveVersionAnnotation(arg1 atc.PipelineRef, arg2 string, arg3 int, arg4 string) (bool, error) {
+	fake.removeVersionAnnotationMutex.Lock()
+	ret, specificReturn := fake.removeVersionAnnotationReturnsOnCall[len(fake.removeVersionAnnotationArgsForCall)]
+	fake.removeVersionAnnotationArgsForCall = append(fake.removeVersionAnnotationArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RemoveVersionAnnotation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeVersionAnnotationMutex.Unlock()
+	if fake.RemoveVersionAnnotationStub != nil {
+		return fake.RemoveVersionAnnotationStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeVersionAnnotationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RemoveVersionAnnotationCallCount() int {
+	fake.removeVersionAnnotationMutex.RLock()
+	defer fake.removeVersionAnnotationMutex.RUnlock()
+	return len(fake.removeVersionAnnotationArgsForCall)
+}
+
+func (fake *FakeTeam) RemoveVersionAnnotationCalls(stub func(atc.PipelineRef, string, int, string) (bool, error)) {
+	fake.removeVersionAnnotationMutex.Lock()
+	defer fake.removeVersionAnnotationMutex.Unlock()
+	fake.RemoveVersionAnnotationStub = stub
+}
+
+func (fake *FakeTeam) RemoveVersionAnnotationArgsForCall(i int) (atc.PipelineRef, string, int, string) {
+	fake.removeVersionAnnotationMutex.RLock()
+	defer fake.removeVersionAnnotationMutex.RUnlock()
+	argsForCall := fake.removeVersionAnnotationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) RemoveVersionAnnotationReturns(result1 bool, result2 error) {
+	fake.removeVersionAnnotationMutex.Lock()
+	defer fake.removeVersionAnnotationMutex.Unlock()
+	fake.RemoveVersionAnnotationStub = nil
+	fake.removeVersionAnnotationReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RemoveVersionAnnotationReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.removeVersionAnnotationMutex.Lock()
+	defer fake.removeVersionAnnotationMutex.Unlock()
+	fake.RemoveVersionAnnotationStub = nil
+	if fake.removeVersionAnnotationReturnsOnCall == nil {
+		fake.removeVersionAnnotationReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.removeVersionAnnotationReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3731,6 +4331,71 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RunJob(arg1 atc.PipelineRef, arg2 string, arg3 atc.RunJobRequest) (atc.Build, error) {
+	fake.runJobMutex.Lock()
+	ret, specificReturn := fake.runJobReturnsOnCall[len(fake.runJobArgsForCall)]
+	fake.runJobArgsForCall = append(fake.runJobArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 atc.RunJobRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RunJob", []interface{}{arg1, arg2, arg3})
+	fake.runJobMutex.Unlock()
+	if fake.RunJobStub != nil {
+		return fake.RunJobStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.runJobReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RunJobCallCount() int {
+	fake.runJobMutex.RLock()
+	defer fake.runJobMutex.RUnlock()
+	return len(fake.runJobArgsForCall)
+}
+
+func (fake *FakeTeam) RunJobCalls(stub func(atc.PipelineRef, string, atc.RunJobRequest) (atc.Build, error)) {
+	fake.runJobMutex.Lock()
+	defer fake.runJobMutex.Unlock()
+	fake.RunJobStub = stub
+}
+
+func (fake *FakeTeam) RunJobArgsForCall(i int) (atc.PipelineRef, string, atc.RunJobRequest) {
+	fake.runJobMutex.RLock()
+	defer fake.runJobMutex.RUnlock()
+	argsForCall := fake.runJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) RunJobReturns(result1 atc.Build, result2 error) {
+	fake.runJobMutex.Lock()
+	defer fake.runJobMutex.Unlock()
+	fake.RunJobStub = nil
+	fake.runJobReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RunJobReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.runJobMutex.Lock()
+	defer fake.runJobMutex.Unlock()
+	fake.RunJobStub = nil
+	if fake.runJobReturnsOnCall == nil {
+		fake.runJobReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.runJobReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -3860,6 +4525,69 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetWebhook(arg1 atc.TeamWebhook) (bool, error) {
+	fake.setWebhookMutex.Lock()
+	ret, specificReturn := fake.setWebhookReturnsOnCall[len(fake.setWebhookArgsForCall)]
+	fake.setWebhookArgsForCall = append(fake.setWebhookArgsForCall, struct {
+		arg1 atc.TeamWebhook
+	}{arg1})
+	fake.recordInvocation("SetWebhook", []interface{}{arg1})
+	fake.setWebhookMutex.Unlock()
+	if fake.SetWebhookStub != nil {
+		return fake.SetWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SetWebhookCallCount() int {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	return len(fake.setWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) SetWebhookCalls(stub func(atc.TeamWebhook) (bool, error)) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = stub
+}
+
+func (fake *FakeTeam) SetWebhookArgsForCall(i int) atc.TeamWebhook {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	argsForCall := fake.setWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetWebhookReturns(result1 bool, result2 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	fake.setWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SetWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	if fake.setWebhookReturnsOnCall == nil {
+		fake.setWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -4117,11 +4845,80 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) WebhookDeliveries(arg1 string, arg2 int) ([]atc.WebhookDelivery, bool, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1, arg2})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCalls(stub func(string, int) ([]atc.WebhookDelivery, bool, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) WebhookDeliveriesArgsForCall(i int) (string, int) {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 bool, result3 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 bool, result3 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.aTCTeamMutex.RLock()
 	defer fake.aTCTeamMutex.RUnlock()
+	fake.annotateResourceVersionMutex.RLock()
+	defer fake.annotateResourceVersionMutex.RUnlock()
 	fake.archivePipelineMutex.RLock()
 	defer fake.archivePipelineMutex.RUnlock()
 	fake.authMutex.RLock()
@@ -4156,6 +4953,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
@@ -4186,22 +4985,32 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listResourcesMutex.RUnlock()
 	fake.listVolumesMutex.RLock()
 	defer fake.listVolumesMutex.RUnlock()
+	fake.listWebhooksMutex.RLock()
+	defer fake.listWebhooksMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
+	fake.patchArtifactMutex.RLock()
+	defer fake.patchArtifactMutex.RUnlock()
 	fake.pauseJobMutex.RLock()
 	defer fake.pauseJobMutex.RUnlock()
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
 	fake.pinResourceVersionMutex.RLock()
 	defer fake.pinResourceVersionMutex.RUnlock()
+	fake.pinResourceVersionUntilMutex.RLock()
+	defer fake.pinResourceVersionUntilMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	fake.removeVersionAnnotationMutex.RLock()
+	defer fake.removeVersionAnnotationMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4212,10 +5021,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.runJobMutex.RLock()
+	defer fake.runJobMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -4224,6 +5037,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.unpinResourceMutex.RUnlock()
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
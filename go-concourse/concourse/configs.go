@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -44,6 +45,39 @@ func (team *team) PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, strin
 	}
 }
 
+// PipelineConfigRevision returns the config the pipeline had at the given
+// config version.
+func (team *team) PipelineConfigRevision(pipelineRef atc.PipelineRef, version int) (atc.Config, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	query := pipelineRef.QueryParams()
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set(atc.GetConfigVersion, strconv.Itoa(version))
+
+	var configResponse atc.ConfigResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetConfig,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &configResponse,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configResponse.Config, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.Config{}, false, nil
+	default:
+		return atc.Config{}, false, err
+	}
+}
+
 type ConfigWarning struct {
 	Type    string `json:"type"`
 	Message string `json:"message"`
@@ -170,6 +170,58 @@ var _ = Describe("ATC Handler Configs", func() {
 		})
 	})
 
+	Describe("PipelineConfigRevision", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/config"
+
+		Context("when the revision exists", func() {
+			var expectedConfig atc.Config
+
+			BeforeEach(func() {
+				expectedConfig = atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "some-job"},
+					},
+				}
+
+				pipelineRef = atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "master"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22&version=42"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: expectedConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+					),
+				)
+			})
+
+			It("returns the config of that revision", func() {
+				pipelineConfig, found, err := team.PipelineConfigRevision(pipelineRef, 42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipelineConfig).To(Equal(expectedConfig))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "version=42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigRevision(pipelineRef, 42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("CreateOrUpdatePipelineConfig", func() {
 		var (
 			expectedVersion string
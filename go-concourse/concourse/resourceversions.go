@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -62,6 +63,44 @@ func (team *team) PinResourceVersion(pipelineRef atc.PipelineRef, resourceName s
 	return team.sendResourceVersion(pipelineRef, resourceName, resourceVersionID, atc.PinResourceVersion)
 }
 
+func (team *team) PinResourceVersionUntil(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, expiresAt time.Time) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":              pipelineRef.Name,
+		"resource_name":              resourceName,
+		"resource_config_version_id": strconv.Itoa(resourceVersionID),
+		"team_name":                  team.Name(),
+	}
+
+	body := atc.PinVersionRequestBody{
+		ExpiresAt: expiresAt.Unix(),
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(body)
+	if err != nil {
+		return false, fmt.Errorf("Unable to marshal pin expiry: %s", err)
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.PinResourceVersion,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Params: params,
+		Query:  pipelineRef.QueryParams(),
+		Body:   buffer,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) UnpinResource(pipelineRef atc.PipelineRef, resourceName string) (bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineRef.Name,
@@ -123,6 +162,39 @@ func (team *team) SetPinComment(pipelineRef atc.PipelineRef, resourceName string
 
 }
 
+func (team *team) AnnotateResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error) {
+	return team.sendVersionAnnotation(pipelineRef, resourceName, resourceVersionID, label, atc.AnnotateResourceVersion)
+}
+
+func (team *team) RemoveVersionAnnotation(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error) {
+	return team.sendVersionAnnotation(pipelineRef, resourceName, resourceVersionID, label, atc.RemoveVersionAnnotation)
+}
+
+func (team *team) sendVersionAnnotation(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string, annotationReq string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":              pipelineRef.Name,
+		"resource_name":              resourceName,
+		"resource_config_version_id": strconv.Itoa(resourceVersionID),
+		"label":                      label,
+		"team_name":                  team.Name(),
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: annotationReq,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) sendResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, resourceVersionReq string) (bool, error) {
 	params := rata.Params{
 		"pipeline_name":              pipelineRef.Name,
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -420,6 +421,166 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 		})
 	})
 
+	Describe("PinResourceVersionUntil", func() {
+		var (
+			expectedStatus    int
+			pipelineName      = "banana"
+			resourceName      = "myresource"
+			resourceVersionID = 42
+			expiresAt         = time.Unix(1611500000, 0)
+			expectedURL       = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/versions/%s/pin", pipelineName, resourceName, strconv.Itoa(resourceVersionID))
+			expectedQuery     = "vars.branch=%22master%22"
+			pipelineRef       = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "master"}}
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL, expectedQuery),
+					ghttp.VerifyJSONRepresenting(atc.PinVersionRequestBody{ExpiresAt: 1611500000}),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("When the resource exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("pins the version with its expiry", func() {
+				pinned, err := team.PinResourceVersionUntil(pipelineRef, resourceName, resourceVersionID, expiresAt)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pinned).To(BeTrue())
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				pinned, err := team.PinResourceVersionUntil(pipelineRef, resourceName, resourceVersionID, expiresAt)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+
+		Context("when the expiry is rejected", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusBadRequest
+			})
+
+			It("returns an error", func() {
+				pinned, err := team.PinResourceVersionUntil(pipelineRef, resourceName, resourceVersionID, expiresAt)
+				Expect(err).To(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("AnnotateResourceVersion", func() {
+		var (
+			expectedStatus    int
+			pipelineName      = "banana"
+			resourceName      = "myresource"
+			resourceVersionID = 42
+			expectedURL       = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/versions/%d/annotations/qa-approved", pipelineName, resourceName, resourceVersionID)
+			expectedQuery     = "vars.branch=%22master%22"
+			pipelineRef       = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "master"}}
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL, expectedQuery),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the version exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("annotates the version", func() {
+				annotated, err := team.AnnotateResourceVersion(pipelineRef, resourceName, resourceVersionID, "qa-approved")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(annotated).To(BeTrue())
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				annotated, err := team.AnnotateResourceVersion(pipelineRef, resourceName, resourceVersionID, "qa-approved")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(annotated).To(BeFalse())
+			})
+		})
+
+		Context("when the label is rejected", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusBadRequest
+			})
+
+			It("returns an error", func() {
+				_, err := team.AnnotateResourceVersion(pipelineRef, resourceName, resourceVersionID, "qa-approved")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RemoveVersionAnnotation", func() {
+		var (
+			expectedStatus    int
+			pipelineName      = "banana"
+			resourceName      = "myresource"
+			resourceVersionID = 42
+			expectedURL       = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/versions/%d/annotations/qa-approved", pipelineName, resourceName, resourceVersionID)
+			expectedQuery     = "vars.branch=%22master%22"
+			pipelineRef       = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "master"}}
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL, expectedQuery),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the version has the label", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNoContent
+			})
+
+			It("removes the label", func() {
+				removed, err := team.RemoveVersionAnnotation(pipelineRef, resourceName, resourceVersionID, "qa-approved")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(BeTrue())
+			})
+		})
+
+		Context("when the version does not have the label", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				removed, err := team.RemoveVersionAnnotation(pipelineRef, resourceName, resourceVersionID, "qa-approved")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("UnpinResource", func() {
 		var (
 			expectedStatus int
@@ -2,6 +2,7 @@ package concourse
 
 import (
 	"io"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -30,6 +31,7 @@ type Team interface {
 	RenamePipeline(oldName, newName string) (bool, []ConfigWarning, error)
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
+	PipelineConfigRevision(pipelineRef atc.PipelineRef, version int) (atc.Config, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
 
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
@@ -41,6 +43,7 @@ type Team interface {
 	JobBuilds(pipelineRef atc.PipelineRef, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
 	CreateJobBuild(pipelineRef atc.PipelineRef, jobName string) (atc.Build, error)
 	RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error)
+	RunJob(pipelineRef atc.PipelineRef, jobName string, request atc.RunJobRequest) (atc.Build, error)
 	ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error)
 	ScheduleJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
 
@@ -59,9 +62,13 @@ type Team interface {
 	EnableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
 
 	PinResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
+	PinResourceVersionUntil(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, expiresAt time.Time) (bool, error)
 	UnpinResource(pipelineRef atc.PipelineRef, resourceName string) (bool, error)
 	SetPinComment(pipelineRef atc.PipelineRef, resourceName string, comment string) (bool, error)
 
+	AnnotateResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error)
+	RemoveVersionAnnotation(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error)
+
 	BuildsWithVersionAsInput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 	BuildsWithVersionAsOutput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 
@@ -74,6 +81,12 @@ type Team interface {
 
 	CreateArtifact(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
+	PatchArtifact(int, io.Reader) (atc.WorkerArtifact, bool, error)
+
+	ListWebhooks() ([]atc.TeamWebhook, error)
+	SetWebhook(webhook atc.TeamWebhook) (bool, error)
+	DestroyWebhook(webhookName string) (bool, error)
+	WebhookDeliveries(webhookName string, limit int) ([]atc.WebhookDelivery, bool, error)
 }
 
 type team struct {
@@ -0,0 +1,114 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListWebhooks() ([]atc.TeamWebhook, error) {
+	var webhooks []atc.TeamWebhook
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamWebhooks,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &webhooks,
+	})
+
+	return webhooks, err
+}
+
+func (team *team) SetWebhook(webhook atc.TeamWebhook) (bool, error) {
+	jsonBytes, err := json.Marshal(webhook)
+	if err != nil {
+		return false, err
+	}
+
+	response, err := team.httpAgent.Send(internal.Request{
+		ReturnResponseBody: true,
+		RequestName:        atc.SetTeamWebhook,
+		Params: rata.Params{
+			"team_name":    team.Name(),
+			"webhook_name": webhook.Name,
+		},
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": {"application/json"}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	defer response.Body.Close()
+	body, _ := ioutil.ReadAll(response.Body)
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return response.StatusCode == http.StatusCreated, nil
+	case http.StatusBadRequest:
+		return false, GenericError{Message: string(body)}
+	case http.StatusForbidden:
+		return false, internal.ForbiddenError{
+			Reason: string(body),
+		}
+	default:
+		return false, internal.UnexpectedResponseError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Body:       string(body),
+		}
+	}
+}
+
+func (team *team) DestroyWebhook(webhookName string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyTeamWebhook,
+		Params: rata.Params{
+			"team_name":    team.Name(),
+			"webhook_name": webhookName,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) WebhookDeliveries(webhookName string, limit int) ([]atc.WebhookDelivery, bool, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.WebhookDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamWebhookDeliveries,
+		Params: rata.Params{
+			"team_name":    team.Name(),
+			"webhook_name": webhookName,
+		},
+		Query: query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
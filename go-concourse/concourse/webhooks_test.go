@@ -0,0 +1,174 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Webhooks", func() {
+	Describe("ListWebhooks", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/webhooks"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.TeamWebhook{
+						{Name: "some-webhook", Type: atc.WebhookTypeGitHub},
+					}),
+				),
+			)
+		})
+
+		It("returns the team's webhooks", func() {
+			webhooks, err := team.ListWebhooks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhooks).To(Equal([]atc.TeamWebhook{
+				{Name: "some-webhook", Type: atc.WebhookTypeGitHub},
+			}))
+		})
+	})
+
+	Describe("SetWebhook", func() {
+		var webhook atc.TeamWebhook
+
+		BeforeEach(func() {
+			webhook = atc.TeamWebhook{
+				Name:   "some-webhook",
+				Type:   atc.WebhookTypeGitHub,
+				Secret: "some-secret",
+			}
+		})
+
+		Context("when the webhook is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/webhooks/some-webhook"),
+						ghttp.VerifyJSONRepresenting(webhook),
+						ghttp.RespondWith(http.StatusCreated, `{"name":"some-webhook","type":"github"}`),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				created, err := team.SetWebhook(webhook)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+		})
+
+		Context("when the webhook is updated", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/webhooks/some-webhook"),
+						ghttp.RespondWith(http.StatusOK, `{"name":"some-webhook","type":"github"}`),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				created, err := team.SetWebhook(webhook)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+
+		Context("when the webhook is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/webhooks/some-webhook"),
+						ghttp.RespondWith(http.StatusBadRequest, "webhook secret must not be empty"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				_, err := team.SetWebhook(webhook)
+				Expect(err).To(Equal(concourse.GenericError{Message: "webhook secret must not be empty"}))
+			})
+		})
+	})
+
+	Describe("DestroyWebhook", func() {
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/webhooks/some-webhook"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				found, err := team.DestroyWebhook("some-webhook")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/webhooks/some-webhook"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := team.DestroyWebhook("some-webhook")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("WebhookDeliveries", func() {
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/webhooks/some-webhook/deliveries", "limit=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WebhookDelivery{
+							{ID: 1, Webhook: "some-webhook", StatusCode: 200},
+						}),
+					),
+				)
+			})
+
+			It("returns the deliveries", func() {
+				deliveries, found, err := team.WebhookDeliveries("some-webhook", 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal([]atc.WebhookDelivery{
+					{ID: 1, Webhook: "some-webhook", StatusCode: 200},
+				}))
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/webhooks/some-webhook/deliveries"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.WebhookDeliveries("some-webhook", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
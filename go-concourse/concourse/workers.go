@@ -92,3 +92,34 @@ func (client *client) LandWorker(workerName string) error {
 
 	return err
 }
+
+func (client *client) RequestWorkerDiagnostics(workerName string) error {
+	params := rata.Params{"worker_name": workerName}
+	return client.connection.Send(internal.Request{
+		RequestName: atc.RequestWorkerDiagnostics,
+		Params:      params,
+	}, nil)
+}
+
+// WorkerDiagnostics returns the most recent diagnostics bundle reported by the
+// worker, or false if the worker has yet to report a requested bundle.
+func (client *client) WorkerDiagnostics(workerName string) (atc.WorkerDiagnostics, bool, error) {
+	params := rata.Params{"worker_name": workerName}
+
+	var diagnostics *atc.WorkerDiagnostics
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetWorkerDiagnostics,
+		Params:      params,
+	}, &internal.Response{
+		Result: &diagnostics,
+	})
+	if err != nil {
+		return atc.WorkerDiagnostics{}, false, err
+	}
+
+	if diagnostics == nil {
+		return atc.WorkerDiagnostics{}, false, nil
+	}
+
+	return *diagnostics, true, nil
+}
@@ -160,4 +160,103 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("RequestWorkerDiagnostics", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusAccepted, nil),
+					),
+				)
+			})
+
+			It("requests diagnostics from the worker", func() {
+				err := client.RequestWorkerDiagnostics("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.RequestWorkerDiagnostics("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("WorkerDiagnostics", func() {
+		Context("when the diagnostics have been reported", func() {
+			var expectedDiagnostics atc.WorkerDiagnostics
+
+			BeforeEach(func() {
+				expectedDiagnostics = atc.WorkerDiagnostics{
+					CollectedAt: 42,
+					Version:     "1.2.3",
+					Runtime:     "guardian",
+					Containers: []atc.WorkerDiagnosticsContainer{
+						{Handle: "some-handle", State: "active"},
+					},
+					Logs: []string{"some-log"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDiagnostics),
+					),
+				)
+			})
+
+			It("returns the diagnostics", func() {
+				diagnostics, ready, err := client.WorkerDiagnostics("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ready).To(BeTrue())
+				Expect(diagnostics).To(Equal(expectedDiagnostics))
+			})
+		})
+
+		Context("when the diagnostics have not been reported yet", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, ready, err := client.WorkerDiagnostics("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ready).To(BeFalse())
+			})
+		})
+
+		Context("when the worker does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/diagnostics"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, _, err := client.WorkerDiagnostics("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
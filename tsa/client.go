@@ -86,6 +86,30 @@ type RegisterOptions struct {
 	// The function must be careful not to take too long or become deadlocked, or
 	// else the SSH connection can starve.
 	HeartbeatedFunc func()
+
+	// ResourcePressureFunc and StalledReasonFunc, if configured, are called once
+	// upon registration and again after each heartbeat. Each sample is sent to
+	// the SSH gateway as a WorkerCondition, which it includes in the following
+	// heartbeat.
+	ResourcePressureFunc func() (atc.WorkerResourcePressure, error)
+	StalledReasonFunc    func() string
+
+	// DiagnosticsRequestedFunc is called whenever the ATC asks for a
+	// diagnostics bundle, which should be sent back with ReportDiagnostics.
+	//
+	// The function must be careful not to take too long or become deadlocked, or
+	// else the SSH connection can starve.
+	DiagnosticsRequestedFunc func()
+}
+
+// WorkerCondition is streamed by the worker to the SSH gateway for as long as
+// it is registered.
+type WorkerCondition struct {
+	ResourcePressure *atc.WorkerResourcePressure `json:"resource_pressure,omitempty"`
+
+	// A non-empty StalledReason indicates that the worker is unhealthy and
+	// should not have any new work placed on it.
+	StalledReason string `json:"stalled_reason,omitempty"`
 }
 
 // Register invokes the 'forward-worker' command, proxying traffic through the
@@ -131,8 +155,25 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 	eventsR, eventsW := io.Pipe()
 	defer eventsW.Close()
 
+	var conditionR io.Reader
+	sampleCondition := make(chan struct{}, 1)
+	if opts.ResourcePressureFunc != nil || opts.StalledReasonFunc != nil {
+		r, w := io.Pipe()
+		defer w.Close()
+
+		// take the first sample right away so the first heartbeat can report it;
+		// samples are only sent along with heartbeats, not the registration
+		sampleCondition <- struct{}{}
+
+		go reportCondition(logger, w, sampleCondition, opts)
+
+		conditionR = r
+	}
+
 	events := NewEventReader(eventsR)
 	go func() {
+		defer close(sampleCondition)
+
 		for {
 			ev, err := events.Next()
 			if err != nil {
@@ -153,14 +194,26 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 				if opts.HeartbeatedFunc != nil {
 					opts.HeartbeatedFunc()
 				}
+
+				select {
+				case sampleCondition <- struct{}{}:
+				default:
+					// a sample is already pending
+				}
+
+			case EventTypeDiagnosticsRequested:
+				if opts.DiagnosticsRequestedFunc != nil {
+					opts.DiagnosticsRequestedFunc()
+				}
 			}
 		}
 	}()
 
-	err = client.run(
+	err = client.runWithInput(
 		ctx,
 		sshClient,
 		"forward-worker --garden "+gardenForwardAddr+" --baggageclaim "+baggageclaimForwardAddr,
+		conditionR,
 		eventsW,
 	)
 	if err != nil {
@@ -276,6 +329,27 @@ func (client *Client) ReportContainers(ctx context.Context, handles []string) er
 	return client.run(ctx, sshClient, strings.Join(command, " "), os.Stdout)
 }
 
+// ReportDiagnostics invokes the 'report-diagnostics' command, sending a
+// diagnostics bundle requested by the ATC.
+func (client *Client) ReportDiagnostics(ctx context.Context, diagnostics atc.WorkerDiagnostics) error {
+	logger := lagerctx.FromContext(ctx)
+
+	sshClient, _, err := client.dial(ctx, 0)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		return err
+	}
+
+	defer sshClient.Close()
+
+	payload, err := json.Marshal(diagnostics)
+	if err != nil {
+		return err
+	}
+
+	return client.runWithInput(ctx, sshClient, ReportDiagnostics, bytes.NewBuffer(payload), os.Stdout)
+}
+
 // VolumesToDestroy invokes the 'sweep-volumes' command, returning a list of
 // handles to be destroyed.
 func (client *Client) VolumesToDestroy(ctx context.Context) ([]string, error) {
@@ -407,8 +481,45 @@ func (client *Client) checkHostKey(hostname string, remote net.Addr, remoteKey s
 	return errors.New("remote host public key mismatch")
 }
 
+func reportCondition(
+	logger lager.Logger,
+	w io.Writer,
+	samples <-chan struct{},
+	opts RegisterOptions,
+) {
+	enc := json.NewEncoder(w)
+
+	for range samples {
+		var condition WorkerCondition
+
+		if opts.ResourcePressureFunc != nil {
+			pressure, err := opts.ResourcePressureFunc()
+			if err != nil {
+				logger.Error("failed-to-sample-resource-pressure", err)
+			} else {
+				condition.ResourcePressure = &pressure
+			}
+		}
+
+		if opts.StalledReasonFunc != nil {
+			condition.StalledReason = opts.StalledReasonFunc()
+		}
+
+		err := enc.Encode(condition)
+		if err != nil {
+			// the session has gone away
+			return
+		}
+	}
+}
 
 func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, stdout io.Writer) error {
+	return client.runWithInput(ctx, sshClient, command, nil, stdout)
+}
+
+// runWithInput is like run, but continues to stream input to the command after
+// the worker payload.
+func (client *Client) runWithInput(ctx context.Context, sshClient *ssh.Client, command string, input io.Reader, stdout io.Writer) error {
 	argv := strings.Split(command, " ")
 	commandName := ""
 	if len(argv) > 0 {
@@ -432,7 +543,12 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
-	sess.Stdin = bytes.NewBuffer(workerPayload)
+	var stdin io.Reader = bytes.NewBuffer(workerPayload)
+	if input != nil {
+		stdin = io.MultiReader(stdin, input)
+	}
+
+	sess.Stdin = stdin
 	sess.Stdout = stdout
 	sess.Stderr = os.Stderr
 
@@ -473,6 +473,42 @@ var _ = Describe("Register", func() {
 			})
 		})
 
+		Context("when the worker reports its condition", func() {
+			BeforeEach(func() {
+				opts.ResourcePressureFunc = func() (atc.WorkerResourcePressure, error) {
+					return atc.WorkerResourcePressure{
+						CPULoad:        0.5,
+						MemoryPressure: 0.25,
+						FreeDiskBytes:  1024,
+						OpenFiles:      42,
+					}, nil
+				}
+
+				opts.StalledReasonFunc = func() string {
+					return "garden: connection refused"
+				}
+			})
+
+			It("includes the latest sample in heartbeats", func() {
+				Eventually(func() tsa.WorkerCondition {
+					worker := (<-heartbeated).worker
+
+					return tsa.WorkerCondition{
+						ResourcePressure: worker.ResourcePressure,
+						StalledReason:    worker.StalledReason,
+					}
+				}).Should(Equal(tsa.WorkerCondition{
+					ResourcePressure: &atc.WorkerResourcePressure{
+						CPULoad:        0.5,
+						MemoryPressure: 0.25,
+						FreeDiskBytes:  1024,
+						OpenFiles:      42,
+					},
+					StalledReason: "garden: connection refused",
+				}))
+			})
+		})
+
 		Context("when the ATC returns a 404 for the heartbeat", func() {
 			BeforeEach(func() {
 				atcServer.RouteToHandler("PUT", "/api/v1/workers/some-worker/heartbeat", func(w http.ResponseWriter, r *http.Request) {
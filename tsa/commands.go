@@ -9,5 +9,6 @@ const (
 
 	ReportContainers      = "report-containers"
 	ReportVolumes         = "report-volumes"
+	ReportDiagnostics     = "report-diagnostics"
 	ResourceActionMissing = "resource-type-missing"
 )
@@ -0,0 +1,61 @@
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/tedsuo/rata"
+)
+
+type DiagnosticsReporter struct {
+	ATCEndpoint *rata.RequestGenerator
+	HTTPClient  *http.Client
+}
+
+func (r *DiagnosticsReporter) Report(ctx context.Context, worker atc.Worker, diagnostics atc.WorkerDiagnostics) error {
+	logger := lagerctx.FromContext(ctx)
+
+	logger.Info("start")
+	defer logger.Info("end")
+
+	payload, err := json.Marshal(diagnostics)
+	if err != nil {
+		logger.Error("failed-to-encode-request-body", err)
+		return err
+	}
+
+	request, err := r.ATCEndpoint.CreateRequest(atc.ReportWorkerDiagnostics, rata.Params{
+		"worker_name": worker.Name,
+	}, bytes.NewBuffer(payload))
+	if err != nil {
+		logger.Error("failed-to-construct-request", err)
+		return err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := r.HTTPClient.Do(request)
+	if err != nil {
+		logger.Error("failed-to-report-diagnostics", err)
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		logger.Error("bad-response", nil, lager.Data{
+			"status-code": response.StatusCode,
+		})
+
+		b, _ := httputil.DumpResponse(response, true)
+		return fmt.Errorf("bad-response (%d): %s", response.StatusCode, string(b))
+	}
+
+	return nil
+}
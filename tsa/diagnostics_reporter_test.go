@@ -0,0 +1,103 @@
+package tsa_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/tsa"
+	"golang.org/x/oauth2"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("DiagnosticsReporter", func() {
+	var (
+		reporter *tsa.DiagnosticsReporter
+
+		ctx         context.Context
+		worker      atc.Worker
+		diagnostics atc.WorkerDiagnostics
+		fakeATC     *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		worker = atc.Worker{
+			Name: "some-worker",
+		}
+		diagnostics = atc.WorkerDiagnostics{
+			CollectedAt: 42,
+			Version:     "1.2.3",
+			Runtime:     "guardian",
+			Logs:        []string{"some-log"},
+		}
+		fakeATC = ghttp.NewServer()
+
+		atcEndpoint := rata.NewRequestGenerator(fakeATC.URL(), atc.Routes)
+
+		token := &oauth2.Token{TokenType: "Bearer", AccessToken: "yo"}
+		httpClient := oauth2.NewClient(oauth2.NoContext, oauth2.StaticTokenSource(token))
+
+		reporter = &tsa.DiagnosticsReporter{
+			ATCEndpoint: atcEndpoint,
+			HTTPClient:  httpClient,
+		}
+	})
+
+	AfterEach(func() {
+		fakeATC.Close()
+	})
+
+	It("sends the diagnostics to the ATC", func() {
+		fakeATC.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics/report"),
+			ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+			ghttp.VerifyJSONRepresenting(diagnostics),
+			ghttp.RespondWith(204, nil, nil),
+		))
+
+		err := reporter.Report(ctx, worker, diagnostics)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	Context("when the ATC responds with a 404", func() {
+		BeforeEach(func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics/report"),
+				ghttp.RespondWith(404, nil, nil),
+			))
+		})
+
+		It("errors", func() {
+			err := reporter.Report(ctx, worker, diagnostics)
+			Expect(err).To(HaveOccurred())
+
+			Expect(err).To(MatchError(ContainSubstring("404")))
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the ATC fails to save the diagnostics", func() {
+		BeforeEach(func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/diagnostics/report"),
+				ghttp.RespondWith(500, nil, nil),
+			))
+		})
+
+		It("errors", func() {
+			err := reporter.Report(ctx, worker, diagnostics)
+			Expect(err).To(HaveOccurred())
+
+			Expect(err).To(MatchError(ContainSubstring("500")))
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -8,8 +8,9 @@ import (
 type EventType string
 
 const (
-	EventTypeRegistered  EventType = "registered"
-	EventTypeHeartbeated EventType = "heartbeated"
+	EventTypeRegistered           EventType = "registered"
+	EventTypeHeartbeated          EventType = "heartbeated"
+	EventTypeDiagnosticsRequested EventType = "diagnostics-requested"
 )
 
 type Event struct {
@@ -34,6 +35,10 @@ func (w EventWriter) Heartbeated() error {
 	return w.enc.Encode(Event{Type: EventTypeHeartbeated})
 }
 
+func (w EventWriter) DiagnosticsRequested() error {
+	return w.enc.Encode(Event{Type: EventTypeDiagnosticsRequested})
+}
+
 type EventReader struct {
 	dec *json.Decoder
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -36,6 +37,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	condition  WorkerCondition
+	conditionL sync.Mutex
 }
 
 func NewHeartbeater(
@@ -65,6 +69,14 @@ func NewHeartbeater(
 	}
 }
 
+// SetCondition records the latest condition reported by the worker, to be
+// sent along with the next heartbeat.
+func (heartbeater *Heartbeater) SetCondition(condition WorkerCondition) {
+	heartbeater.conditionL.Lock()
+	heartbeater.condition = condition
+	heartbeater.conditionL.Unlock()
+}
+
 func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 	logger := lagerctx.FromContext(ctx)
 
@@ -239,6 +251,15 @@ func (heartbeater *Heartbeater) heartbeat(logger lager.Logger) HeartbeatStatus {
 		return HeartbeatStatusLanded
 	}
 
+	if workerInfo.DiagnosticsRequested {
+		logger.Info("diagnostics-requested")
+
+		err = heartbeater.eventWriter.DiagnosticsRequested()
+		if err != nil {
+			logger.Error("failed-to-emit-diagnostics-requested-event", err)
+		}
+	}
+
 	return HeartbeatStatusHealthy
 }
 
@@ -282,6 +303,11 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	heartbeater.conditionL.Lock()
+	registration.ResourcePressure = heartbeater.condition.ResourcePressure
+	registration.StalledReason = heartbeater.condition.StalledReason
+	heartbeater.conditionL.Unlock()
+
 	return registration, true
 }
 
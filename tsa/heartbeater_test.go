@@ -49,6 +49,7 @@ var _ = Describe("Heartbeater", func() {
 		fakeATC2               *ghttp.Server
 		httpClient             *http.Client
 		atcEndpointPicker      *tsafakes.FakeEndpointPicker
+		heartbeater            *Heartbeater
 		heartbeatErr           <-chan error
 
 		verifyRegister  http.HandlerFunc
@@ -153,7 +154,7 @@ var _ = Describe("Heartbeater", func() {
 	})
 
 	JustBeforeEach(func() {
-		heartbeater := NewHeartbeater(
+		heartbeater = NewHeartbeater(
 			fakeClock,
 			interval,
 			cprInterval,
@@ -262,6 +263,28 @@ var _ = Describe("Heartbeater", func() {
 					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
 				})
 
+				It("includes the latest condition reported by the worker", func() {
+					Eventually(registrations).Should(Receive())
+
+					pressure := atc.WorkerResourcePressure{
+						CPULoad:        0.5,
+						MemoryPressure: 0.25,
+						FreeDiskBytes:  1024,
+						OpenFiles:      42,
+					}
+					heartbeater.SetCondition(WorkerCondition{
+						ResourcePressure: &pressure,
+						StalledReason:    "garden: connection refused",
+					})
+
+					fakeClock.WaitForWatcherAndIncrement(interval)
+					expectedWorker.ActiveContainers = 5
+					expectedWorker.ActiveVolumes = 2
+					expectedWorker.ResourcePressure = &pressure
+					expectedWorker.StalledReason = "garden: connection refused"
+					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+
 				It("emits events", func() {
 					Eventually(registrations).Should(Receive())
 
@@ -311,6 +334,35 @@ var _ = Describe("Heartbeater", func() {
 			})
 		})
 
+		Context("when heartbeat returns that diagnostics were requested", func() {
+			BeforeEach(func() {
+				heartbeated := make(chan registration, 100)
+				heartbeats = heartbeated
+
+				fakeATC1.AppendHandlers(verifyRegister)
+				fakeATC2.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-name/heartbeat"),
+					func(w http.ResponseWriter, r *http.Request) {
+						heartbeated <- registration{}
+
+						json.NewEncoder(w).Encode(atc.Worker{
+							State:                "running",
+							DiagnosticsRequested: true,
+						})
+					},
+				))
+			})
+
+			It("emits a diagnostics-requested event", func() {
+				Eventually(registrations).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(interval)
+				Eventually(heartbeats).Should(Receive())
+
+				Eventually(clientWriter).Should(gbytes.Say(`{"event":"diagnostics-requested"}`))
+			})
+		})
+
 		Context("when the ATC doesn't respond to the first heartbeat", func() {
 			BeforeEach(func() {
 				fakeATC1.AppendHandlers(
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -31,8 +32,10 @@ type forwardWorkerRequest struct {
 func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
 	logger := lagerctx.FromContext(ctx)
 
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -91,6 +94,24 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 		tsa.NewEventWriter(channel),
 	)
 
+	// workers continue to report their condition after the initial
+	// registration payload; older workers just close their side
+	go func() {
+		for {
+			var condition tsa.WorkerCondition
+			err := decoder.Decode(&condition)
+			if err != nil {
+				if err != io.EOF {
+					logger.Error("failed-to-decode-worker-condition", err)
+				}
+
+				return
+			}
+
+			heartbeater.SetCondition(condition)
+		}
+	}()
+
 	err = heartbeater.Heartbeat(ctx)
 	if err != nil {
 		logger.Error("failed-to-heartbeat", err)
@@ -327,6 +348,35 @@ func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, cha
 	}).WorkerStatus(ctx, worker, tsa.ReportVolumes)
 }
 
+type reportDiagnosticsRequest struct {
+	server *server
+}
+
+func (req reportDiagnosticsRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
+	var worker atc.Worker
+	err := decoder.Decode(&worker)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTeam(state, worker); err != nil {
+		return err
+	}
+
+	var diagnostics atc.WorkerDiagnostics
+	err = decoder.Decode(&diagnostics)
+	if err != nil {
+		return err
+	}
+
+	return (&tsa.DiagnosticsReporter{
+		ATCEndpoint: req.server.atcEndpointPicker.Pick(),
+		HTTPClient:  req.server.httpClient,
+	}).Report(ctx, worker, diagnostics)
+}
+
 func gardenURL(addr string) string {
 	return fmt.Sprintf("http://%s", addr)
 }
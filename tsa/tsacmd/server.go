@@ -537,6 +537,10 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			server:        server,
 			volumeHandles: args,
 		}
+	case tsa.ReportDiagnostics:
+		req = reportDiagnosticsRequest{
+			server: server,
+		}
 	default:
 		return nil, "", fmt.Errorf("unknown command: %s", command)
 	}
@@ -880,6 +880,7 @@ sampleJob name passed =
           }
         ]
     , outputs = []
+    , dependsOn = []
     , groups = []
     }
 
@@ -999,6 +1000,7 @@ jobByName jobs job =
             , disableManualTrigger = False
             , inputs = []
             , outputs = []
+            , dependsOn = []
             , groups = []
             }
 
@@ -49,6 +49,7 @@ type StepTree
     | Put StepID
     | SetPipeline StepID
     | LoadVar StepID
+    | Approval StepID
     | ArtifactInput StepID
     | ArtifactOutput StepID
     | InParallel (Array StepTree)
@@ -271,6 +272,9 @@ activeStepIds model tree =
         LoadVar stepId ->
             [ stepId ]
 
+        Approval stepId ->
+            [ stepId ]
+
         InParallel trees ->
             List.concatMap (activeStepIds model) (Array.toList trees)
 
@@ -97,6 +97,9 @@ init hl resources plan =
         Concourse.BuildStepLoadVar _ ->
             step |> initBottom hl resources plan LoadVar
 
+        Concourse.BuildStepApproval _ ->
+            step |> initBottom hl resources plan Approval
+
         Concourse.BuildStepInParallel plans ->
             initMultiStep hl resources plan.id InParallel plans Nothing
 
@@ -505,6 +508,9 @@ viewTree session model tree depth =
         LoadVar stepId ->
             viewStep model session depth stepId
 
+        Approval stepId ->
+            viewStep model session depth stepId
+
         Try subTree ->
             viewTree session model subTree depth
 
@@ -1108,6 +1114,9 @@ viewStepHeader step =
         Concourse.BuildStepLoadVar name ->
             simpleHeader "load_var:" Nothing name
 
+        Concourse.BuildStepApproval name ->
+            simpleHeader "approval:" Nothing name
+
         Concourse.BuildStepCheck name ->
             simpleHeader "check:" Nothing name
 
@@ -1169,6 +1178,9 @@ stepName header =
         Concourse.BuildStepLoadVar name ->
             Just name
 
+        Concourse.BuildStepApproval name ->
+            Just name
+
         Concourse.BuildStepArtifactInput name ->
             Just name
 
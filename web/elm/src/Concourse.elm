@@ -370,6 +370,9 @@ mapBuildPlan fn plan =
                 BuildStepLoadVar _ ->
                     []
 
+                BuildStepApproval _ ->
+                    []
+
                 BuildStepArtifactInput _ ->
                     []
 
@@ -429,6 +432,7 @@ type BuildStep
     = BuildStepTask StepName
     | BuildStepSetPipeline StepName InstanceVars
     | BuildStepLoadVar StepName
+    | BuildStepApproval StepName
     | BuildStepArtifactInput StepName
     | BuildStepCheck StepName
     | BuildStepGet StepName (Maybe Version)
@@ -625,6 +629,8 @@ decodeBuildPlan =
                     lazy (\_ -> decodeBuildSetPipeline)
                 , Json.Decode.field "load_var" <|
                     lazy (\_ -> decodeBuildStepLoadVar)
+                , Json.Decode.field "approval" <|
+                    lazy (\_ -> decodeBuildStepApproval)
                 , Json.Decode.field "across" <|
                     lazy (\_ -> decodeBuildStepAcross)
                 ]
@@ -756,6 +762,12 @@ decodeBuildStepLoadVar =
         |> andMap (Json.Decode.field "name" Json.Decode.string)
 
 
+decodeBuildStepApproval : Json.Decode.Decoder BuildStep
+decodeBuildStepApproval =
+    Json.Decode.succeed BuildStepApproval
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
 decodeBuildStepAcross : Json.Decode.Decoder BuildStep
 decodeBuildStepAcross =
     Json.Decode.map BuildStepAcross
@@ -821,6 +833,7 @@ type alias Job =
     , disableManualTrigger : Bool
     , inputs : List JobInput
     , outputs : List JobOutput
+    , dependsOn : List JobName
     , groups : List String
     }
 
@@ -854,6 +867,7 @@ encodeJob job =
         , ( "disable_manual_trigger", job.disableManualTrigger |> Json.Encode.bool )
         , ( "inputs", job.inputs |> Json.Encode.list encodeJobInput )
         , ( "outputs", job.outputs |> Json.Encode.list encodeJobOutput )
+        , ( "depends_on", job.dependsOn |> Json.Encode.list Json.Encode.string )
         , ( "groups", job.groups |> Json.Encode.list Json.Encode.string )
         ]
 
@@ -873,6 +887,7 @@ decodeJob =
         |> andMap (defaultTo False <| Json.Decode.field "disable_manual_trigger" Json.Decode.bool)
         |> andMap (defaultTo [] <| Json.Decode.field "inputs" <| Json.Decode.list decodeJobInput)
         |> andMap (defaultTo [] <| Json.Decode.field "outputs" <| Json.Decode.list decodeJobOutput)
+        |> andMap (defaultTo [] <| Json.Decode.field "depends_on" <| Json.Decode.list Json.Decode.string)
         |> andMap (defaultTo [] <| Json.Decode.field "groups" <| Json.Decode.list Json.Decode.string)
 
 
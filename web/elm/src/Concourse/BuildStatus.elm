@@ -72,6 +72,9 @@ decodeBuildStatus =
                     "started" ->
                         Json.Decode.succeed BuildStatusStarted
 
+                    "awaiting_approval" ->
+                        Json.Decode.succeed BuildStatusStarted
+
                     "succeeded" ->
                         Json.Decode.succeed BuildStatusSucceeded
 
@@ -2225,6 +2225,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
     , disableManualTrigger = False
     , inputs = []
     , outputs = []
+    , dependsOn = []
     , groups = []
     }
 
@@ -2273,6 +2274,7 @@ circularJobs =
               }
             ]
       , outputs = []
+      , dependsOn = []
       , groups = []
       }
     , { name = "jobB"
@@ -2317,6 +2319,7 @@ circularJobs =
               }
             ]
       , outputs = []
+      , dependsOn = []
       , groups = []
       }
     ]
@@ -247,6 +247,7 @@ job pipelineID =
     , disableManualTrigger = False
     , inputs = []
     , outputs = []
+    , dependsOn = []
     , groups = []
     }
 
@@ -510,6 +510,22 @@ function createGraph(svg, jobs, resources) {
     }
   }
 
+  // populate job dependency edges
+  //
+  // jobs listed under depends_on connect directly, as they share no resource
+  for (var i in jobs) {
+    var job = jobs[i];
+    var id = jobNode(job.name);
+
+    for (var d in job.depends_on) {
+      var sourceJobNode = jobNode(job.depends_on[d]);
+
+      if (graph.node(sourceJobNode)) {
+        graph.addEdge(sourceJobNode, id, "depends-on-" + job.depends_on[d], {trigger: true});
+      }
+    }
+  }
+
   // populate unconstrained job inputs
   //
   // now that we know the rank, draw one unconstrained input per rank
@@ -7,8 +7,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -28,7 +30,30 @@ type Beacon struct {
 	LocalBaggageclaimNetwork string
 	LocalBaggageclaimAddr    string
 
-	drained int32
+	ResourcePressureFunc func() (atc.WorkerResourcePressure, error)
+
+	// HealthChecker is polled every HealthCheckInterval. After
+	// UnhealthyThreshold consecutive failures the worker reports itself as
+	// stalled, which causes the ATC to land it. Once HealthyThreshold
+	// consecutive checks succeed the worker reports itself as healthy again
+	// and returns to running. A zero UnhealthyThreshold disables this.
+	//
+	// Clock drives the health check ticker.
+	HealthChecker       HealthChecker
+	HealthCheckInterval time.Duration
+	UnhealthyThreshold  int
+	HealthyThreshold    int
+	Clock               clock.Clock
+
+	// DiagnosticsCollector, if configured, is used to collect a diagnostics
+	// bundle whenever the ATC requests one.
+	DiagnosticsCollector DiagnosticsCollector
+
+	drained              int32
+	reportingDiagnostics int32
+
+	stalledReason  string
+	stalledReasonL sync.Mutex
 }
 
 // total number of active registrations; all but one are "live", the rest
@@ -50,6 +75,9 @@ func (beacon *Beacon) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 	cwg := &countingWaitGroup{}
 	defer cwg.Wait()
 
+	healthWG := &sync.WaitGroup{}
+	defer healthWG.Wait()
+
 	rootCtx, cancelAll := context.WithCancel(lagerctx.NewContext(context.Background(), beacon.Logger))
 	defer cancelAll()
 
@@ -59,6 +87,11 @@ func (beacon *Beacon) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 	cwg.Add(1)
 	beacon.registerWorker(ctx, cwg, latestErrChan)
 
+	if beacon.HealthChecker != nil && beacon.UnhealthyThreshold > 0 {
+		healthWG.Add(1)
+		go beacon.monitorHealth(rootCtx, healthWG)
+	}
+
 	close(ready)
 
 	var retiring bool
@@ -173,6 +206,114 @@ func (beacon *Beacon) Drained() bool {
 	return atomic.LoadInt32(&beacon.drained) == 1
 }
 
+// StalledReason returns the most recent health check failure once the
+// unhealthy threshold has been reached, or an empty string if the worker is
+// healthy.
+func (beacon *Beacon) StalledReason() string {
+	beacon.stalledReasonL.Lock()
+	defer beacon.stalledReasonL.Unlock()
+
+	return beacon.stalledReason
+}
+
+func (beacon *Beacon) setStalledReason(reason string) {
+	beacon.stalledReasonL.Lock()
+	beacon.stalledReason = reason
+	beacon.stalledReasonL.Unlock()
+}
+
+// reportDiagnostics collects and reports a diagnostics bundle in the
+// background. Requests which arrive while a bundle is still being reported are
+// ignored; the ATC keeps asking until it receives one.
+func (beacon *Beacon) reportDiagnostics(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&beacon.reportingDiagnostics, 0, 1) {
+		return
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&beacon.reportingDiagnostics, 0)
+
+		logger := lagerctx.FromContext(ctx).Session("diagnostics")
+		ctx := lagerctx.NewContext(ctx, logger)
+
+		logger.Info("collecting")
+
+		diagnostics := beacon.DiagnosticsCollector.Collect(ctx)
+
+		err := beacon.Client.ReportDiagnostics(ctx, diagnostics)
+		if err != nil {
+			logger.Error("failed-to-report-diagnostics", err)
+			return
+		}
+
+		logger.Info("reported")
+	}()
+}
+
+func (beacon *Beacon) monitorHealth(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	logger := beacon.Logger.Session("health")
+
+	interval := beacon.HealthCheckInterval
+	if interval == 0 {
+		interval = 10 * time.Second
+	}
+
+	ticker := beacon.Clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	var failures, successes int
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C():
+			err := beacon.HealthChecker.Check(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				successes = 0
+				failures++
+
+				logger.Debug("failed-health-check", lager.Data{
+					"error":    err.Error(),
+					"failures": failures,
+				})
+
+				if failures < beacon.UnhealthyThreshold {
+					continue
+				}
+
+				if beacon.StalledReason() == "" {
+					logger.Error("landing-unhealthy-worker", err, lager.Data{
+						"failures": failures,
+					})
+				}
+
+				beacon.setStalledReason(err.Error())
+
+				continue
+			}
+
+			failures = 0
+			successes++
+
+			if beacon.StalledReason() != "" && successes >= beacon.HealthyThreshold {
+				logger.Info("worker-recovered", lager.Data{
+					"successes": successes,
+				})
+
+				beacon.setStalledReason("")
+			}
+		}
+	}
+}
+
 func (beacon *Beacon) registerWorker(
 	ctx context.Context,
 	cwg *countingWaitGroup,
@@ -182,6 +323,13 @@ func (beacon *Beacon) registerWorker(
 
 	once := &sync.Once{}
 
+	var diagnosticsRequested func()
+	if beacon.DiagnosticsCollector != nil {
+		diagnosticsRequested = func() {
+			beacon.reportDiagnostics(ctx)
+		}
+	}
+
 	registeredOrFailed := make(chan struct{})
 	go func() {
 		defer cwg.Done()
@@ -203,6 +351,11 @@ func (beacon *Beacon) registerWorker(
 			HeartbeatedFunc: func() {
 				logger.Debug("heartbeated")
 			},
+
+			ResourcePressureFunc: beacon.ResourcePressureFunc,
+			StalledReasonFunc:    beacon.StalledReason,
+
+			DiagnosticsRequestedFunc: diagnosticsRequested,
 		})
 
 		once.Do(func() { close(registeredOrFailed) })
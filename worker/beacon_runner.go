@@ -5,6 +5,7 @@ import (
 	"os/signal"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/tsa"
 	"github.com/tedsuo/ifrit"
@@ -19,6 +20,12 @@ func NewBeaconRunner(
 	connectionDrainTimeout time.Duration,
 	gardenAddr string,
 	baggageclaimAddr string,
+	workDir string,
+	healthChecker HealthChecker,
+	healthCheckInterval time.Duration,
+	unhealthyThreshold int,
+	healthyThreshold int,
+	diagnosticsCollector DiagnosticsCollector,
 ) ifrit.Runner {
 	signals := make(chan os.Signal, 2)
 	signal.Notify(signals, drainSignals...)
@@ -38,6 +45,16 @@ func NewBeaconRunner(
 
 		LocalBaggageclaimNetwork: "tcp",
 		LocalBaggageclaimAddr:    baggageclaimAddr,
+
+		ResourcePressureFunc: resourcePressureFunc(workDir),
+
+		HealthChecker:       healthChecker,
+		HealthCheckInterval: healthCheckInterval,
+		UnhealthyThreshold:  unhealthyThreshold,
+		HealthyThreshold:    healthyThreshold,
+		Clock:               clock.NewClock(),
+
+		DiagnosticsCollector: diagnosticsCollector,
 	}
 
 	return restart.Restarter{
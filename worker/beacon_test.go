@@ -7,7 +7,9 @@ import (
 	"syscall"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/concourse/worker"
 	"github.com/concourse/concourse/worker/workerfakes"
@@ -290,6 +292,119 @@ var _ = Describe("Beacon", func() {
 		})
 	})
 
+	Context("when health checking is configured", func() {
+		var (
+			fakeHealthChecker *workerfakes.FakeHealthChecker
+			fakeClock         *fakeclock.FakeClock
+		)
+
+		BeforeEach(func() {
+			fakeHealthChecker = new(workerfakes.FakeHealthChecker)
+			fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+			beacon.HealthChecker = fakeHealthChecker
+			beacon.HealthCheckInterval = time.Minute
+			beacon.UnhealthyThreshold = 3
+			beacon.HealthyThreshold = 2
+			beacon.Clock = fakeClock
+
+			fakeClient.RegisterStub = func(ctx context.Context, opts tsa.RegisterOptions) error {
+				opts.RegisteredFunc()
+				<-ctx.Done()
+				return nil
+			}
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+		})
+
+		check := func(times int) {
+			for i := 0; i < times; i++ {
+				checks := fakeHealthChecker.CheckCallCount()
+				fakeClock.WaitForWatcherAndIncrement(beacon.HealthCheckInterval)
+				Eventually(fakeHealthChecker.CheckCallCount).Should(Equal(checks + 1))
+			}
+		}
+
+		It("reports the stalled reason in the register options", func() {
+			Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+			_, opts := fakeClient.RegisterArgsForCall(0)
+			Expect(opts.StalledReasonFunc).ToNot(BeNil())
+			Expect(opts.StalledReasonFunc()).To(BeEmpty())
+		})
+
+		Context("when the health check keeps passing", func() {
+			It("does not report a stalled reason", func() {
+				check(5)
+				Expect(beacon.StalledReason()).To(BeEmpty())
+			})
+		})
+
+		Context("when the health check keeps failing", func() {
+			BeforeEach(func() {
+				fakeHealthChecker.CheckReturns(errors.New("garden: connection refused"))
+			})
+
+			It("reports the failure once the unhealthy threshold is reached", func() {
+				check(2)
+				Expect(beacon.StalledReason()).To(BeEmpty())
+
+				check(1)
+				Eventually(beacon.StalledReason).Should(Equal("garden: connection refused"))
+			})
+
+			It("does not exit", func() {
+				check(3)
+				Eventually(beacon.StalledReason).ShouldNot(BeEmpty())
+				Consistently(process.Wait()).ShouldNot(Receive())
+			})
+
+			Context("when the health check recovers", func() {
+				It("clears the stalled reason once the healthy threshold is reached", func() {
+					check(3)
+					Eventually(beacon.StalledReason).ShouldNot(BeEmpty())
+
+					fakeHealthChecker.CheckReturns(nil)
+
+					check(1)
+					Expect(beacon.StalledReason()).ToNot(BeEmpty())
+
+					check(1)
+					Eventually(beacon.StalledReason).Should(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the health check fails fewer times than the threshold", func() {
+			BeforeEach(func() {
+				fakeHealthChecker.CheckStub = func(context.Context) error {
+					if fakeHealthChecker.CheckCallCount()%3 == 0 {
+						return nil
+					}
+
+					return errors.New("baggageclaim: timeout")
+				}
+			})
+
+			It("does not report a stalled reason", func() {
+				check(9)
+				Expect(beacon.StalledReason()).To(BeEmpty())
+			})
+		})
+
+		Context("when the unhealthy threshold is zero", func() {
+			BeforeEach(func() {
+				beacon.UnhealthyThreshold = 0
+			})
+
+			It("does not check health", func() {
+				Consistently(fakeHealthChecker.CheckCallCount).Should(BeZero())
+			})
+		})
+	})
+
 	Context("when rebalancing is configured", func() {
 		BeforeEach(func() {
 			beacon.RebalanceInterval = 500 * time.Millisecond
@@ -412,6 +527,52 @@ var _ = Describe("Beacon", func() {
 		})
 	})
 
+	Context("when a diagnostics collector is configured", func() {
+		var fakeCollector *workerfakes.FakeDiagnosticsCollector
+
+		BeforeEach(func() {
+			fakeCollector = new(workerfakes.FakeDiagnosticsCollector)
+			fakeCollector.CollectReturns(atc.WorkerDiagnostics{Version: "1.2.3"})
+
+			beacon.DiagnosticsCollector = fakeCollector
+
+			fakeClient.RegisterStub = func(ctx context.Context, opts tsa.RegisterOptions) error {
+				opts.RegisteredFunc()
+				<-ctx.Done()
+				return nil
+			}
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+		})
+
+		It("reports diagnostics when they are requested", func() {
+			Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+			_, opts := fakeClient.RegisterArgsForCall(0)
+			Expect(opts.DiagnosticsRequestedFunc).ToNot(BeNil())
+
+			opts.DiagnosticsRequestedFunc()
+
+			Eventually(fakeClient.ReportDiagnosticsCallCount).Should(Equal(1))
+			_, diagnostics := fakeClient.ReportDiagnosticsArgsForCall(0)
+			Expect(diagnostics).To(Equal(atc.WorkerDiagnostics{Version: "1.2.3"}))
+		})
+
+		Context("when no diagnostics collector is configured", func() {
+			BeforeEach(func() {
+				beacon.DiagnosticsCollector = nil
+			})
+
+			It("does not subscribe to diagnostics requests", func() {
+				Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+				_, opts := fakeClient.RegisterArgsForCall(0)
+				Expect(opts.DiagnosticsRequestedFunc).To(BeNil())
+			})
+		})
+	})
+
 	Context("when registration succeeds", func() {
 		It("becomes ready", func() {
 			Consistently(process.Ready()).ShouldNot(Receive())
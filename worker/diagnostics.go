@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"context"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker/gclient"
+)
+
+//go:generate counterfeiter . DiagnosticsCollector
+
+type DiagnosticsCollector interface {
+	Collect(context.Context) atc.WorkerDiagnostics
+}
+
+type diagnosticsCollector struct {
+	gardenClient       gclient.Client
+	baggageclaimClient baggageclaim.Client
+
+	version    string
+	runtime    string
+	runtimeBin string
+	workDir    string
+
+	logs *LogBuffer
+}
+
+func NewDiagnosticsCollector(
+	gardenClient gclient.Client,
+	baggageclaimClient baggageclaim.Client,
+	version string,
+	runtime string,
+	runtimeBin string,
+	workDir string,
+	logs *LogBuffer,
+) DiagnosticsCollector {
+	return &diagnosticsCollector{
+		gardenClient:       gardenClient,
+		baggageclaimClient: baggageclaimClient,
+
+		version:    version,
+		runtime:    runtime,
+		runtimeBin: runtimeBin,
+		workDir:    workDir,
+
+		logs: logs,
+	}
+}
+
+const runtimeVersionTimeout = 10 * time.Second
+
+// Collect gathers as much of the bundle as it can; anything which fails is
+// recorded in Errors rather than failing the whole collection.
+func (collector *diagnosticsCollector) Collect(ctx context.Context) atc.WorkerDiagnostics {
+	logger := lagerctx.FromContext(ctx)
+
+	diagnostics := atc.WorkerDiagnostics{
+		CollectedAt: time.Now().Unix(),
+
+		Version: collector.version,
+		Runtime: collector.runtime,
+
+		Containers: []atc.WorkerDiagnosticsContainer{},
+		Volumes:    []atc.WorkerDiagnosticsVolume{},
+		Logs:       []string{},
+	}
+
+	addError := func(section string, err error) {
+		logger.Error("failed-to-collect-"+section, err)
+		diagnostics.Errors = append(diagnostics.Errors, section+": "+err.Error())
+	}
+
+	if collector.runtimeBin != "" {
+		version, err := collector.runtimeVersion(ctx)
+		if err != nil {
+			addError("runtime version", err)
+		} else {
+			diagnostics.RuntimeVersion = version
+		}
+	}
+
+	containers, err := collector.containers()
+	if err != nil {
+		addError("containers", err)
+	} else {
+		diagnostics.Containers = containers
+	}
+
+	volumes, err := collector.baggageclaimClient.ListVolumes(logger.Session("list-volumes"), nil)
+	if err != nil {
+		addError("volumes", err)
+	} else {
+		for _, volume := range volumes {
+			diagnostics.Volumes = append(diagnostics.Volumes, atc.WorkerDiagnosticsVolume{
+				Handle: volume.Handle(),
+				Path:   volume.Path(),
+			})
+		}
+
+		sort.Slice(diagnostics.Volumes, func(i, j int) bool {
+			return diagnostics.Volumes[i].Handle < diagnostics.Volumes[j].Handle
+		})
+	}
+
+	if collector.workDir != "" {
+		disk, err := diskUsage(collector.workDir)
+		if err != nil {
+			addError("disk", err)
+		} else {
+			diagnostics.Disk = disk
+		}
+	}
+
+	if collector.logs != nil {
+		diagnostics.Logs = collector.logs.Lines()
+	}
+
+	return diagnostics
+}
+
+func (collector *diagnosticsCollector) runtimeVersion(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, runtimeVersionTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, collector.runtimeBin, "--version").Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(strings.SplitN(string(output), "\n", 2)[0]), nil
+}
+
+func (collector *diagnosticsCollector) containers() ([]atc.WorkerDiagnosticsContainer, error) {
+	containers, err := collector.gardenClient.Containers(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	handles := make([]string, len(containers))
+	for i, container := range containers {
+		handles[i] = container.Handle()
+	}
+
+	sort.Strings(handles)
+
+	infos, err := collector.gardenClient.BulkInfo(handles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []atc.WorkerDiagnosticsContainer{}
+	for _, handle := range handles {
+		state := "unknown"
+		if entry, found := infos[handle]; found && entry.Err == nil {
+			state = entry.Info.State
+		}
+
+		result = append(result, atc.WorkerDiagnosticsContainer{
+			Handle: handle,
+			State:  state,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker/gclient"
+	"github.com/concourse/concourse/atc/worker/gclient/gclientfakes"
+	. "github.com/concourse/concourse/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiagnosticsCollector", func() {
+	var (
+		fakeGardenClient       *gclientfakes.FakeClient
+		fakeBaggageclaimClient *baggageclaimfakes.FakeClient
+		logs                   *LogBuffer
+		workDir                string
+
+		diagnostics atc.WorkerDiagnostics
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = new(gclientfakes.FakeClient)
+		fakeBaggageclaimClient = new(baggageclaimfakes.FakeClient)
+
+		logs = NewLogBuffer(10)
+		fmt.Fprintln(logs, "some-log")
+
+		var err error
+		workDir, err = ioutil.TempDir("", "diagnostics")
+		Expect(err).NotTo(HaveOccurred())
+
+		containerA := new(gclientfakes.FakeContainer)
+		containerA.HandleReturns("container-a")
+		containerB := new(gclientfakes.FakeContainer)
+		containerB.HandleReturns("container-b")
+		fakeGardenClient.ContainersReturns([]gclient.Container{containerB, containerA}, nil)
+		fakeGardenClient.BulkInfoReturns(map[string]garden.ContainerInfoEntry{
+			"container-a": {Info: garden.ContainerInfo{State: "active"}},
+			"container-b": {Err: garden.NewError("nope")},
+		}, nil)
+
+		volume := new(baggageclaimfakes.FakeVolume)
+		volume.HandleReturns("volume-a")
+		volume.PathReturns("/volumes/live/volume-a/volume")
+		fakeBaggageclaimClient.ListVolumesReturns(baggageclaim.Volumes{volume}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(workDir)
+	})
+
+	JustBeforeEach(func() {
+		collector := NewDiagnosticsCollector(
+			fakeGardenClient,
+			fakeBaggageclaimClient,
+			"1.2.3",
+			"guardian",
+			"",
+			workDir,
+			logs,
+		)
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		diagnostics = collector.Collect(ctx)
+	})
+
+	It("includes the worker's version and runtime", func() {
+		Expect(diagnostics.Version).To(Equal("1.2.3"))
+		Expect(diagnostics.Runtime).To(Equal("guardian"))
+		Expect(diagnostics.CollectedAt).NotTo(BeZero())
+	})
+
+	It("lists containers sorted by handle with their state", func() {
+		Expect(fakeGardenClient.BulkInfoArgsForCall(0)).To(Equal([]string{"container-a", "container-b"}))
+		Expect(diagnostics.Containers).To(Equal([]atc.WorkerDiagnosticsContainer{
+			{Handle: "container-a", State: "active"},
+			{Handle: "container-b", State: "unknown"},
+		}))
+	})
+
+	It("lists volumes", func() {
+		Expect(diagnostics.Volumes).To(Equal([]atc.WorkerDiagnosticsVolume{
+			{Handle: "volume-a", Path: "/volumes/live/volume-a/volume"},
+		}))
+	})
+
+	It("includes the recent logs", func() {
+		Expect(diagnostics.Logs).To(Equal([]string{"some-log"}))
+	})
+
+	It("does not report any errors", func() {
+		Expect(diagnostics.Errors).To(BeEmpty())
+	})
+
+	Context("when listing containers fails", func() {
+		BeforeEach(func() {
+			fakeGardenClient.ContainersReturns(nil, errors.New("garden is down"))
+		})
+
+		It("records the error and still collects the rest", func() {
+			Expect(diagnostics.Errors).To(ConsistOf("containers: garden is down"))
+			Expect(diagnostics.Containers).To(BeEmpty())
+			Expect(diagnostics.Volumes).To(HaveLen(1))
+		})
+	})
+
+	Context("when listing volumes fails", func() {
+		BeforeEach(func() {
+			fakeBaggageclaimClient.ListVolumesReturns(nil, errors.New("baggageclaim is down"))
+		})
+
+		It("records the error and still collects the rest", func() {
+			Expect(diagnostics.Errors).To(ConsistOf("volumes: baggageclaim is down"))
+			Expect(diagnostics.Volumes).To(BeEmpty())
+			Expect(diagnostics.Containers).To(HaveLen(2))
+		})
+	})
+})
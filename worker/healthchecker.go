@@ -2,12 +2,19 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 )
 
+//go:generate counterfeiter . HealthChecker
+
+type HealthChecker interface {
+	Check(context.Context) error
+}
+
 type healthChecker struct {
 	client           *http.Client
 	baggageclaimAddr string
@@ -43,24 +50,29 @@ func doRequest(ctx context.Context, url string) error {
 }
 
 func (h *healthChecker) CheckHealth(w http.ResponseWriter, req *http.Request) {
-	var err error
+	err := h.Check(context.Background())
+	if err != nil {
+		w.WriteHeader(503)
+		h.logger.Error("failed-health-check", err)
+		return
+	}
+}
 
-	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(h.timeout))
+// Check pings Garden and lists volumes on Baggageclaim, returning an error
+// describing the first one which did not respond in time.
+func (h *healthChecker) Check(ctx context.Context) error {
+	ctx, cancel := context.WithDeadline(ctx, time.Now().Add(h.timeout))
 	defer cancel()
 
-	err = doRequest(ctx, h.gardenAddr+"/ping")
+	err := doRequest(ctx, h.gardenAddr+"/ping")
 	if err != nil {
-		w.WriteHeader(503)
-		h.logger.Error("failed-to-ping-garden-server", err)
-		return
+		return fmt.Errorf("garden: %w", err)
 	}
 
 	err = doRequest(ctx, h.baggageclaimAddr+"/volumes")
 	if err != nil {
-		w.WriteHeader(503)
-		h.logger.Error("failed-to-list-volumes-on-baggageclaim-server", err)
-		return
+		return fmt.Errorf("baggageclaim: %w", err)
 	}
 
-	return
+	return nil
 }
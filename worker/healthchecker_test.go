@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"time"
@@ -114,3 +115,62 @@ var _ = Describe("CheckHealth", func() {
 		})
 	})
 })
+
+var _ = Describe("Check", func() {
+	var (
+		garden       *ghttp.Server
+		baggageclaim *ghttp.Server
+
+		hc       HealthChecker
+		checkErr error
+	)
+
+	BeforeEach(func() {
+		garden = ghttp.NewServer()
+		baggageclaim = ghttp.NewServer()
+
+		garden.AllowUnhandledRequests = true
+		baggageclaim.AllowUnhandledRequests = true
+
+		checker := NewHealthChecker(lagertest.NewTestLogger("healthchecker"),
+			"http://"+baggageclaim.Addr(), "http://"+garden.Addr(), 100*time.Millisecond)
+		hc = &checker
+	})
+
+	JustBeforeEach(func() {
+		checkErr = hc.Check(context.Background())
+	})
+
+	AfterEach(func() {
+		baggageclaim.Close()
+		garden.Close()
+	})
+
+	Context("having baggageclaim AND garden up", func() {
+		It("succeeds", func() {
+			Expect(checkErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("having garden down", func() {
+		BeforeEach(func() {
+			garden.Close()
+		})
+
+		It("returns an error naming garden", func() {
+			Expect(checkErr).To(HaveOccurred())
+			Expect(checkErr.Error()).To(HavePrefix("garden: "))
+		})
+	})
+
+	Context("having baggageclaim down", func() {
+		BeforeEach(func() {
+			baggageclaim.Close()
+		})
+
+		It("returns an error naming baggageclaim", func() {
+			Expect(checkErr).To(HaveOccurred())
+			Expect(checkErr.Error()).To(HavePrefix("baggageclaim: "))
+		})
+	})
+})
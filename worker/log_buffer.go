@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"strings"
+	"sync"
+)
+
+// LogBuffer retains the most recent lines written to it, so that they can be
+// included in diagnostics bundles.
+type LogBuffer struct {
+	size int
+
+	lines []string
+	lock  sync.Mutex
+}
+
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{
+		size: size,
+	}
+}
+
+func (buffer *LogBuffer) Write(p []byte) (int, error) {
+	buffer.lock.Lock()
+	defer buffer.lock.Unlock()
+
+	for _, line := range strings.Split(string(p), "\n") {
+		if line == "" {
+			continue
+		}
+
+		buffer.lines = append(buffer.lines, line)
+	}
+
+	if len(buffer.lines) > buffer.size {
+		buffer.lines = append([]string{}, buffer.lines[len(buffer.lines)-buffer.size:]...)
+	}
+
+	return len(p), nil
+}
+
+// Lines returns the retained lines, oldest first.
+func (buffer *LogBuffer) Lines() []string {
+	buffer.lock.Lock()
+	defer buffer.lock.Unlock()
+
+	return append([]string{}, buffer.lines...)
+}
@@ -0,0 +1,32 @@
+package worker_test
+
+import (
+	"fmt"
+
+	. "github.com/concourse/concourse/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogBuffer", func() {
+	var buffer *LogBuffer
+
+	BeforeEach(func() {
+		buffer = NewLogBuffer(3)
+	})
+
+	It("splits writes into lines", func() {
+		_, err := fmt.Fprint(buffer, "one\ntwo\n")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(buffer.Lines()).To(Equal([]string{"one", "two"}))
+	})
+
+	It("only retains the most recent lines", func() {
+		for i := 0; i < 5; i++ {
+			fmt.Fprintf(buffer, "line %d\n", i)
+		}
+
+		Expect(buffer.Lines()).To(Equal([]string{"line 2", "line 3", "line 4"}))
+	})
+})
@@ -0,0 +1,142 @@
+// +build linux
+
+package worker
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+)
+
+func resourcePressureFunc(workDir string) func() (atc.WorkerResourcePressure, error) {
+	return func() (atc.WorkerResourcePressure, error) {
+		return SampleResourcePressure("/proc", workDir)
+	}
+}
+
+// SampleResourcePressure reads the host's load average, memory usage and open
+// file count from procfs, and the free space available in workDir.
+func SampleResourcePressure(procDir string, workDir string) (atc.WorkerResourcePressure, error) {
+	var pressure atc.WorkerResourcePressure
+
+	load, err := readLoadAverage(procDir + "/loadavg")
+	if err != nil {
+		return atc.WorkerResourcePressure{}, err
+	}
+
+	pressure.CPULoad = load / float64(runtime.NumCPU())
+
+	pressure.MemoryPressure, err = readMemoryPressure(procDir + "/meminfo")
+	if err != nil {
+		return atc.WorkerResourcePressure{}, err
+	}
+
+	pressure.OpenFiles, err = readOpenFiles(procDir + "/sys/fs/file-nr")
+	if err != nil {
+		return atc.WorkerResourcePressure{}, err
+	}
+
+	var stat syscall.Statfs_t
+	err = syscall.Statfs(workDir, &stat)
+	if err != nil {
+		return atc.WorkerResourcePressure{}, fmt.Errorf("statfs %s: %w", workDir, err)
+	}
+
+	pressure.FreeDiskBytes = stat.Bavail * uint64(stat.Bsize)
+
+	return pressure, nil
+}
+
+func diskUsage(path string) (*atc.WorkerDiagnosticsDisk, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return nil, fmt.Errorf("statfs %s: %w", path, err)
+	}
+
+	return &atc.WorkerDiagnosticsDisk{
+		Path:       path,
+		TotalBytes: stat.Blocks * uint64(stat.Bsize),
+		FreeBytes:  stat.Bavail * uint64(stat.Bsize),
+	}, nil
+}
+
+func readLoadAverage(path string) (float64, error) {
+	fields, err := readFields(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+func readOpenFiles(path string) (int, error) {
+	fields, err := readFields(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(fields[0])
+}
+
+func readMemoryPressure(path string) (float64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	var total, available float64
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "MemTotal:":
+			total, err = strconv.ParseFloat(fields[1], 64)
+		case "MemAvailable:":
+			available, err = strconv.ParseFloat(fields[1], 64)
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf("parse %s: %w", path, err)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return 0, err
+	}
+
+	if total == 0 {
+		return 0, fmt.Errorf("no MemTotal in %s", path)
+	}
+
+	return 1 - available/total, nil
+}
+
+func readFields(path string) ([]string, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%s is empty", path)
+	}
+
+	return fields, nil
+}
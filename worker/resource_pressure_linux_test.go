@@ -0,0 +1,81 @@
+// +build linux
+
+package worker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SampleResourcePressure", func() {
+	var (
+		procDir string
+		workDir string
+
+		pressure  atc.WorkerResourcePressure
+		sampleErr error
+	)
+
+	writeProcFile := func(path string, contents string) {
+		fullPath := filepath.Join(procDir, path)
+		Expect(os.MkdirAll(filepath.Dir(fullPath), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(fullPath, []byte(contents), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		procDir, err = ioutil.TempDir("", "proc")
+		Expect(err).ToNot(HaveOccurred())
+
+		workDir, err = ioutil.TempDir("", "work-dir")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeProcFile("loadavg", "2.00 1.50 1.00 3/512 12345\n")
+		writeProcFile("meminfo", "MemTotal:       1000 kB\nMemFree:         100 kB\nMemAvailable:    250 kB\n")
+		writeProcFile("sys/fs/file-nr", "1234\t0\t9223372036854775807\n")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(procDir)).To(Succeed())
+		Expect(os.RemoveAll(workDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		pressure, sampleErr = SampleResourcePressure(procDir, workDir)
+	})
+
+	It("reports the host's resource pressure", func() {
+		Expect(sampleErr).ToNot(HaveOccurred())
+		Expect(pressure.CPULoad).To(BeNumerically("~", 2.0/float64(runtime.NumCPU())))
+		Expect(pressure.MemoryPressure).To(BeNumerically("~", 0.75))
+		Expect(pressure.OpenFiles).To(Equal(1234))
+		Expect(pressure.FreeDiskBytes).To(BeNumerically(">", 0))
+	})
+
+	Context("when meminfo has no total", func() {
+		BeforeEach(func() {
+			writeProcFile("meminfo", "MemFree:         100 kB\n")
+		})
+
+		It("errors", func() {
+			Expect(sampleErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when the work dir does not exist", func() {
+		BeforeEach(func() {
+			workDir = filepath.Join(workDir, "bogus")
+		})
+
+		It("errors", func() {
+			Expect(sampleErr).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,15 @@
+// +build !linux
+
+package worker
+
+import "github.com/concourse/concourse/atc"
+
+// resource pressure is only sampled on Linux; other platforms don't report it
+func resourcePressureFunc(workDir string) func() (atc.WorkerResourcePressure, error) {
+	return nil
+}
+
+// disk usage is likewise only reported on Linux
+func diskUsage(path string) (*atc.WorkerDiagnosticsDisk, error) {
+	return nil, nil
+}
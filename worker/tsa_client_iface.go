@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -20,4 +21,6 @@ type TSAClient interface {
 
 	ReportVolumes(context.Context, []string) error
 	VolumesToDestroy(context.Context) ([]string, error)
+
+	ReportDiagnostics(context.Context, atc.WorkerDiagnostics) error
 }
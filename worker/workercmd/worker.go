@@ -40,6 +40,10 @@ type WorkerCommand struct {
 	HealthcheckBindPort uint16        `long:"healthcheck-bind-port"  default:"8888"     description:"Port on which to listen for health checking requests."`
 	HealthCheckTimeout  time.Duration `long:"healthcheck-timeout"    default:"5s"       description:"HTTP timeout for the full duration of health checking."`
 
+	HealthCheckInterval           time.Duration `long:"healthcheck-interval"            default:"10s" description:"Interval on which the worker checks the health of its own Garden and Baggageclaim servers."`
+	HealthCheckUnhealthyThreshold int           `long:"healthcheck-unhealthy-threshold" default:"3"   description:"Number of consecutive failed health checks after which the worker is landed automatically. Set to 0 to disable."`
+	HealthCheckHealthyThreshold   int           `long:"healthcheck-healthy-threshold"   default:"2"   description:"Number of consecutive successful health checks after which an automatically landed worker returns to running."`
+
 	SweepInterval               time.Duration `long:"sweep-interval" default:"30s" description:"Interval on which containers and volumes will be garbage collected from the worker."`
 	VolumeSweeperMaxInFlight    uint16        `long:"volume-sweeper-max-in-flight" default:"3" description:"Maximum number of volumes which can be swept in parallel."`
 	ContainerSweeperMaxInFlight uint16        `long:"container-sweeper-max-in-flight" default:"5" description:"Maximum number of containers which can be swept in parallel."`
@@ -65,6 +69,9 @@ type WorkerCommand struct {
 	Logger flag.Lager
 }
 
+// number of recent log lines included in diagnostics bundles
+const diagnosticsLogLines = 500
+
 func (cmd *WorkerCommand) Execute(args []string) error {
 	runner, err := cmd.Runner(args)
 	if err != nil {
@@ -81,6 +88,10 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 
 	logger, _ := cmd.Logger.Logger("worker")
 
+	// keep the most recent logs around for diagnostics bundles
+	logBuffer := worker.NewLogBuffer(diagnosticsLogLines)
+	logger.RegisterSink(lager.NewPrettySink(logBuffer, lager.INFO))
+
 	atcWorker, gardenServerRunner, err := cmd.gardenServerRunner(logger.Session("garden"))
 	if err != nil {
 		return nil, err
@@ -102,15 +113,6 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 
 	tsaClient := cmd.TSA.Client(atcWorker)
 
-	beaconRunner := worker.NewBeaconRunner(
-		logger.Session("beacon-runner"),
-		tsaClient,
-		cmd.RebalanceInterval,
-		cmd.ConnectionDrainTimeout,
-		cmd.gardenAddr(),
-		cmd.baggageclaimAddr(),
-	)
-
 	gardenClient := gclient.BasicGardenClientWithRequestTimeout(
 		logger.Session("garden-connection"),
 		cmd.Guardian.RequestTimeout,
@@ -137,6 +139,36 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		},
 	)
 
+	runtime, runtimeBin := cmd.runtimeInfo()
+	if cmd.gardenServerIsExternal() {
+		runtime, runtimeBin = "external", ""
+	}
+
+	diagnosticsCollector := worker.NewDiagnosticsCollector(
+		gardenClient,
+		baggageclaimClient,
+		concourse.WorkerVersion,
+		runtime,
+		runtimeBin,
+		cmd.WorkDir.Path(),
+		logBuffer,
+	)
+
+	beaconRunner := worker.NewBeaconRunner(
+		logger.Session("beacon-runner"),
+		tsaClient,
+		cmd.RebalanceInterval,
+		cmd.ConnectionDrainTimeout,
+		cmd.gardenAddr(),
+		cmd.baggageclaimAddr(),
+		cmd.WorkDir.Path(),
+		&healthChecker,
+		cmd.HealthCheckInterval,
+		cmd.HealthCheckUnhealthyThreshold,
+		cmd.HealthCheckHealthyThreshold,
+		diagnosticsCollector,
+	)
+
 	containerSweeper := worker.NewContainerSweeper(
 		logger.Session("container-sweeper"),
 		cmd.SweepInterval,
@@ -114,6 +114,27 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 	return worker, runner, nil
 }
 
+// runtimeInfo returns the configured runtime along with the executable which
+// implements it, if any, for inclusion in diagnostics bundles.
+func (cmd *WorkerCommand) runtimeInfo() (string, string) {
+	switch cmd.Runtime {
+	case guardianRuntime:
+		if cmd.Guardian.Bin != "" {
+			return guardianRuntime, cmd.Guardian.Bin
+		}
+
+		return guardianRuntime, "gdn"
+	case containerdRuntime:
+		if cmd.Containerd.Bin != "" {
+			return containerdRuntime, cmd.Containerd.Bin
+		}
+
+		return containerdRuntime, "containerd"
+	default:
+		return cmd.Runtime, ""
+	}
+}
+
 func trySetConcourseDirInPATH() {
 	binDir := concourseCmd.DiscoverAsset("bin")
 	if binDir == "" {
@@ -29,6 +29,12 @@ func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Comman
 	command.FindOptionByLongName(prefix + "baggageclaim-volumes").Required = false
 }
 
+// runtimeInfo returns the runtime used on this platform, which has no separate
+// executable.
+func (cmd *WorkerCommand) runtimeInfo() (string, string) {
+	return "houdini", ""
+}
+
 func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, ifrit.Runner, error) {
 	worker := cmd.Worker.Worker()
 	worker.Platform = runtime.GOOS
@@ -0,0 +1,112 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package workerfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/worker"
+)
+
+type FakeDiagnosticsCollector struct {
+	CollectStub        func(context.Context) atc.WorkerDiagnostics
+	collectMutex       sync.RWMutex
+	collectArgsForCall []struct {
+		arg1 context.Context
+	}
+	collectReturns struct {
+		result1 atc.WorkerDiagnostics
+	}
+	collectReturnsOnCall map[int]struct {
+		result1 atc.WorkerDiagnostics
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiagnosticsCollector) Collect(arg1 context.Context) atc.WorkerDiagnostics {
+	fake.collectMutex.Lock()
+	ret, specificReturn := fake.collectReturnsOnCall[len(fake.collectArgsForCall)]
+	fake.collectArgsForCall = append(fake.collectArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Collect", []interface{}{arg1})
+	fake.collectMutex.Unlock()
+	if fake.CollectStub != nil {
+		return fake.CollectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.collectReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDiagnosticsCollector) CollectCallCount() int {
+	fake.collectMutex.RLock()
+	defer fake.collectMutex.RUnlock()
+	return len(fake.collectArgsForCall)
+}
+
+func (fake *FakeDiagnosticsCollector) CollectCalls(stub func(context.Context) atc.WorkerDiagnostics) {
+	fake.collectMutex.Lock()
+	defer fake.collectMutex.Unlock()
+	fake.CollectStub = stub
+}
+
+func (fake *FakeDiagnosticsCollector) CollectArgsForCall(i int) context.Context {
+	fake.collectMutex.RLock()
+	defer fake.collectMutex.RUnlock()
+	argsForCall := fake.collectArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDiagnosticsCollector) CollectReturns(result1 atc.WorkerDiagnostics) {
+	fake.collectMutex.Lock()
+	defer fake.collectMutex.Unlock()
+	fake.CollectStub = nil
+	fake.collectReturns = struct {
+		result1 atc.WorkerDiagnostics
+	}{result1}
+}
+
+func (fake *FakeDiagnosticsCollector) CollectReturnsOnCall(i int, result1 atc.WorkerDiagnostics) {
+	fake.collectMutex.Lock()
+	defer fake.collectMutex.Unlock()
+	fake.CollectStub = nil
+	if fake.collectReturnsOnCall == nil {
+		fake.collectReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerDiagnostics
+		})
+	}
+	fake.collectReturnsOnCall[i] = struct {
+		result1 atc.WorkerDiagnostics
+	}{result1}
+}
+
+func (fake *FakeDiagnosticsCollector) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.collectMutex.RLock()
+	defer fake.collectMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDiagnosticsCollector) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ worker.DiagnosticsCollector = new(FakeDiagnosticsCollector)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/concourse/worker"
 )
@@ -69,6 +70,18 @@ type FakeTSAClient struct {
 	reportContainersReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReportDiagnosticsStub        func(context.Context, atc.WorkerDiagnostics) error
+	reportDiagnosticsMutex       sync.RWMutex
+	reportDiagnosticsArgsForCall []struct {
+		arg1 context.Context
+		arg2 atc.WorkerDiagnostics
+	}
+	reportDiagnosticsReturns struct {
+		result1 error
+	}
+	reportDiagnosticsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReportVolumesStub        func(context.Context, []string) error
 	reportVolumesMutex       sync.RWMutex
 	reportVolumesArgsForCall []struct {
@@ -419,6 +432,67 @@ func (fake *FakeTSAClient) ReportContainersReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTSAClient) ReportDiagnostics(arg1 context.Context, arg2 atc.WorkerDiagnostics) error {
+	fake.reportDiagnosticsMutex.Lock()
+	ret, specificReturn := fake.reportDiagnosticsReturnsOnCall[len(fake.reportDiagnosticsArgsForCall)]
+	fake.reportDiagnosticsArgsForCall = append(fake.reportDiagnosticsArgsForCall, struct {
+		arg1 context.Context
+		arg2 atc.WorkerDiagnostics
+	}{arg1, arg2})
+	fake.recordInvocation("ReportDiagnostics", []interface{}{arg1, arg2})
+	fake.reportDiagnosticsMutex.Unlock()
+	if fake.ReportDiagnosticsStub != nil {
+		return fake.ReportDiagnosticsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.reportDiagnosticsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTSAClient) ReportDiagnosticsCallCount() int {
+	fake.reportDiagnosticsMutex.RLock()
+	defer fake.reportDiagnosticsMutex.RUnlock()
+	return len(fake.reportDiagnosticsArgsForCall)
+}
+
+func (fake *FakeTSAClient) ReportDiagnosticsCalls(stub func(context.Context, atc.WorkerDiagnostics) error) {
+	fake.reportDiagnosticsMutex.Lock()
+	defer fake.reportDiagnosticsMutex.Unlock()
+	fake.ReportDiagnosticsStub = stub
+}
+
+func (fake *FakeTSAClient) ReportDiagnosticsArgsForCall(i int) (context.Context, atc.WorkerDiagnostics) {
+	fake.reportDiagnosticsMutex.RLock()
+	defer fake.reportDiagnosticsMutex.RUnlock()
+	argsForCall := fake.reportDiagnosticsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTSAClient) ReportDiagnosticsReturns(result1 error) {
+	fake.reportDiagnosticsMutex.Lock()
+	defer fake.reportDiagnosticsMutex.Unlock()
+	fake.ReportDiagnosticsStub = nil
+	fake.reportDiagnosticsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTSAClient) ReportDiagnosticsReturnsOnCall(i int, result1 error) {
+	fake.reportDiagnosticsMutex.Lock()
+	defer fake.reportDiagnosticsMutex.Unlock()
+	fake.ReportDiagnosticsStub = nil
+	if fake.reportDiagnosticsReturnsOnCall == nil {
+		fake.reportDiagnosticsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reportDiagnosticsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTSAClient) ReportVolumes(arg1 context.Context, arg2 []string) error {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -621,6 +695,8 @@ func (fake *FakeTSAClient) Invocations() map[string][][]interface{} {
 	defer fake.registerMutex.RUnlock()
 	fake.reportContainersMutex.RLock()
 	defer fake.reportContainersMutex.RUnlock()
+	fake.reportDiagnosticsMutex.RLock()
+	defer fake.reportDiagnosticsMutex.RUnlock()
 	fake.reportVolumesMutex.RLock()
 	defer fake.reportVolumesMutex.RUnlock()
 	fake.retireMutex.RLock()